@@ -44,8 +44,14 @@ func main() {
 		ShareClasses:     store.NewShareClassStore(db),
 		VestingSchedules: store.NewVestingScheduleStore(db),
 		Grants:           store.NewGrantStore(db),
+		GrantMilestones:  store.NewGrantMilestoneStore(db),
+		OptionPools:      store.NewOptionPoolStore(db),
+		Leaves:           store.NewLeaveStore(db),
+		GrantExercises:   store.NewGrantExerciseStore(db),
 		FundingRounds:    store.NewFundingRoundStore(db),
 		SAFENotes:        store.NewSAFENoteStore(db),
+		MFNNotices:       store.NewMFNNoticeStore(db),
+		ConvertibleNotes: store.NewConvertibleNoteStore(db),
 		Audit:            auditLogger,
 	}
 
@@ -12,6 +12,7 @@ type StakeholderRepository interface {
 	GetByID(ctx context.Context, id string) (*Stakeholder, error)
 	GetByIDs(ctx context.Context, ids []string) (map[string]*Stakeholder, error)
 	ListByCompany(ctx context.Context, companyID string) ([]Stakeholder, error)
+	Terminate(ctx context.Context, s *Stakeholder, grants []Grant) error
 }
 
 type ShareClassRepository interface {
@@ -24,6 +25,11 @@ type ShareClassRepository interface {
 type VestingScheduleRepository interface {
 	Create(ctx context.Context, vs *VestingSchedule) error
 	GetByID(ctx context.Context, id string) (*VestingSchedule, error)
+	GetByIDs(ctx context.Context, ids []string) (map[string]*VestingSchedule, error)
+	ListByCompany(ctx context.Context, companyID string) ([]VestingSchedule, error)
+	Update(ctx context.Context, vs *VestingSchedule) error
+	Archive(ctx context.Context, vs *VestingSchedule) error
+	InUse(ctx context.Context, id string) (bool, error)
 }
 
 type GrantRepository interface {
@@ -31,6 +37,27 @@ type GrantRepository interface {
 	GetByID(ctx context.Context, id string) (*Grant, error)
 	ListByCompany(ctx context.Context, companyID string) ([]Grant, error)
 	ListByStakeholder(ctx context.Context, stakeholderID string) ([]Grant, error)
+	Repurchase(ctx context.Context, g *Grant) error
+}
+
+type GrantMilestoneRepository interface {
+	GetByID(ctx context.Context, id string) (*GrantMilestone, error)
+	MarkAchieved(ctx context.Context, m *GrantMilestone) error
+}
+
+type GrantExerciseRepository interface {
+	Create(ctx context.Context, g *Grant, e *GrantExercise, validate func(Grant) error) error
+}
+
+type LeaveRepository interface {
+	Create(ctx context.Context, l *LeaveOfAbsence) error
+	ListByStakeholder(ctx context.Context, stakeholderID string) ([]LeaveOfAbsence, error)
+}
+
+type OptionPoolRepository interface {
+	Create(ctx context.Context, p *OptionPool) error
+	GetByID(ctx context.Context, id string) (*OptionPool, error)
+	ListByCompany(ctx context.Context, companyID string) ([]OptionPool, error)
 }
 
 type FundingRoundRepository interface {
@@ -41,9 +68,23 @@ type FundingRoundRepository interface {
 
 type SAFENoteRepository interface {
 	Create(ctx context.Context, s *SAFENote) error
+	CreateWithNotices(ctx context.Context, s *SAFENote, notices []MFNNotice) error
 	GetByID(ctx context.Context, id string) (*SAFENote, error)
 	ListByCompany(ctx context.Context, companyID string) ([]SAFENote, error)
-	MarkConverted(ctx context.Context, id string, roundID string) error
+	MarkConverted(ctx context.Context, sn *SAFENote, round *FundingRound) error
+	MarkAllConverted(ctx context.Context, safes []SAFENote, round *FundingRound) error
+}
+
+type MFNNoticeRepository interface {
+	Create(ctx context.Context, n *MFNNotice) error
+	ListByStakeholder(ctx context.Context, stakeholderID string) ([]MFNNotice, error)
+}
+
+type ConvertibleNoteRepository interface {
+	Create(ctx context.Context, n *ConvertibleNote) error
+	GetByID(ctx context.Context, id string) (*ConvertibleNote, error)
+	ListByCompany(ctx context.Context, companyID string) ([]ConvertibleNote, error)
+	Settle(ctx context.Context, n *ConvertibleNote, shareClassID string) error
 }
 
 type AuditRepository interface {
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -23,6 +24,53 @@ const (
 	SAFEPostMoney SAFEType = "post_money"
 )
 
+// CapitalizationDefinition is the "Company Capitalization" a SAFE's contract
+// converts against. Outstanding counts every outstanding grant. Fully diluted
+// adds promised options and the unissued pool, leaving out the round's pool
+// increase except where promised options exceed the pool before it, as in
+// the post-money SAFE. The pre-money SAFE counts the whole increase.
+type CapitalizationDefinition string
+
+const (
+	CapitalizationOutstanding                  CapitalizationDefinition = "outstanding"
+	CapitalizationFullyDiluted                 CapitalizationDefinition = "fully_diluted"
+	CapitalizationFullyDilutedWithPoolIncrease CapitalizationDefinition = "fully_diluted_with_pool_increase"
+)
+
+// CapTableBasis is which shares a cap table counts. Each basis counts
+// everything the one before it does: outstanding counts issued shares, fully
+// diluted adds unexercised options, as converted adds SAFEs and notes
+// converted at their valuation caps, and fully diluted with pool adds the
+// unallocated option pool.
+type CapTableBasis string
+
+const (
+	CapTableOutstanding          CapTableBasis = "outstanding"
+	CapTableFullyDiluted         CapTableBasis = "fully_diluted"
+	CapTableAsConverted          CapTableBasis = "as_converted"
+	CapTableFullyDilutedWithPool CapTableBasis = "fully_diluted_with_pool"
+)
+
+// InterestType is how a convertible note accrues interest. Compound interest
+// compounds annually on the issue date's anniversaries, with simple interest
+// for the part year since the last one.
+type InterestType string
+
+const (
+	InterestSimple   InterestType = "simple"
+	InterestCompound InterestType = "compound"
+)
+
+// DayCount is the convention for measuring a period as a fraction of a year
+// when accruing interest.
+type DayCount string
+
+const (
+	DayCountActual365 DayCount = "actual_365"
+	DayCountActual360 DayCount = "actual_360"
+	DayCount30360     DayCount = "30_360" // US (NASD) 30/360
+)
+
 type VestingFrequency string
 
 const (
@@ -39,6 +87,63 @@ const (
 	AccelerationDoubleTrigger AccelerationTrigger = "double_trigger"
 )
 
+// VestingRounding controls how fractional shares are handled as a schedule
+// vests. Every policy vests exactly the full quantity by the final date.
+type VestingRounding string
+
+const (
+	// RoundingFractional vests fractional shares, floored to 4 decimal places.
+	RoundingFractional VestingRounding = "fractional"
+	// RoundingCumulativeFloor floors the cumulative vested amount to whole shares.
+	RoundingCumulativeFloor VestingRounding = "cumulative_floor"
+	// RoundingPerPeriodFloor floors each period's vest to whole shares and
+	// trues up the remainder on the final period.
+	RoundingPerPeriodFloor VestingRounding = "per_period_floor"
+	// RoundingBankers rounds the cumulative vested amount to whole shares,
+	// with ties to even.
+	RoundingBankers VestingRounding = "bankers"
+)
+
+type MilestoneCondition string
+
+const (
+	// MilestoneOnly vests the milestone's shares in full when it is achieved.
+	MilestoneOnly MilestoneCondition = "milestone"
+	// MilestoneAndTime vests the milestone's shares on the grant's time-based
+	// schedule, but only once the milestone is achieved.
+	MilestoneAndTime MilestoneCondition = "milestone_and_time"
+)
+
+// GrantType distinguishes option grants from restricted stock and plain
+// stock. An RSA issues shares outright at grant; its vesting schedule governs
+// when the company's right to repurchase them lapses. Stock is issued outright
+// and fully vested, such as the shares a SAFE converts into.
+type GrantType string
+
+const (
+	GrantTypeOption GrantType = "option"
+	GrantTypeRSA    GrantType = "rsa"
+	GrantTypeStock  GrantType = "stock"
+)
+
+type TerminationReason string
+
+const (
+	TerminationVoluntary   TerminationReason = "voluntary"
+	TerminationInvoluntary TerminationReason = "involuntary"
+	TerminationForCause    TerminationReason = "for_cause"
+	TerminationDeath       TerminationReason = "death"
+	TerminationDisability  TerminationReason = "disability"
+)
+
+type ForecastBucket string
+
+const (
+	BucketMonth   ForecastBucket = "month"
+	BucketQuarter ForecastBucket = "quarter"
+	BucketYear    ForecastBucket = "year"
+)
+
 type Company struct {
 	ID        string
 	Name      string
@@ -48,71 +153,264 @@ type Company struct {
 }
 
 type Stakeholder struct {
-	ID        string
-	CompanyID string
-	Name      string
-	Email     string
-	Role      StakeholderRole
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	ID                string
+	CompanyID         string
+	Name              string
+	Email             string
+	Role              StakeholderRole
+	TerminationDate   *time.Time
+	TerminationReason *TerminationReason
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time
 }
 
 type ShareClass struct {
-	ID                   string
-	CompanyID            string
-	Name                 string
-	IsPreferred          bool
-	LiquidationMultiple  decimal.Decimal
-	IsParticipating      bool
-	ParticipationCap     *decimal.Decimal // nil = uncapped
-	PricePerShare        *decimal.Decimal
-	Seniority            int
-	AuthorizedShares     decimal.Decimal
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
-	DeletedAt            *time.Time
+	ID                  string
+	CompanyID           string
+	Name                string
+	IsPreferred         bool
+	LiquidationMultiple decimal.Decimal
+	IsParticipating     bool
+	ParticipationCap    *decimal.Decimal // nil = uncapped
+	PricePerShare       *decimal.Decimal
+	Seniority           int
+	AuthorizedShares    decimal.Decimal
+	ParentShareClassID  *string // set for a shadow series
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	DeletedAt           *time.Time
+}
+
+// ShadowSeries is the n-th shadow series of sc, such as Series A-1: the
+// sub-series issued to SAFEs converting at pps, below the round price. It
+// carries sc's terms and seniority, but with pps as its issue price, so its
+// liquidation preference matches what the holders paid.
+func (sc ShareClass) ShadowSeries(n int, pps decimal.Decimal) ShareClass {
+	parentID := sc.ID
+	return ShareClass{
+		CompanyID:           sc.CompanyID,
+		Name:                fmt.Sprintf("%s-%d", sc.Name, n),
+		IsPreferred:         sc.IsPreferred,
+		LiquidationMultiple: sc.LiquidationMultiple,
+		IsParticipating:     sc.IsParticipating,
+		ParticipationCap:    sc.ParticipationCap,
+		PricePerShare:       &pps,
+		Seniority:           sc.Seniority,
+		ParentShareClassID:  &parentID,
+	}
 }
 
+// VestingSchedule is a company's named vesting template, such as
+// "Standard 4y/1y". Archived templates are hidden from the company's list but
+// keep governing the grants already issued on them.
 type VestingSchedule struct {
 	ID                  string
+	CompanyID           string
+	Name                string
 	CliffMonths         int
 	TotalMonths         int
 	Frequency           VestingFrequency
 	AccelerationTrigger AccelerationTrigger
-	CreatedAt           time.Time
+
+	// Acceleration terms. With neither AccelerationPercent nor
+	// AccelerationMonths set, a trigger vests 100% of unvested shares.
+	AccelerationPercent      *decimal.Decimal // 50 = 50% of unvested shares
+	AccelerationMonths       *int             // months of additional vesting credited
+	AccelerationWindowMonths int              // double trigger: max months from change of control to termination
+
+	// Tranches, when present, replace the uniform cliff/frequency schedule.
+	Tranches []VestingTranche
+
+	Rounding VestingRounding // empty means RoundingFractional
+
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt *time.Time
+}
+
+// VestingTranche is one explicit vesting event in a custom schedule, offset
+// from the vesting start. Exactly one of Percent or Shares is set.
+type VestingTranche struct {
+	OffsetMonths int
+	Percent      *decimal.Decimal // 25 = 25% of the grant
+	Shares       *decimal.Decimal
 }
 
 type Grant struct {
-	ID                string
-	CompanyID         string
-	StakeholderID     string
-	ShareClassID      string
-	VestingScheduleID *string
-	Quantity          decimal.Decimal
-	GrantDate         time.Time
-	ExercisePrice     decimal.Decimal
-	IsExercised       bool
-	Notes             *string
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
-	DeletedAt         *time.Time
+	ID                      string
+	CompanyID               string
+	StakeholderID           string
+	ShareClassID            string
+	VestingScheduleID       *string
+	OptionPoolID            *string
+	Type                    GrantType // empty means GrantTypeOption
+	Quantity                decimal.Decimal
+	GrantDate               time.Time       // board approval; legal and tax date
+	VestingCommencementDate time.Time       // vesting anchor, usually the holder's start date
+	ExercisePrice           decimal.Decimal // purchase price per share for an RSA
+	IsExercised             bool            // every outstanding option has been exercised
+	EarlyExerciseAllowed    bool            // options may be exercised before they vest
+	Notes                   *string
+	TerminationDate         *time.Time // vesting stops on this date
+	ForfeitedQuantity       decimal.Decimal
+	ExerciseDeadline        *time.Time
+	ExpiredQuantity         decimal.Decimal  // vested options left unexercised at a passed ExerciseDeadline
+	RepurchasePrice         *decimal.Decimal // per share, for issued shares unvested at termination
+	RepurchasedQuantity     decimal.Decimal  // unvested shares bought back into treasury
+	RepurchaseDate          *time.Time
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	DeletedAt               *time.Time
 
 	VestingSchedule *VestingSchedule
+	Milestones      []GrantMilestone
+	Leaves          []LeaveOfAbsence // the holder's leaves, including those recorded against the whole stakeholder
+	Exercises       []GrantExercise
+}
+
+// OutstandingQuantity is the quantity still held after forfeiture,
+// repurchase and expiry.
+func (g Grant) OutstandingQuantity() decimal.Decimal {
+	return g.Quantity.Sub(g.ForfeitedQuantity).Sub(g.RepurchasedQuantity).Sub(g.ExpiredQuantity)
+}
+
+// OutstandingQuantityAt is the quantity outstanding on asOf: nothing before
+// the grant date, less forfeiture from the termination date, repurchase from
+// the repurchase date, and the options left unexercised once the exercise
+// deadline has passed.
+func (g Grant) OutstandingQuantityAt(asOf time.Time) decimal.Decimal {
+	if asOf.Before(g.GrantDate) {
+		return decimal.Zero
+	}
+	outstanding := g.Quantity
+	if g.TerminationDate != nil && !asOf.Before(*g.TerminationDate) {
+		outstanding = outstanding.Sub(g.ForfeitedQuantity)
+	}
+	if g.RepurchaseDate != nil && !asOf.Before(*g.RepurchaseDate) {
+		outstanding = outstanding.Sub(g.RepurchasedQuantity)
+	}
+	if !g.IssuedAtGrant() && g.ExerciseDeadline != nil && asOf.After(*g.ExerciseDeadline) {
+		unexercised := g.Quantity.Sub(g.ForfeitedQuantity).Sub(g.ExercisedQuantity(*g.ExerciseDeadline))
+		outstanding = outstanding.Sub(decimal.Max(unexercised, decimal.Zero))
+	}
+	return outstanding
+}
+
+// IsRSA reports whether the grant is restricted stock rather than options.
+func (g Grant) IsRSA() bool {
+	return g.Type == GrantTypeRSA
+}
+
+// IssuedAtGrant reports whether the grant issues shares outright rather than
+// options: restricted stock and stock.
+func (g Grant) IssuedAtGrant() bool {
+	return g.Type == GrantTypeRSA || g.Type == GrantTypeStock
+}
+
+// IssuedQuantity is the number of shares actually issued to the holder by
+// asOf: the whole outstanding grant for shares issued at grant, and the
+// exercised options otherwise.
+func (g Grant) IssuedQuantity(asOf time.Time) decimal.Decimal {
+	issued := g.ExercisedQuantity(asOf)
+	if g.IssuedAtGrant() {
+		issued = decimal.Zero
+		if !asOf.Before(g.GrantDate) {
+			issued = g.Quantity
+		}
+	}
+	if g.RepurchaseDate != nil && !asOf.Before(*g.RepurchaseDate) {
+		issued = issued.Sub(g.RepurchasedQuantity)
+	}
+	return issued
+}
+
+// ExercisedQuantity is the number of options exercised on or before asOf.
+func (g Grant) ExercisedQuantity(asOf time.Time) decimal.Decimal {
+	total := decimal.Zero
+	for _, e := range g.Exercises {
+		if !e.ExerciseDate.After(asOf) {
+			total = total.Add(e.Quantity)
+		}
+	}
+	return total
+}
+
+// TotalExercised is the number of options exercised to date.
+func (g Grant) TotalExercised() decimal.Decimal {
+	total := decimal.Zero
+	for _, e := range g.Exercises {
+		total = total.Add(e.Quantity)
+	}
+	return total
+}
+
+// GrantExercise is an exercise of some of a grant's options into shares.
+type GrantExercise struct {
+	ID           string
+	GrantID      string
+	Quantity     decimal.Decimal
+	ExerciseDate time.Time
+	CreatedAt    time.Time
+}
+
+// LeaveOfAbsence is a period away from work. A leave with no GrantID applies
+// to all of the stakeholder's grants.
+type LeaveOfAbsence struct {
+	ID            string
+	StakeholderID string
+	GrantID       *string
+	StartDate     time.Time
+	EndDate       time.Time // first day back; the leave covers [StartDate, EndDate)
+	IsPaid        bool
+	CreatedAt     time.Time
+}
+
+// OptionPool is a reserve of shares set aside for equity incentive grants.
+type OptionPool struct {
+	ID             string
+	CompanyID      string
+	ShareClassID   string
+	Name           string
+	ReservedShares decimal.Decimal
+	GrantedShares  decimal.Decimal // outstanding quantity of grants drawn from the pool; computed on read
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
+
+// AvailableShares is the part of the reserve not yet granted.
+func (p OptionPool) AvailableShares() decimal.Decimal {
+	return p.ReservedShares.Sub(p.GrantedShares)
+}
+
+// GrantMilestone is a performance condition on part of a grant, such as a
+// revenue target or regulatory approval.
+type GrantMilestone struct {
+	ID           string
+	GrantID      string
+	Name         string
+	Shares       decimal.Decimal
+	Condition    MilestoneCondition
+	AchievedDate *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 type FundingRound struct {
-	ID               string
-	CompanyID        string
-	Name             string
-	PreMoneyVal      decimal.Decimal
-	AmountRaised     decimal.Decimal
-	PricePerShare    decimal.Decimal
-	ShareClassID     string
-	RoundDate        time.Time
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	DeletedAt        *time.Time
+	ID                 string
+	CompanyID          string
+	Name               string
+	PreMoneyVal        decimal.Decimal
+	AmountRaised       decimal.Decimal
+	PricePerShare      decimal.Decimal
+	ShareClassID       string
+	RoundDate          time.Time
+	OptionPoolIncrease decimal.Decimal // shares added to the option pool in the round
+	PromisedOptions    decimal.Decimal // options promised but not yet granted at the round
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	DeletedAt          *time.Time
 }
 
 type SAFENote struct {
@@ -123,12 +421,115 @@ type SAFENote struct {
 	ValuationCap     *decimal.Decimal
 	DiscountRate     *decimal.Decimal // 0.20 = 20%
 	SAFEType         SAFEType
+	IsMFN            bool // uncapped; inherits the best terms of later SAFEs
 	IsConverted      bool
 	ConvertedInRound *string
 	IssueDate        time.Time
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	DeletedAt        *time.Time
+
+	CapitalizationDefinition CapitalizationDefinition // empty means CapitalizationOutstanding
+
+	InheritedTermsFrom *string               // the later SAFE whose cap and discount an MFN SAFE adopted
+	Conversion         *SAFEConversionResult // set once converted
+	ConversionGrantID  *string               // the stock grant that issued the converted shares
+}
+
+// MFNNotice tells an MFN SAFE's holder that a later SAFE was issued on better
+// terms, which the MFN SAFE may inherit.
+type MFNNotice struct {
+	ID            string
+	SAFEID        string // the MFN SAFE
+	SourceSAFEID  string // the SAFE issued on better terms
+	StakeholderID string // the MFN SAFE's holder
+	ValuationCap  *decimal.Decimal
+	DiscountRate  *decimal.Decimal
+	CreatedAt     time.Time
+}
+
+// Issuance is the stock grant that issues the SAFE's converted shares in
+// round at the effective price, in shareClassID: the round's class or a
+// shadow series of it. Conversion must be set.
+func (sn SAFENote) Issuance(round FundingRound, shareClassID string) Grant {
+	notes := fmt.Sprintf("Conversion of SAFE %s in %s", sn.ID, round.Name)
+	return Grant{
+		CompanyID:               sn.CompanyID,
+		StakeholderID:           sn.StakeholderID,
+		ShareClassID:            shareClassID,
+		Type:                    GrantTypeStock,
+		Quantity:                sn.Conversion.SharesIssued,
+		GrantDate:               round.RoundDate,
+		VestingCommencementDate: round.RoundDate,
+		ExercisePrice:           sn.Conversion.EffectivePPS,
+		Notes:                   &notes,
+	}
+}
+
+// ConvertibleNote is debt that converts into equity at a qualified financing,
+// principal plus accrued interest, at the better of its cap and discount. If
+// it reaches maturity unconverted it converts at the maturity cap when it has
+// one, and is repaid otherwise.
+type ConvertibleNote struct {
+	ID                       string
+	CompanyID                string
+	StakeholderID            string
+	Principal                decimal.Decimal
+	InterestRate             decimal.Decimal // annual; 0.08 = 8%
+	InterestType             InterestType
+	DayCount                 DayCount
+	IssueDate                time.Time
+	MaturityDate             time.Time
+	ValuationCap             *decimal.Decimal // pre-money
+	DiscountRate             *decimal.Decimal // 0.20 = 20%
+	QualifiedFinancingAmount *decimal.Decimal // smallest round that converts the note; nil means any priced round
+	MaturityValuationCap     *decimal.Decimal // nil means the note is repaid at maturity
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+	DeletedAt                *time.Time
+
+	Settlement        *NoteSettlement // set once converted or repaid
+	SettledInRound    *string         // the round it converted in, if any
+	SettlementGrantID *string         // the stock grant that issued the converted shares
+}
+
+// Issuance is the stock grant that issues the note's converted shares in
+// shareClassID at the effective price. Settlement must be set.
+func (n ConvertibleNote) Issuance(shareClassID string) Grant {
+	notes := fmt.Sprintf("Conversion of convertible note %s", n.ID)
+	return Grant{
+		CompanyID:               n.CompanyID,
+		StakeholderID:           n.StakeholderID,
+		ShareClassID:            shareClassID,
+		Type:                    GrantTypeStock,
+		Quantity:                n.Settlement.SharesIssued,
+		GrantDate:               n.Settlement.Date,
+		VestingCommencementDate: n.Settlement.Date,
+		ExercisePrice:           n.Settlement.EffectivePPS,
+		Notes:                   &notes,
+	}
+}
+
+// NoteAccrual is a convertible note's balance on a date.
+type NoteAccrual struct {
+	NoteID          string
+	AsOfDate        time.Time
+	Principal       decimal.Decimal
+	AccruedInterest decimal.Decimal
+	Balance         decimal.Decimal // principal plus accrued interest
+}
+
+// NoteSettlement is how a convertible note was settled: converted into shares
+// or repaid in cash.
+type NoteSettlement struct {
+	NoteID          string
+	Date            time.Time
+	Principal       decimal.Decimal
+	AccruedInterest decimal.Decimal
+	SharesIssued    decimal.Decimal // zero when repaid
+	EffectivePPS    decimal.Decimal // zero when repaid
+	RepaymentAmount decimal.Decimal // zero when converted
+	Method          string          // "cap", "discount", "round_price", "maturity_cap" or "repayment"
 }
 
 type AuditEntry struct {
@@ -154,13 +555,86 @@ type VestingStatus struct {
 	CliffDate      time.Time
 	FullyVestedAt  time.Time
 	IsFullyVested  bool
+
+	AcceleratedShares decimal.Decimal
+
+	// ExercisedShares are options exercised by AsOfDate. Shares issued to the
+	// holder, by exercise or as restricted stock, are either released (vested)
+	// or still subject to repurchase: RepurchasableShares are those the
+	// company can buy back if the holder leaves before they vest.
+	ExercisedShares     decimal.Decimal
+	ReleasedShares      decimal.Decimal
+	RepurchasableShares decimal.Decimal
+}
+
+// VestEvent is a date on which shares of a grant vest.
+type VestEvent struct {
+	Date             time.Time
+	Shares           decimal.Decimal
+	CumulativeVested decimal.Decimal
+}
+
+// VestingForecastPeriod totals the shares vesting across a company in one
+// time bucket.
+type VestingForecastPeriod struct {
+	PeriodStart  time.Time
+	PeriodEnd    time.Time // inclusive
+	VestedShares decimal.Decimal
+	Breakdown    []VestingForecastLine
+}
+
+// VestingForecastLine is the share of a forecast period attributable to one
+// share class and stakeholder role.
+type VestingForecastLine struct {
+	ShareClassID   string
+	ShareClassName string
+	Role           StakeholderRole
+	VestedShares   decimal.Decimal
 }
 
 type SAFEConversionResult struct {
-	SAFEID            string
-	SharesIssued      decimal.Decimal
-	EffectivePPS      decimal.Decimal
-	ConversionMethod  string // "cap", "discount", or "round_price"
+	SAFEID             string
+	SharesIssued       decimal.Decimal
+	EffectivePPS       decimal.Decimal
+	ConversionMethod   string  // "cap", "discount", or "round_price"
+	InheritedTermsFrom *string // for an MFN SAFE, the SAFE whose terms it converted on
+	Capitalization     *Capitalization
+}
+
+// BelowRoundPrice reports whether the SAFE converted at its cap or discount,
+// and so into a shadow series rather than the round's class.
+func (r SAFEConversionResult) BelowRoundPrice() bool {
+	return r.ConversionMethod == "cap" || r.ConversionMethod == "discount"
+}
+
+// Capitalization is the share count a SAFE converts against, broken down by
+// component. Components a definition leaves out are zero.
+type Capitalization struct {
+	Definition      CapitalizationDefinition
+	IssuedShares    decimal.Decimal // stock, restricted stock and exercised options
+	IssuedOptions   decimal.Decimal // granted options not yet exercised
+	PromisedOptions decimal.Decimal
+	UnissuedPool    decimal.Decimal // available in option pools, less promised options
+	PoolIncrease    decimal.Decimal
+	Total           decimal.Decimal
+}
+
+// SAFEConversionPreview is what a company's unconverted SAFEs would convert
+// into at a candidate round, without converting them.
+type SAFEConversionPreview struct {
+	Round           FundingRound
+	PostMoneyShares decimal.Decimal // existing, SAFE and new investor shares
+	Conversions     []SAFEConversionResult
+	Holders         []SAFEHolderOwnership
+}
+
+// SAFEHolderOwnership is a SAFE holder's post-round position in a preview.
+type SAFEHolderOwnership struct {
+	StakeholderID    string
+	StakeholderName  string
+	ExistingShares   decimal.Decimal
+	ConversionShares decimal.Decimal
+	OwnershipPct     decimal.Decimal
 }
 
 type CapTableEntry struct {
@@ -169,20 +643,64 @@ type CapTableEntry struct {
 	ShareClassName  string
 	Shares          decimal.Decimal
 	OwnershipPct    decimal.Decimal
+
+	ExercisedUnvestedShares decimal.Decimal // part of Shares still subject to repurchase
+
+	// The columns Shares sums, as far as the basis counts them. Only cap
+	// table queries fill them.
+	IssuedShares      decimal.Decimal // stock, restricted stock and exercised options
+	OptionShares      decimal.Decimal // unexercised options
+	ConvertibleShares decimal.Decimal // SAFEs and notes as converted at their caps
+	PoolShares        decimal.Decimal // the unallocated option pool
 }
 
 type CapTableSnapshot struct {
-	CompanyID        string
-	AsOfDate         time.Time
-	TotalShares      decimal.Decimal
-	Entries          []CapTableEntry
+	CompanyID   string
+	AsOfDate    time.Time
+	TotalShares decimal.Decimal
+	Entries     []CapTableEntry
+
+	// Set by cap table queries: the basis TotalShares counts, and the
+	// column totals across every entry whether the basis counts them or not.
+	Basis             CapTableBasis
+	IssuedShares      decimal.Decimal
+	OptionShares      decimal.Decimal
+	ConvertibleShares decimal.Decimal
+	PoolShares        decimal.Decimal
 }
 
 type DilutionResult struct {
-	PreRound     CapTableSnapshot
-	PostRound    CapTableSnapshot
-	NewInvestor  CapTableEntry
-	RoundName    string
+	PreRound    CapTableSnapshot
+	PostRound   CapTableSnapshot
+	NewInvestor CapTableEntry // every investor's new shares together
+	RoundName   string
+
+	Investors          []CapTableEntry // each investor's new shares
+	AllocatedAmount    decimal.Decimal
+	AllocationMismatch bool // the allocations do not sum to the amount raised
+
+	EffectivePPS        decimal.Decimal // pre-money valuation over pre-money shares, pool increase included
+	PoolIncrease        decimal.Decimal // new pool shares to reach the target post-money pool
+	PoolDilutionPct     decimal.Decimal // existing holders' ownership lost to the pool increase, in points
+	NewMoneyDilutionPct decimal.Decimal // existing holders' ownership lost to the new money, in points
+
+	Conversions           []CapTableEntry // shares issued to converting SAFEs and notes
+	ConversionDilutionPct decimal.Decimal // existing holders' ownership lost to converting instruments, in points
+}
+
+// RoundSequenceResult is a sequence of modeled rounds, each starting from the
+// previous one's post-round cap table.
+type RoundSequenceResult struct {
+	Steps        []DilutionResult
+	Trajectories []OwnershipTrajectory
+}
+
+// OwnershipTrajectory is a stakeholder's ownership before the first modeled
+// round and after each round.
+type OwnershipTrajectory struct {
+	StakeholderID   string // empty for investors modeled by name and for the pool
+	StakeholderName string
+	OwnershipPct    []decimal.Decimal
 }
 
 type WaterfallPayout struct {
@@ -192,10 +710,11 @@ type WaterfallPayout struct {
 	Shares          decimal.Decimal
 	Payout          decimal.Decimal
 	PayoutPerShare  decimal.Decimal
+	ClaimElection   string // for a SAFE or convertible note: "cash_out" or "convert"
 }
 
 type WaterfallResult struct {
-	ExitValuation  decimal.Decimal
-	TotalPayout    decimal.Decimal
-	Payouts        []WaterfallPayout
+	ExitValuation decimal.Decimal
+	TotalPayout   decimal.Decimal
+	Payouts       []WaterfallPayout
 }
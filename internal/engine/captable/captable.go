@@ -0,0 +1,70 @@
+package captable
+
+import (
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// PoolEntryName is the stakeholder name of the unallocated option pool's entry.
+const PoolEntryName = "Unallocated option pool"
+
+// GrantShares splits grant g's quantity outstanding on asOf into issued
+// shares and unexercised options, counting only what had been granted,
+// exercised, forfeited, repurchased or had expired by then.
+func GrantShares(g domain.Grant, asOf time.Time) (issued, options decimal.Decimal) {
+	outstanding := g.OutstandingQuantityAt(asOf)
+	if g.IssuedAtGrant() {
+		return outstanding, decimal.Zero
+	}
+	issued = g.IssuedQuantity(asOf)
+	return issued, outstanding.Sub(issued)
+}
+
+// Build assembles a cap table on basis from entries with their issued,
+// option and convertible columns set, plus an entry for the unallocated
+// pool when pool is positive. Each entry's Shares sums the columns the basis
+// counts, and its ownership is measured against the basis total. Entries
+// the basis does not count keep their columns at zero shares and ownership.
+func Build(entries []domain.CapTableEntry, pool decimal.Decimal, basis domain.CapTableBasis) domain.CapTableSnapshot {
+	countsOptions := basis != domain.CapTableOutstanding
+	countsConvertibles := basis == domain.CapTableAsConverted || basis == domain.CapTableFullyDilutedWithPool
+	countsPool := basis == domain.CapTableFullyDilutedWithPool
+
+	out := make([]domain.CapTableEntry, len(entries), len(entries)+1)
+	copy(out, entries)
+	if pool.IsPositive() {
+		out = append(out, domain.CapTableEntry{StakeholderName: PoolEntryName, PoolShares: pool})
+	}
+
+	snap := domain.CapTableSnapshot{Basis: basis}
+	for i := range out {
+		e := &out[i]
+		e.Shares = e.IssuedShares
+		if countsOptions {
+			e.Shares = e.Shares.Add(e.OptionShares)
+		}
+		if countsConvertibles {
+			e.Shares = e.Shares.Add(e.ConvertibleShares)
+		}
+		if countsPool {
+			e.Shares = e.Shares.Add(e.PoolShares)
+		}
+		snap.TotalShares = snap.TotalShares.Add(e.Shares)
+		snap.IssuedShares = snap.IssuedShares.Add(e.IssuedShares)
+		snap.OptionShares = snap.OptionShares.Add(e.OptionShares)
+		snap.ConvertibleShares = snap.ConvertibleShares.Add(e.ConvertibleShares)
+		snap.PoolShares = snap.PoolShares.Add(e.PoolShares)
+	}
+
+	hundred := decimal.NewFromInt(100)
+	for i := range out {
+		out[i].OwnershipPct = decimal.Zero
+		if snap.TotalShares.IsPositive() {
+			out[i].OwnershipPct = out[i].Shares.Div(snap.TotalShares).Mul(hundred).RoundFloor(4)
+		}
+	}
+	snap.Entries = out
+	return snap
+}
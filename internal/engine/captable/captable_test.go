@@ -0,0 +1,119 @@
+package captable
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+func dec(v string) decimal.Decimal {
+	d, _ := decimal.NewFromString(v)
+	return d
+}
+
+func TestGrantShares(t *testing.T) {
+	asOf := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	deadline := time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)
+	termination := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		grant       domain.Grant
+		wantIssued  string
+		wantOptions string
+	}{
+		{
+			"exercise after asOf stays an option",
+			domain.Grant{Quantity: dec("1000"), GrantDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Exercises: []domain.GrantExercise{
+				{Quantity: dec("200"), ExerciseDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+				{Quantity: dec("300"), ExerciseDate: later},
+			}},
+			"200", "800",
+		},
+		{"granted after asOf", domain.Grant{Quantity: dec("1000"), GrantDate: later}, "0", "0"},
+		{"stock granted after asOf", domain.Grant{Type: domain.GrantTypeStock, Quantity: dec("1000"), GrantDate: later}, "0", "0"},
+		{
+			"forfeiture after asOf still outstanding",
+			domain.Grant{Quantity: dec("1000"), GrantDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), TerminationDate: &later, ForfeitedQuantity: dec("600")},
+			"0", "1000",
+		},
+		{
+			"vested options expired at the deadline",
+			domain.Grant{Quantity: dec("1000"), GrantDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				TerminationDate: &termination, ForfeitedQuantity: dec("500"), ExerciseDeadline: &deadline,
+				Exercises: []domain.GrantExercise{{Quantity: dec("100"), ExerciseDate: time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)}}},
+			"100", "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issued, options := GrantShares(tt.grant, asOf)
+			if !issued.Equal(dec(tt.wantIssued)) || !options.Equal(dec(tt.wantOptions)) {
+				t.Errorf("GrantShares = %s issued, %s options, want %s, %s", issued, options, tt.wantIssued, tt.wantOptions)
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	// 6M issued founder shares, 2M options, a SAFE converting into 1M
+	// shares and 1M left in the pool.
+	entries := []domain.CapTableEntry{
+		{StakeholderID: "f1", StakeholderName: "Founder", ShareClassName: "Common", IssuedShares: dec("6000000")},
+		{StakeholderID: "e1", StakeholderName: "Employee", ShareClassName: "Common", IssuedShares: dec("500000"), OptionShares: dec("1500000")},
+		{StakeholderID: "s1", StakeholderName: "Angel", ShareClassName: "SAFE", ConvertibleShares: dec("1000000")},
+	}
+
+	tests := []struct {
+		basis          domain.CapTableBasis
+		wantTotal      string
+		wantFounderPct string
+		wantEmployee   string
+		wantSAFEPct    string
+		wantPoolPct    string
+	}{
+		{domain.CapTableOutstanding, "6500000", "92.3076", "500000", "0", "0"},
+		{domain.CapTableFullyDiluted, "8000000", "75", "2000000", "0", "0"},
+		{domain.CapTableAsConverted, "9000000", "66.6666", "2000000", "11.1111", "0"},
+		{domain.CapTableFullyDilutedWithPool, "10000000", "60", "2000000", "10", "10"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.basis), func(t *testing.T) {
+			snap := Build(entries, dec("1000000"), tt.basis)
+
+			if snap.Basis != tt.basis || !snap.TotalShares.Equal(dec(tt.wantTotal)) {
+				t.Errorf("TotalShares = %s on %s, want %s", snap.TotalShares, snap.Basis, tt.wantTotal)
+			}
+			if len(snap.Entries) != 4 {
+				t.Fatalf("got %d entries, want every holder and the pool", len(snap.Entries))
+			}
+			if got := snap.Entries[0].OwnershipPct; !got.Equal(dec(tt.wantFounderPct)) {
+				t.Errorf("founder OwnershipPct = %s, want %s", got, tt.wantFounderPct)
+			}
+			if got := snap.Entries[1].Shares; !got.Equal(dec(tt.wantEmployee)) {
+				t.Errorf("employee Shares = %s, want %s", got, tt.wantEmployee)
+			}
+			if got := snap.Entries[2].OwnershipPct; !got.Equal(dec(tt.wantSAFEPct)) {
+				t.Errorf("SAFE OwnershipPct = %s, want %s", got, tt.wantSAFEPct)
+			}
+			pool := snap.Entries[3]
+			if pool.StakeholderName != PoolEntryName || !pool.OwnershipPct.Equal(dec(tt.wantPoolPct)) {
+				t.Errorf("pool entry = %s at %s%%, want %s%%", pool.StakeholderName, pool.OwnershipPct, tt.wantPoolPct)
+			}
+
+			// The column totals do not depend on the basis.
+			if !snap.IssuedShares.Equal(dec("6500000")) || !snap.OptionShares.Equal(dec("1500000")) ||
+				!snap.ConvertibleShares.Equal(dec("1000000")) || !snap.PoolShares.Equal(dec("1000000")) {
+				t.Errorf("column totals = %s / %s / %s / %s, want 6500000 / 1500000 / 1000000 / 1000000",
+					snap.IssuedShares, snap.OptionShares, snap.ConvertibleShares, snap.PoolShares)
+			}
+		})
+	}
+
+	if !entries[0].Shares.IsZero() {
+		t.Errorf("Build modified its input: %+v", entries[0])
+	}
+}
@@ -1,7 +1,11 @@
 package dilution
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/hutfut/vestigo/internal/engine/captable"
 	"github.com/shopspring/decimal"
 )
 
@@ -14,74 +18,279 @@ type StakeholderShares struct {
 	Shares          decimal.Decimal
 }
 
+// Allocation is one investor's part of a round. StakeholderID is set for an
+// existing stakeholder, whose new shares join their post-round entry.
+type Allocation struct {
+	InvestorName  string
+	StakeholderID string
+	Amount        decimal.Decimal
+}
+
 // RoundInput describes a hypothetical funding round for dilution modeling.
+// Without Allocations, InvestorName takes the whole round.
 type RoundInput struct {
 	RoundName     string
 	PreMoneyVal   decimal.Decimal
 	AmountRaised  decimal.Decimal
 	NewShareClass string
 	InvestorName  string
+	Allocations   []Allocation
+
+	// ExistingPool is the unallocated option pool before the round.
+	ExistingPool decimal.Decimal
+	// TargetPoolPct, if positive, is the unallocated pool the round requires
+	// post-money, as a percentage. The increase to reach it is made before
+	// the round, so it dilutes only the existing holders.
+	TargetPoolPct decimal.Decimal
 }
 
+// Validate checks that the round can be modeled.
+func Validate(input RoundInput) error {
+	if !input.PreMoneyVal.IsPositive() {
+		return &domain.ErrValidation{Field: "preMoneyValuation", Message: "must be positive"}
+	}
+	if !input.AmountRaised.IsPositive() {
+		return &domain.ErrValidation{Field: "amountRaised", Message: "must be positive"}
+	}
+	for _, a := range input.Allocations {
+		if !a.Amount.IsPositive() {
+			return &domain.ErrValidation{Field: "allocations", Message: fmt.Sprintf("amount for %s must be positive", a.InvestorName)}
+		}
+	}
+	if input.TargetPoolPct.IsNegative() {
+		return &domain.ErrValidation{Field: "targetPoolPct", Message: "must not be negative"}
+	}
+	hundred := decimal.NewFromInt(100)
+	investorPct := input.AmountRaised.Div(input.PreMoneyVal.Add(input.AmountRaised)).Mul(hundred)
+	if investorPct.Add(input.TargetPoolPct).GreaterThanOrEqual(hundred) {
+		return &domain.ErrValidation{Field: "targetPoolPct", Message: "leaves nothing for existing holders after the new money"}
+	}
+	return nil
+}
+
+// Conversion is the shares a SAFE or convertible note converts into at the
+// modeled round.
+type Conversion struct {
+	StakeholderID   string
+	StakeholderName string
+	Shares          decimal.Decimal
+}
+
+// Converter converts a company's outstanding SAFEs and notes at a round
+// priced at pps whose pool increase is poolIncrease. preRoundShares is the
+// round's pre-round share count, existing holders and pool together.
+type Converter func(pps, poolIncrease, preRoundShares decimal.Decimal) ([]Conversion, error)
+
 // Model calculates the dilution impact of a hypothetical funding round on the
-// current cap table. It returns pre-round and post-round snapshots plus the
-// new investor's entry.
+// current cap table. It returns pre-round and post-round snapshots, each
+// investor's new shares at the round price, and their total as the new
+// investor's entry. Allocations that do not sum to the amount raised are
+// flagged; shares follow the allocations.
+//
+// The pre-money share count includes the unallocated pool and any increase
+// to reach input.TargetPoolPct, so the increase lowers the effective price.
+// The new investor buys a fixed fraction a = raised / post-money of the
+// company, so with E the existing shares and p the target pool fraction the
+// post-money total T satisfies T = E + pT + aT, giving
+//
+//	T = E / (1 - a - p)
+//
+// and a pool increase of pT less the existing pool. An existing pool already
+// at the target is left as is.
 func Model(existing []StakeholderShares, input RoundInput) domain.DilutionResult {
+	result, _ := ModelWithConversions(existing, input, nil)
+	return result
+}
+
+// maxConversionIterations bounds the search for a round price consistent
+// with the shares converting instruments receive at it.
+const maxConversionIterations = 100
+
+// ModelWithConversions models the round as Model does, with the SAFEs and
+// notes convert converts at the round price joining the post-round cap
+// table. Their shares count in the pre-money, as existing shares do, so the
+// new investor still buys raised / post-money of the company. Discount and
+// round-price conversions depend on the price they help set, so the price
+// is iterated until the converted shares are stable.
+func ModelWithConversions(existing []StakeholderShares, input RoundInput, convert Converter) (domain.DilutionResult, error) {
 	hundred := decimal.NewFromInt(100)
 
 	totalExisting := decimal.Zero
 	for _, s := range existing {
 		totalExisting = totalExisting.Add(s.Shares)
 	}
+	preTotal := totalExisting.Add(input.ExistingPool)
 
 	postMoneyVal := input.PreMoneyVal.Add(input.AmountRaised)
-	pps := input.PreMoneyVal.Div(totalExisting)
-	newShares := input.AmountRaised.Div(pps).RoundFloor(4)
-	totalPost := totalExisting.Add(newShares)
+	tolerance := decimal.New(1, -4)
+	var conversions []Conversion
+	converted := decimal.Zero
+	var poolIncrease, preMoneyShares, pps decimal.Decimal
+	for iter := 0; ; iter++ {
+		poolIncrease = decimal.Zero
+		if input.TargetPoolPct.IsPositive() {
+			investorFraction := input.AmountRaised.Div(postMoneyVal)
+			poolFraction := input.TargetPoolPct.Div(hundred)
+			targetTotal := totalExisting.Add(converted).Div(decimal.NewFromInt(1).Sub(investorFraction).Sub(poolFraction))
+			poolIncrease = decimal.Max(targetTotal.Mul(poolFraction).Sub(input.ExistingPool), decimal.Zero).RoundFloor(4)
+		}
+		preMoneyShares = preTotal.Add(poolIncrease).Add(converted)
+		pps = input.PreMoneyVal.Div(preMoneyShares)
+		if convert == nil {
+			break
+		}
+		if iter == maxConversionIterations {
+			return domain.DilutionResult{}, &domain.ErrValidation{Field: "preMoneyValuation", Message: "no round price is consistent with the converting instruments"}
+		}
+
+		next, err := convert(pps, poolIncrease, preTotal)
+		if err != nil {
+			return domain.DilutionResult{}, err
+		}
+		total := decimal.Zero
+		for _, c := range next {
+			total = total.Add(c.Shares)
+		}
+		conversions = next
+		if total.Sub(converted).Abs().LessThanOrEqual(tolerance) {
+			preMoneyShares = preTotal.Add(poolIncrease).Add(total)
+			break
+		}
+		converted = total
+	}
+
+	allocations := input.Allocations
+	if len(allocations) == 0 {
+		allocations = []Allocation{{InvestorName: input.InvestorName, Amount: input.AmountRaised}}
+	}
+	allocated := decimal.Zero
+	newShares := decimal.Zero
+	investors := make([]domain.CapTableEntry, len(allocations))
+	names := make([]string, len(allocations))
+	for i, a := range allocations {
+		names[i] = a.InvestorName
+		investors[i] = domain.CapTableEntry{
+			StakeholderID:   a.StakeholderID,
+			StakeholderName: a.InvestorName,
+			ShareClassName:  input.NewShareClass,
+			Shares:          a.Amount.Div(pps).RoundFloor(4),
+		}
+		allocated = allocated.Add(a.Amount)
+		newShares = newShares.Add(investors[i].Shares)
+	}
+	totalPost := preMoneyShares.Add(newShares)
 
 	// Pre-round snapshot
-	preEntries := make([]domain.CapTableEntry, len(existing))
+	preEntries := make([]domain.CapTableEntry, len(existing), len(existing)+1)
 	for i, s := range existing {
 		preEntries[i] = domain.CapTableEntry{
 			StakeholderID:   s.StakeholderID,
 			StakeholderName: s.StakeholderName,
 			ShareClassName:  s.ShareClassName,
 			Shares:          s.Shares,
-			OwnershipPct:    s.Shares.Div(totalExisting).Mul(hundred).RoundFloor(4),
+			OwnershipPct:    s.Shares.Div(preTotal).Mul(hundred).RoundFloor(4),
 		}
 	}
+	if input.ExistingPool.IsPositive() {
+		preEntries = append(preEntries, domain.CapTableEntry{
+			StakeholderName: captable.PoolEntryName,
+			Shares:          input.ExistingPool,
+			OwnershipPct:    input.ExistingPool.Div(preTotal).Mul(hundred).RoundFloor(4),
+		})
+	}
 
-	// Post-round snapshot
-	postEntries := make([]domain.CapTableEntry, len(existing))
+	// Post-round snapshot. An existing stakeholder's new shares join their
+	// entry in the round's class, or else their first entry, which then
+	// lists both classes.
+	postEntries := make([]domain.CapTableEntry, len(existing), len(existing)+len(investors)+1)
+	entryOf := make(map[string]int, len(existing))
 	for i, s := range existing {
 		postEntries[i] = domain.CapTableEntry{
 			StakeholderID:   s.StakeholderID,
 			StakeholderName: s.StakeholderName,
 			ShareClassName:  s.ShareClassName,
 			Shares:          s.Shares,
-			OwnershipPct:    s.Shares.Div(totalPost).Mul(hundred).RoundFloor(4),
 		}
+		if j, ok := entryOf[s.StakeholderID]; !ok || (s.ShareClassName == input.NewShareClass && existing[j].ShareClassName != input.NewShareClass) {
+			entryOf[s.StakeholderID] = i
+		}
+	}
+	if pool := input.ExistingPool.Add(poolIncrease); pool.IsPositive() {
+		postEntries = append(postEntries, domain.CapTableEntry{
+			StakeholderName: captable.PoolEntryName,
+			Shares:          pool,
+		})
+	}
+	conversionEntries := make([]domain.CapTableEntry, len(conversions))
+	for i, c := range conversions {
+		conversionEntries[i] = domain.CapTableEntry{
+			StakeholderID:   c.StakeholderID,
+			StakeholderName: c.StakeholderName,
+			ShareClassName:  input.NewShareClass,
+			Shares:          c.Shares,
+		}
+	}
+	for _, inv := range append(conversionEntries, investors...) {
+		j, ok := entryOf[inv.StakeholderID]
+		if inv.StakeholderID == "" || !ok {
+			postEntries = append(postEntries, inv)
+			continue
+		}
+		e := &postEntries[j]
+		e.Shares = e.Shares.Add(inv.Shares)
+		if e.ShareClassName != input.NewShareClass {
+			e.ShareClassName += ", " + input.NewShareClass
+		}
+	}
+	for i := range postEntries {
+		postEntries[i].OwnershipPct = postEntries[i].Shares.Div(totalPost).Mul(hundred).RoundFloor(4)
+	}
+	for i := range investors {
+		investors[i].OwnershipPct = investors[i].Shares.Div(totalPost).Mul(hundred).RoundFloor(4)
+	}
+	for i := range conversionEntries {
+		conversionEntries[i].OwnershipPct = conversionEntries[i].Shares.Div(totalPost).Mul(hundred).RoundFloor(4)
 	}
 
+	// Named after every allocation's investor, so a round modeled with
+	// allocations has no blank holder.
 	newInvestor := domain.CapTableEntry{
-		StakeholderName: input.InvestorName,
+		StakeholderName: strings.Join(names, ", "),
 		ShareClassName:  input.NewShareClass,
 		Shares:          newShares,
 		OwnershipPct:    newShares.Div(totalPost).Mul(hundred).RoundFloor(4),
 	}
 
-	_ = postMoneyVal
+	// Attribute the existing holders' loss of ownership, in percentage
+	// points, to the pool increase, then the converting instruments, then
+	// the new money.
+	beforePool := totalExisting.Div(preTotal)
+	afterPool := totalExisting.Div(preTotal.Add(poolIncrease))
+	afterConversions := totalExisting.Div(preMoneyShares)
+	afterRound := totalExisting.Div(totalPost)
 
 	return domain.DilutionResult{
 		PreRound: domain.CapTableSnapshot{
-			TotalShares: totalExisting,
+			TotalShares: preTotal,
 			Entries:     preEntries,
 		},
 		PostRound: domain.CapTableSnapshot{
 			TotalShares: totalPost,
-			Entries:     append(postEntries, newInvestor),
+			Entries:     postEntries,
 		},
 		NewInvestor: newInvestor,
 		RoundName:   input.RoundName,
-	}
+
+		Investors:          investors,
+		AllocatedAmount:    allocated,
+		AllocationMismatch: !allocated.Equal(input.AmountRaised),
+
+		EffectivePPS:        pps,
+		PoolIncrease:        poolIncrease,
+		PoolDilutionPct:     beforePool.Sub(afterPool).Mul(hundred).RoundFloor(4),
+		NewMoneyDilutionPct: afterConversions.Sub(afterRound).Mul(hundred).RoundFloor(4),
+
+		Conversions:           conversionEntries,
+		ConversionDilutionPct: afterPool.Sub(afterConversions).Mul(hundred).RoundFloor(4),
+	}, nil
 }
@@ -3,6 +3,7 @@ package dilution
 import (
 	"testing"
 
+	"github.com/hutfut/vestigo/internal/engine/captable"
 	"github.com/shopspring/decimal"
 )
 
@@ -124,3 +125,196 @@ func TestModel_DilutionImpact(t *testing.T) {
 		t.Errorf("founder post-round ownership = %s, want %s", founderPost, expectedPostPct)
 	}
 }
+
+func TestModel_PoolTopUp(t *testing.T) {
+	// $2M on $8M pre is 20% to the investor. A 10% post-money pool makes
+	// the post-money total 7M / (1 - 0.2 - 0.1) = 10M shares.
+	existing := []StakeholderShares{
+		{StakeholderID: "f1", StakeholderName: "Founder", ShareClassName: "Common", Shares: dec("7000000")},
+	}
+
+	tests := []struct {
+		name             string
+		existingPool     string
+		wantIncrease     string
+		wantPPS          string
+		wantNewShares    string
+		wantPoolPct      string
+		wantPoolDilution string
+		wantNewMoney     string
+	}{
+		{"no existing pool", "0", "1000000", "1", "2000000", "10", "12.5", "17.5"},
+		{"tops up an existing pool", "400000", "600000", "1", "2000000", "10", "7.0945", "17.5"},
+		{"pool already at target", "1500000", "0", "0.9411764705882353", "2124999.9999", "14.1176", "0", "16.4705"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Model(existing, RoundInput{
+				RoundName:     "Series A",
+				PreMoneyVal:   dec("8000000"),
+				AmountRaised:  dec("2000000"),
+				NewShareClass: "Preferred A",
+				InvestorName:  "VC Fund",
+				ExistingPool:  dec(tt.existingPool),
+				TargetPoolPct: dec("10"),
+			})
+
+			if !result.PoolIncrease.Equal(dec(tt.wantIncrease)) {
+				t.Errorf("PoolIncrease = %s, want %s", result.PoolIncrease, tt.wantIncrease)
+			}
+			if !result.EffectivePPS.Equal(dec(tt.wantPPS)) {
+				t.Errorf("EffectivePPS = %s, want %s", result.EffectivePPS, tt.wantPPS)
+			}
+			if !result.NewInvestor.Shares.Equal(dec(tt.wantNewShares)) {
+				t.Errorf("NewInvestor.Shares = %s, want %s", result.NewInvestor.Shares, tt.wantNewShares)
+			}
+			pool := result.PostRound.Entries[1]
+			if pool.StakeholderName != captable.PoolEntryName || !pool.OwnershipPct.Equal(dec(tt.wantPoolPct)) {
+				t.Errorf("post-round pool = %s at %s%%, want %s%%", pool.StakeholderName, pool.OwnershipPct, tt.wantPoolPct)
+			}
+			if !result.PoolDilutionPct.Equal(dec(tt.wantPoolDilution)) {
+				t.Errorf("PoolDilutionPct = %s, want %s", result.PoolDilutionPct, tt.wantPoolDilution)
+			}
+			if !result.NewMoneyDilutionPct.Equal(dec(tt.wantNewMoney)) {
+				t.Errorf("NewMoneyDilutionPct = %s, want %s", result.NewMoneyDilutionPct, tt.wantNewMoney)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		targetPct string
+		wantErr   bool
+	}{
+		{"no pool target", "0", false},
+		{"10% pool", "10", false},
+		{"pool and investor take everything", "80", true},
+		{"negative target", "-1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(RoundInput{PreMoneyVal: dec("8000000"), AmountRaised: dec("2000000"), TargetPoolPct: dec(tt.targetPct)})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestModel_Allocations(t *testing.T) {
+	// $8M pre on 8M shares prices the round at $1.
+	existing := []StakeholderShares{
+		{StakeholderID: "f1", StakeholderName: "Founder", ShareClassName: "Common", Shares: dec("7000000")},
+		{StakeholderID: "s1", StakeholderName: "Seed Fund", ShareClassName: "Seed Preferred", Shares: dec("1000000")},
+	}
+	input := RoundInput{
+		RoundName:     "Series A",
+		PreMoneyVal:   dec("8000000"),
+		AmountRaised:  dec("3000000"),
+		NewShareClass: "Series A Preferred",
+		Allocations: []Allocation{
+			{InvestorName: "Lead VC", Amount: dec("2000000")},
+			{InvestorName: "Seed Fund", StakeholderID: "s1", Amount: dec("1000000")},
+		},
+	}
+
+	result := Model(existing, input)
+
+	if result.AllocationMismatch || !result.AllocatedAmount.Equal(dec("3000000")) {
+		t.Errorf("allocated %s (mismatch %v), want 3000000 matching", result.AllocatedAmount, result.AllocationMismatch)
+	}
+	if len(result.Investors) != 2 || !result.Investors[0].Shares.Equal(dec("2000000")) || !result.Investors[1].Shares.Equal(dec("1000000")) {
+		t.Fatalf("Investors = %+v, want 2000000 and 1000000 shares", result.Investors)
+	}
+	if !result.NewInvestor.Shares.Equal(dec("3000000")) || result.NewInvestor.StakeholderName != "Lead VC, Seed Fund" {
+		t.Errorf("NewInvestor = %s with %s shares, want Lead VC, Seed Fund with 3000000", result.NewInvestor.StakeholderName, result.NewInvestor.Shares)
+	}
+
+	entries := result.PostRound.Entries
+	if len(entries) != 3 {
+		t.Fatalf("got %d post-round entries, want the seed fund merged into its row", len(entries))
+	}
+	seed := entries[1]
+	if seed.StakeholderID != "s1" || !seed.Shares.Equal(dec("2000000")) || seed.ShareClassName != "Seed Preferred, Series A Preferred" {
+		t.Errorf("seed fund entry = %+v, want 2000000 shares across both classes", seed)
+	}
+	if !seed.OwnershipPct.Equal(dec("18.1818")) {
+		t.Errorf("seed fund OwnershipPct = %s, want 18.1818", seed.OwnershipPct)
+	}
+	if lead := entries[2]; lead.StakeholderName != "Lead VC" || !lead.Shares.Equal(dec("2000000")) {
+		t.Errorf("lead entry = %+v, want Lead VC with 2000000 shares", lead)
+	}
+
+	input.Allocations = input.Allocations[:1]
+	result = Model(existing, input)
+	if !result.AllocationMismatch || !result.AllocatedAmount.Equal(dec("2000000")) {
+		t.Errorf("allocated %s (mismatch %v), want 2000000 flagged", result.AllocatedAmount, result.AllocationMismatch)
+	}
+}
+
+func TestModelWithConversions(t *testing.T) {
+	// $2M on $10M pre with a $1M SAFE at a 20% discount outstanding. The
+	// SAFE's shares count in the pre-money, so with C converted shares the
+	// price is 10M / (8M + C) and C = 1M / (0.8 x price), giving C = 8M / 7
+	// and a price of $1.09375.
+	existing := []StakeholderShares{
+		{StakeholderID: "f1", StakeholderName: "Founder", ShareClassName: "Common", Shares: dec("8000000")},
+	}
+	input := RoundInput{
+		RoundName:     "Series A",
+		PreMoneyVal:   dec("10000000"),
+		AmountRaised:  dec("2000000"),
+		NewShareClass: "Series A Preferred",
+		InvestorName:  "Lead VC",
+	}
+	convert := func(pps, poolIncrease, preRoundShares decimal.Decimal) ([]Conversion, error) {
+		return []Conversion{{
+			StakeholderID:   "s1",
+			StakeholderName: "SAFE Holder",
+			Shares:          dec("1000000").Div(pps.Mul(dec("0.8"))).RoundFloor(4),
+		}}, nil
+	}
+
+	result, err := ModelWithConversions(existing, input, convert)
+	if err != nil {
+		t.Fatalf("ModelWithConversions() error = %v", err)
+	}
+
+	near := func(got decimal.Decimal, want string) bool {
+		return got.Sub(dec(want)).Abs().LessThan(dec("0.01"))
+	}
+	if !near(result.EffectivePPS, "1.09375") {
+		t.Errorf("EffectivePPS = %s, want 1.09375", result.EffectivePPS)
+	}
+	if len(result.Conversions) != 1 || !near(result.Conversions[0].Shares, "1142857.1428") {
+		t.Fatalf("Conversions = %+v, want 1142857.1428 shares", result.Conversions)
+	}
+	if !near(result.NewInvestor.OwnershipPct, "16.6666") {
+		t.Errorf("NewInvestor.OwnershipPct = %s, want the investor to keep 2M / 12M", result.NewInvestor.OwnershipPct)
+	}
+	if !near(result.ConversionDilutionPct, "12.5") {
+		t.Errorf("ConversionDilutionPct = %s, want 12.5", result.ConversionDilutionPct)
+	}
+	if !near(result.NewMoneyDilutionPct, "14.5833") {
+		t.Errorf("NewMoneyDilutionPct = %s, want 14.5833", result.NewMoneyDilutionPct)
+	}
+
+	entries := result.PostRound.Entries
+	if len(entries) != 3 || entries[1].StakeholderName != "SAFE Holder" || entries[1].ShareClassName != "Series A Preferred" {
+		t.Fatalf("post-round entries = %+v, want the SAFE holder before the investor", entries)
+	}
+	sum := decimal.Zero
+	for _, e := range entries {
+		sum = sum.Add(e.Shares)
+	}
+	if !sum.Equal(result.PostRound.TotalShares) {
+		t.Errorf("post-round entries sum to %s, want TotalShares %s", sum, result.PostRound.TotalShares)
+	}
+
+	plain := Model(existing, input)
+	if len(plain.Conversions) != 0 || !plain.ConversionDilutionPct.IsZero() {
+		t.Errorf("Model() converted %+v, want no conversions", plain.Conversions)
+	}
+}
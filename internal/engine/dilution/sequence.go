@@ -0,0 +1,110 @@
+package dilution
+
+import (
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/hutfut/vestigo/internal/engine/captable"
+	"github.com/shopspring/decimal"
+)
+
+// ModelSequence models rounds one after another, each against the previous
+// round's post-round cap table: its entries become the next round's existing
+// holders and its pool the next round's existing pool. The first round
+// starts from existing and its own ExistingPool. Each round converts the
+// SAFEs and notes its converter in converters converts, so an instrument the
+// first round does not qualify can convert at a later one; a missing or nil
+// converter converts nothing.
+//
+// Each stakeholder's ownership is traced from before the first round
+// through every round. Stakeholders are matched by ID, or by name for
+// investors modeled without one and for the pool.
+func ModelSequence(existing []StakeholderShares, rounds []RoundInput, converters []Converter) (domain.RoundSequenceResult, error) {
+	var result domain.RoundSequenceResult
+	if len(rounds) == 0 {
+		return result, nil
+	}
+
+	holders := existing
+	pool := rounds[0].ExistingPool
+	for i, input := range rounds {
+		input.ExistingPool = pool
+		var convert Converter
+		if i < len(converters) {
+			convert = converters[i]
+		}
+		step, err := ModelWithConversions(holders, input, convert)
+		if err != nil {
+			return domain.RoundSequenceResult{}, err
+		}
+		if i == 0 {
+			result.Trajectories = trace(result.Trajectories, step.PreRound, 0, len(rounds)+1)
+		}
+		result.Trajectories = trace(result.Trajectories, step.PostRound, i+1, len(rounds)+1)
+		result.Steps = append(result.Steps, step)
+
+		holders, pool = carryForward(step.PostRound)
+	}
+	return result, nil
+}
+
+// carryForward splits a post-round snapshot into the holders and pool the
+// next round starts from.
+func carryForward(snap domain.CapTableSnapshot) ([]StakeholderShares, decimal.Decimal) {
+	holders := make([]StakeholderShares, 0, len(snap.Entries))
+	pool := decimal.Zero
+	for _, e := range snap.Entries {
+		if e.StakeholderID == "" && e.StakeholderName == captable.PoolEntryName {
+			pool = pool.Add(e.Shares)
+			continue
+		}
+		holders = append(holders, StakeholderShares{
+			StakeholderID:   e.StakeholderID,
+			StakeholderName: e.StakeholderName,
+			ShareClassName:  e.ShareClassName,
+			Shares:          e.Shares,
+		})
+	}
+	return holders, pool
+}
+
+// trace records each stakeholder's ownership in snap as point n of their
+// trajectory, adding trajectories for stakeholders not seen before. Points
+// before a stakeholder appears stay zero.
+func trace(trajectories []domain.OwnershipTrajectory, snap domain.CapTableSnapshot, n, points int) []domain.OwnershipTrajectory {
+	index := make(map[string]int, len(trajectories))
+	for i, t := range trajectories {
+		index[holderKey(t.StakeholderID, t.StakeholderName)] = i
+	}
+
+	shares := make(map[string]decimal.Decimal, len(snap.Entries))
+	for _, e := range snap.Entries {
+		k := holderKey(e.StakeholderID, e.StakeholderName)
+		if _, ok := index[k]; !ok {
+			index[k] = len(trajectories)
+			t := domain.OwnershipTrajectory{
+				StakeholderID:   e.StakeholderID,
+				StakeholderName: e.StakeholderName,
+				OwnershipPct:    make([]decimal.Decimal, points),
+			}
+			for j := range t.OwnershipPct {
+				t.OwnershipPct[j] = decimal.Zero
+			}
+			trajectories = append(trajectories, t)
+		}
+		shares[k] = shares[k].Add(e.Shares)
+	}
+
+	hundred := decimal.NewFromInt(100)
+	for k, s := range shares {
+		if snap.TotalShares.IsPositive() {
+			trajectories[index[k]].OwnershipPct[n] = s.Div(snap.TotalShares).Mul(hundred).RoundFloor(4)
+		}
+	}
+	return trajectories
+}
+
+func holderKey(stakeholderID, name string) string {
+	if stakeholderID != "" {
+		return "id:" + stakeholderID
+	}
+	return "name:" + name
+}
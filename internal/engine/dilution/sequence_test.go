@@ -0,0 +1,138 @@
+package dilution
+
+import (
+	"testing"
+
+	"github.com/hutfut/vestigo/internal/engine/captable"
+	"github.com/shopspring/decimal"
+)
+
+func TestModelSequence(t *testing.T) {
+	// A $1M seed at $4M pre on 8M founder shares, then a $5M Series A at
+	// $15M pre topping the pool up to 10% post-money.
+	existing := []StakeholderShares{
+		{StakeholderID: "f1", StakeholderName: "Founder", ShareClassName: "Common", Shares: dec("8000000")},
+	}
+	rounds := []RoundInput{
+		{RoundName: "Seed", PreMoneyVal: dec("4000000"), AmountRaised: dec("1000000"), NewShareClass: "Seed Preferred", InvestorName: "Angel"},
+		{RoundName: "Series A", PreMoneyVal: dec("15000000"), AmountRaised: dec("5000000"), NewShareClass: "Series A Preferred", InvestorName: "Lead VC", TargetPoolPct: dec("10")},
+	}
+
+	result, err := ModelSequence(existing, rounds, nil)
+	if err != nil {
+		t.Fatalf("ModelSequence() error = %v", err)
+	}
+	if len(result.Steps) != 2 {
+		t.Fatalf("got %d steps, want 2", len(result.Steps))
+	}
+
+	seriesA := result.Steps[1]
+	if !seriesA.PreRound.TotalShares.Equal(dec("10000000")) {
+		t.Errorf("Series A pre-round TotalShares = %s, want the seed's 10M post-round", seriesA.PreRound.TotalShares)
+	}
+	// T = 10M / (1 - 0.25 - 0.1), of which 10% is pool.
+	if !seriesA.PoolIncrease.Equal(dec("1538461.5384")) {
+		t.Errorf("Series A PoolIncrease = %s, want 1538461.5384", seriesA.PoolIncrease)
+	}
+
+	near := func(got decimal.Decimal, want string) bool {
+		return got.Sub(dec(want)).Abs().LessThan(dec("0.001"))
+	}
+	want := []struct {
+		name string
+		pct  []string
+	}{
+		{"Founder", []string{"100", "80", "52"}},
+		{"Angel", []string{"0", "20", "13"}},
+		{captable.PoolEntryName, []string{"0", "0", "10"}},
+		{"Lead VC", []string{"0", "0", "25"}},
+	}
+	if len(result.Trajectories) != len(want) {
+		t.Fatalf("got %d trajectories, want %d", len(result.Trajectories), len(want))
+	}
+	for i, w := range want {
+		tr := result.Trajectories[i]
+		if tr.StakeholderName != w.name || len(tr.OwnershipPct) != 3 {
+			t.Fatalf("trajectory %d = %+v, want %s over 3 points", i, tr, w.name)
+		}
+		for j, pct := range w.pct {
+			if !near(tr.OwnershipPct[j], pct) {
+				t.Errorf("%s ownership at point %d = %s, want %s", w.name, j, tr.OwnershipPct[j], pct)
+			}
+		}
+	}
+}
+
+func TestModelSequence_ConvertsInFirstRoundOnly(t *testing.T) {
+	existing := []StakeholderShares{
+		{StakeholderID: "f1", StakeholderName: "Founder", ShareClassName: "Common", Shares: dec("9000000")},
+	}
+	rounds := []RoundInput{
+		{RoundName: "Seed", PreMoneyVal: dec("5000000"), AmountRaised: dec("1000000"), NewShareClass: "Seed Preferred", InvestorName: "Angel"},
+		{RoundName: "Series A", PreMoneyVal: dec("20000000"), AmountRaised: dec("5000000"), NewShareClass: "Series A Preferred", InvestorName: "Lead VC"},
+	}
+	calls := 0
+	convert := func(pps, poolIncrease, preRoundShares decimal.Decimal) ([]Conversion, error) {
+		calls++
+		return []Conversion{{StakeholderID: "s1", StakeholderName: "SAFE Holder", Shares: dec("1000000")}}, nil
+	}
+
+	result, err := ModelSequence(existing, rounds, []Converter{convert})
+	if err != nil {
+		t.Fatalf("ModelSequence() error = %v", err)
+	}
+	if calls == 0 || len(result.Steps[0].Conversions) != 1 {
+		t.Errorf("seed converted %+v, want the SAFE", result.Steps[0].Conversions)
+	}
+	if len(result.Steps[1].Conversions) != 0 {
+		t.Errorf("Series A converted %+v, want nothing left to convert", result.Steps[1].Conversions)
+	}
+	// The SAFE's shares carry into the Series A pre-round.
+	if !result.Steps[1].PreRound.TotalShares.Equal(result.Steps[0].PostRound.TotalShares) {
+		t.Errorf("Series A pre-round TotalShares = %s, want the seed's post-round %s",
+			result.Steps[1].PreRound.TotalShares, result.Steps[0].PostRound.TotalShares)
+	}
+}
+
+func TestModelSequence_ConvertsAtLaterRound(t *testing.T) {
+	// A note that only the Series A qualifies converts there: $1M at a $10M
+	// cap, a tenth of the ~10.8M shares outstanding after the seed.
+	existing := []StakeholderShares{
+		{StakeholderID: "f1", StakeholderName: "Founder", ShareClassName: "Common", Shares: dec("9000000")},
+	}
+	rounds := []RoundInput{
+		{RoundName: "Seed", PreMoneyVal: dec("5000000"), AmountRaised: dec("1000000"), NewShareClass: "Seed Preferred", InvestorName: "Angel"},
+		{RoundName: "Series A", PreMoneyVal: dec("20000000"), AmountRaised: dec("5000000"), NewShareClass: "Series A Preferred", InvestorName: "Lead VC"},
+	}
+	var base decimal.Decimal
+	note := func(pps, poolIncrease, preRoundShares decimal.Decimal) ([]Conversion, error) {
+		base = preRoundShares
+		return []Conversion{{StakeholderID: "n1", StakeholderName: "Noteholder", Shares: preRoundShares.Mul(dec("0.1"))}}, nil
+	}
+
+	result, err := ModelSequence(existing, rounds, []Converter{nil, note})
+	if err != nil {
+		t.Fatalf("ModelSequence() error = %v", err)
+	}
+	if len(result.Steps[0].Conversions) != 0 {
+		t.Errorf("seed converted %+v, want nothing", result.Steps[0].Conversions)
+	}
+	if !base.Equal(result.Steps[0].PostRound.TotalShares) {
+		t.Errorf("note converted against %s shares, want the seed's post-round %s", base, result.Steps[0].PostRound.TotalShares)
+	}
+	conversions := result.Steps[1].Conversions
+	if len(conversions) != 1 || conversions[0].Shares.Sub(dec("1080000")).Abs().GreaterThan(dec("0.01")) {
+		t.Fatalf("Series A converted %+v, want the note's 1080000 shares", conversions)
+	}
+
+	for _, tr := range result.Trajectories {
+		if tr.StakeholderID != "n1" {
+			continue
+		}
+		if !tr.OwnershipPct[0].IsZero() || !tr.OwnershipPct[1].IsZero() || !tr.OwnershipPct[2].IsPositive() {
+			t.Errorf("noteholder trajectory = %v, want ownership only after the Series A", tr.OwnershipPct)
+		}
+		return
+	}
+	t.Error("no trajectory for the noteholder")
+}
@@ -0,0 +1,180 @@
+package note
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// Validate checks a note's terms before it is issued.
+func Validate(note domain.ConvertibleNote) error {
+	if !note.Principal.IsPositive() {
+		return &domain.ErrValidation{Field: "principal", Message: "must be positive"}
+	}
+	if note.InterestRate.IsNegative() {
+		return &domain.ErrValidation{Field: "interestRate", Message: "must not be negative"}
+	}
+	if !note.MaturityDate.After(note.IssueDate) {
+		return &domain.ErrValidation{Field: "maturityDate", Message: "must be after the issue date"}
+	}
+	if d := note.DiscountRate; d != nil && (!d.IsPositive() || !d.LessThan(decimal.NewFromInt(1))) {
+		return &domain.ErrValidation{Field: "discountRate", Message: "must be between 0 and 1"}
+	}
+	return nil
+}
+
+// YearFraction measures the period from from to to as a fraction of a year
+// under the day-count convention. Actual/365 is the default.
+func YearFraction(dc domain.DayCount, from, to time.Time) decimal.Decimal {
+	switch dc {
+	case domain.DayCount30360:
+		d1, d2 := from.Day(), to.Day()
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+		days := 360*(to.Year()-from.Year()) + 30*(int(to.Month())-int(from.Month())) + (d2 - d1)
+		return decimal.NewFromInt(int64(days)).Div(decimal.NewFromInt(360))
+	case domain.DayCountActual360:
+		return decimal.NewFromInt(actualDays(from, to)).Div(decimal.NewFromInt(360))
+	default:
+		return decimal.NewFromInt(actualDays(from, to)).Div(decimal.NewFromInt(365))
+	}
+}
+
+func actualDays(from, to time.Time) int64 {
+	return int64(to.Sub(from).Hours() / 24)
+}
+
+// Accrue computes the note's principal plus interest accrued from its issue
+// date to asOf, rounded to the cent. Interest keeps accruing past maturity
+// until the note is settled.
+func Accrue(note domain.ConvertibleNote, asOf time.Time) domain.NoteAccrual {
+	interest := decimal.Zero
+	if asOf.After(note.IssueDate) {
+		interest = balance(note, asOf).Sub(note.Principal).Round(2)
+	}
+	return domain.NoteAccrual{
+		NoteID:          note.ID,
+		AsOfDate:        asOf,
+		Principal:       note.Principal,
+		AccruedInterest: interest,
+		Balance:         note.Principal.Add(interest),
+	}
+}
+
+// balance is the unrounded principal plus interest on asOf. Compound interest
+// compounds on each anniversary of the issue date, with simple interest for
+// the part year since the last one.
+func balance(note domain.ConvertibleNote, asOf time.Time) decimal.Decimal {
+	one := decimal.NewFromInt(1)
+	if note.InterestType != domain.InterestCompound {
+		return note.Principal.Mul(one.Add(note.InterestRate.Mul(YearFraction(note.DayCount, note.IssueDate, asOf))))
+	}
+
+	total := note.Principal
+	last := note.IssueDate
+	for years := 1; ; years++ {
+		next := note.IssueDate.AddDate(years, 0, 0)
+		if next.After(asOf) {
+			break
+		}
+		total = total.Mul(one.Add(note.InterestRate))
+		last = next
+	}
+	return total.Mul(one.Add(note.InterestRate.Mul(YearFraction(note.DayCount, last, asOf))))
+}
+
+// Convert converts the note's principal and accrued interest into shares at
+// a qualified financing. The conversion price is the lowest of the round
+// price, the discounted round price and the cap price, the valuation cap
+// divided by preMoneyShares, the company's fully diluted capitalization
+// before the round.
+func Convert(note domain.ConvertibleNote, round domain.FundingRound, preMoneyShares decimal.Decimal) (domain.NoteSettlement, error) {
+	if round.RoundDate.Before(note.IssueDate) {
+		return domain.NoteSettlement{}, &domain.ErrValidation{Field: "roundID", Message: "round precedes the note's issue date"}
+	}
+	if note.QualifiedFinancingAmount != nil && round.AmountRaised.LessThan(*note.QualifiedFinancingAmount) {
+		return domain.NoteSettlement{}, &domain.ErrValidation{
+			Field:   "roundID",
+			Message: fmt.Sprintf("round raises %s, below the qualified financing amount of %s", round.AmountRaised, *note.QualifiedFinancingAmount),
+		}
+	}
+
+	effectivePPS := round.PricePerShare
+	method := "round_price"
+
+	if note.ValuationCap != nil && preMoneyShares.IsPositive() {
+		capPPS := note.ValuationCap.Div(preMoneyShares)
+		if capPPS.LessThan(effectivePPS) {
+			effectivePPS = capPPS
+			method = "cap"
+		}
+	}
+
+	if note.DiscountRate != nil && !note.DiscountRate.IsZero() {
+		discountPPS := round.PricePerShare.Mul(decimal.NewFromInt(1).Sub(*note.DiscountRate))
+		if discountPPS.LessThan(effectivePPS) {
+			effectivePPS = discountPPS
+			method = "discount"
+		}
+	}
+
+	return settle(note, round.RoundDate, effectivePPS, method), nil
+}
+
+// Mature settles a note that reached its maturity date without converting.
+// A note with a maturity cap converts at the cap divided by
+// fullyDilutedShares; any other note is repaid with accrued interest.
+func Mature(note domain.ConvertibleNote, date time.Time, fullyDilutedShares decimal.Decimal) (domain.NoteSettlement, error) {
+	if date.Before(note.MaturityDate) {
+		return domain.NoteSettlement{}, &domain.ErrValidation{Field: "settlementDate", Message: "is before the note's maturity date"}
+	}
+	if note.MaturityValuationCap == nil {
+		accrual := Accrue(note, date)
+		return domain.NoteSettlement{
+			NoteID:          note.ID,
+			Date:            date,
+			Principal:       accrual.Principal,
+			AccruedInterest: accrual.AccruedInterest,
+			SharesIssued:    decimal.Zero,
+			EffectivePPS:    decimal.Zero,
+			RepaymentAmount: accrual.Balance,
+			Method:          "repayment",
+		}, nil
+	}
+	if !fullyDilutedShares.IsPositive() {
+		return domain.NoteSettlement{}, &domain.ErrValidation{Field: "fullyDilutedShares", Message: "must be positive to convert at the maturity cap"}
+	}
+	return settle(note, date, note.MaturityValuationCap.Div(fullyDilutedShares), "maturity_cap"), nil
+}
+
+// settle converts the note's balance on date into shares at price.
+func settle(note domain.ConvertibleNote, date time.Time, price decimal.Decimal, method string) domain.NoteSettlement {
+	accrual := Accrue(note, date)
+	return domain.NoteSettlement{
+		NoteID:          note.ID,
+		Date:            date,
+		Principal:       accrual.Principal,
+		AccruedInterest: accrual.AccruedInterest,
+		SharesIssued:    accrual.Balance.Div(price).RoundFloor(4),
+		EffectivePPS:    price,
+		RepaymentAmount: decimal.Zero,
+		Method:          method,
+	}
+}
+
+// LiquidityShares is the number of shares an unsettled note's balance on
+// asOf would convert into at a liquidity event, at its valuation cap divided
+// by capitalization. A note without a cap can only be repaid, so it converts
+// into zero shares.
+func LiquidityShares(note domain.ConvertibleNote, asOf time.Time, capitalization decimal.Decimal) decimal.Decimal {
+	if note.ValuationCap == nil || !note.ValuationCap.IsPositive() || !capitalization.IsPositive() {
+		return decimal.Zero
+	}
+	return Accrue(note, asOf).Balance.Div(note.ValuationCap.Div(capitalization)).RoundFloor(4)
+}
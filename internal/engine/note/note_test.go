@@ -0,0 +1,215 @@
+package note
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+func dec(v string) decimal.Decimal {
+	d, _ := decimal.NewFromString(v)
+	return d
+}
+
+func decPtr(v string) *decimal.Decimal {
+	d := dec(v)
+	return &d
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func baseNote() domain.ConvertibleNote {
+	return domain.ConvertibleNote{
+		ID:           "n1",
+		Principal:    dec("100000"),
+		InterestRate: dec("0.08"),
+		InterestType: domain.InterestSimple,
+		DayCount:     domain.DayCountActual365,
+		IssueDate:    date(2023, 1, 1),
+		MaturityDate: date(2025, 1, 1),
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(*domain.ConvertibleNote)
+		wantField string
+	}{
+		{"valid", func(*domain.ConvertibleNote) {}, ""},
+		{"zero principal", func(n *domain.ConvertibleNote) { n.Principal = decimal.Zero }, "principal"},
+		{"negative rate", func(n *domain.ConvertibleNote) { n.InterestRate = dec("-0.01") }, "interestRate"},
+		{"matures on issue", func(n *domain.ConvertibleNote) { n.MaturityDate = n.IssueDate }, "maturityDate"},
+		{"full discount", func(n *domain.ConvertibleNote) { n.DiscountRate = decPtr("1") }, "discountRate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := baseNote()
+			tt.modify(&n)
+			err := Validate(n)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var ve *domain.ErrValidation
+			if !errors.As(err, &ve) || ve.Field != tt.wantField {
+				t.Errorf("got %v, want ErrValidation on %s", err, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestYearFraction(t *testing.T) {
+	tests := []struct {
+		name     string
+		dc       domain.DayCount
+		from, to time.Time
+		want     string
+	}{
+		{"actual/365 whole year", domain.DayCountActual365, date(2023, 1, 1), date(2024, 1, 1), "1"},
+		{"actual/365 leap year", domain.DayCountActual365, date(2024, 1, 1), date(2024, 3, 1), dec("60").Div(dec("365")).String()},
+		{"actual/360 quarter", domain.DayCountActual360, date(2023, 1, 1), date(2023, 4, 1), "0.25"},
+		{"30/360 month ends", domain.DayCount30360, date(2023, 1, 31), date(2023, 7, 31), "0.5"},
+		{"30/360 February", domain.DayCount30360, date(2023, 1, 30), date(2023, 2, 28), dec("28").Div(dec("360")).String()},
+		{"default is actual/365", "", date(2023, 1, 1), date(2024, 1, 1), "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := YearFraction(tt.dc, tt.from, tt.to); !got.Equal(dec(tt.want)) {
+				t.Errorf("YearFraction = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccrue(t *testing.T) {
+	compound := baseNote()
+	compound.InterestType = domain.InterestCompound
+
+	tests := []struct {
+		name         string
+		note         domain.ConvertibleNote
+		asOf         time.Time
+		wantInterest string
+	}{
+		{"before issue", baseNote(), date(2022, 12, 1), "0"},
+		{"on issue", baseNote(), date(2023, 1, 1), "0"},
+		{"simple, 547 days", baseNote(), date(2024, 7, 1), "11989.04"},
+		{"simple past maturity", baseNote(), date(2025, 1, 1), "16021.92"},
+		{"compound, one year", compound, date(2024, 1, 1), "8000"},
+		{"compound, two years and a half", compound, date(2025, 7, 1), "21267.25"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Accrue(tt.note, tt.asOf)
+			if !got.AccruedInterest.Equal(dec(tt.wantInterest)) {
+				t.Errorf("AccruedInterest = %s, want %s", got.AccruedInterest, tt.wantInterest)
+			}
+			if !got.Balance.Equal(got.Principal.Add(got.AccruedInterest)) {
+				t.Errorf("Balance = %s, want principal plus interest", got.Balance)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	round := domain.FundingRound{
+		AmountRaised:  dec("5000000"),
+		PricePerShare: dec("2"),
+		RoundDate:     date(2024, 1, 1),
+	}
+
+	tests := []struct {
+		name       string
+		cap        *decimal.Decimal
+		discount   *decimal.Decimal
+		wantShares string
+		wantPPS    string
+		wantMethod string
+	}{
+		{"cap binding", decPtr("8000000"), decPtr("0.20"), "108000", "1", "cap"},
+		{"discount binding", decPtr("20000000"), decPtr("0.20"), "67500", "1.6", "discount"},
+		{"no cap or discount", nil, nil, "54000", "2", "round_price"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := baseNote()
+			n.ValuationCap = tt.cap
+			n.DiscountRate = tt.discount
+			got, err := Convert(n, round, dec("8000000"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.AccruedInterest.Equal(dec("8000")) {
+				t.Errorf("AccruedInterest = %s, want 8000", got.AccruedInterest)
+			}
+			if !got.SharesIssued.Equal(dec(tt.wantShares)) {
+				t.Errorf("SharesIssued = %s, want %s", got.SharesIssued, tt.wantShares)
+			}
+			if !got.EffectivePPS.Equal(dec(tt.wantPPS)) {
+				t.Errorf("EffectivePPS = %s, want %s", got.EffectivePPS, tt.wantPPS)
+			}
+			if got.Method != tt.wantMethod {
+				t.Errorf("Method = %q, want %q", got.Method, tt.wantMethod)
+			}
+		})
+	}
+
+	var ve *domain.ErrValidation
+	small := baseNote()
+	small.QualifiedFinancingAmount = decPtr("10000000")
+	if _, err := Convert(small, round, dec("8000000")); !errors.As(err, &ve) {
+		t.Errorf("round below qualified financing: expected ErrValidation, got %v", err)
+	}
+	early := round
+	early.RoundDate = date(2022, 6, 1)
+	if _, err := Convert(baseNote(), early, dec("8000000")); !errors.As(err, &ve) {
+		t.Errorf("round before issue: expected ErrValidation, got %v", err)
+	}
+}
+
+func TestMature(t *testing.T) {
+	got, err := Mature(baseNote(), date(2025, 1, 1), dec("8000000"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "repayment" || !got.RepaymentAmount.Equal(dec("116021.92")) || !got.SharesIssued.IsZero() {
+		t.Errorf("got %s of %s and %s shares, want repayment of 116021.92", got.Method, got.RepaymentAmount, got.SharesIssued)
+	}
+
+	capped := baseNote()
+	capped.MaturityValuationCap = decPtr("4000000")
+	got, err = Mature(capped, date(2025, 1, 1), dec("8000000"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Method != "maturity_cap" || !got.EffectivePPS.Equal(dec("0.5")) || !got.SharesIssued.Equal(dec("232043.84")) {
+		t.Errorf("got %s shares at %s by %s, want 232043.84 at 0.5 by maturity_cap", got.SharesIssued, got.EffectivePPS, got.Method)
+	}
+
+	var ve *domain.ErrValidation
+	if _, err := Mature(baseNote(), date(2024, 12, 31), dec("8000000")); !errors.As(err, &ve) {
+		t.Errorf("before maturity: expected ErrValidation, got %v", err)
+	}
+	if _, err := Mature(capped, date(2025, 1, 1), decimal.Zero); !errors.As(err, &ve) {
+		t.Errorf("no shares to price the maturity cap: expected ErrValidation, got %v", err)
+	}
+}
+
+func TestLiquidityShares(t *testing.T) {
+	capped := baseNote()
+	capped.ValuationCap = decPtr("8000000")
+	if got := LiquidityShares(capped, date(2024, 1, 1), dec("8000000")); !got.Equal(dec("108000")) {
+		t.Errorf("capped: LiquidityShares = %s, want 108000", got)
+	}
+	if got := LiquidityShares(baseNote(), date(2024, 1, 1), dec("8000000")); !got.IsZero() {
+		t.Errorf("uncapped: LiquidityShares = %s, want 0", got)
+	}
+}
@@ -0,0 +1,128 @@
+package safe
+
+import (
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// ConvertAll converts every SAFE converting in a priced round together.
+//
+// Pre-money SAFEs follow the convention that SAFEs are excluded from each
+// other's share base: each converts against preMoneyShares alone, exactly as
+// ConvertPreMoney does.
+//
+// Post-money SAFEs price their cap against the company capitalization, which
+// includes the shares issued to every converting SAFE, their own included.
+// That is circular: a capped SAFE's shares depend on the capitalization and
+// the capitalization depends on its shares. With C the capitalization, E the
+// existing shares plus shares of SAFEs converting at a fixed price, and each
+// capped SAFE receiving investment * C / cap shares:
+//
+//	C = E / (1 - Σ investment/cap)
+//
+// Whether a SAFE's cap binds depends on C in turn. The solve starts with
+// every post-money SAFE at its discount or round price, then repeatedly
+// recomputes C and re-checks every SAFE, pricing it at its cap exactly when
+// the cap price at that C is lower, until no SAFE's pricing changes. Moving
+// a SAFE to its cap gives it more shares and so raises C, which raises every
+// cap price; starting from no caps, C climbs towards the fixed point and
+// each pass only adds SAFEs whose cap binds at the final C as well.
+//
+// Results are returned in the order of safes.
+func ConvertAll(safes []domain.SAFENote, round domain.FundingRound, preMoneyShares decimal.Decimal) ([]domain.SAFEConversionResult, error) {
+	results := make([]domain.SAFEConversionResult, len(safes))
+
+	var post []int
+	convertedPreMoney := decimal.Zero
+	for i, s := range safes {
+		if s.SAFEType == domain.SAFEPostMoney {
+			post = append(post, i)
+			continue
+		}
+		results[i] = Convert(s, round, preMoneyShares)
+		convertedPreMoney = convertedPreMoney.Add(results[i].SharesIssued)
+	}
+	if len(post) == 0 {
+		return results, nil
+	}
+
+	atCap := make(map[int]bool, len(post))
+	var capitalization decimal.Decimal
+	for pass := 0; ; pass++ {
+		if pass > 2*len(post) {
+			return nil, &domain.ErrValidation{Field: "valuationCap", Message: "post-money SAFE conversions do not settle on a capitalization"}
+		}
+
+		fixedShares := preMoneyShares.Add(convertedPreMoney)
+		capFraction := decimal.Zero
+		for _, i := range post {
+			s := safes[i]
+			if atCap[i] {
+				capFraction = capFraction.Add(s.InvestmentAmount.Div(*s.ValuationCap))
+			} else {
+				fixedShares = fixedShares.Add(s.InvestmentAmount.Div(fixedPrice(s, round)))
+			}
+		}
+		remaining := decimal.NewFromInt(1).Sub(capFraction)
+		if !remaining.IsPositive() {
+			return nil, &domain.ErrValidation{
+				Field:   "valuationCap",
+				Message: fmt.Sprintf("post-money SAFEs converting at their caps would own %s%% of the company", capFraction.Mul(decimal.NewFromInt(100)).StringFixed(2)),
+			}
+		}
+		capitalization = fixedShares.Div(remaining)
+
+		changed := false
+		for _, i := range post {
+			binds := hasCap(safes[i]) && safes[i].ValuationCap.Div(capitalization).LessThan(fixedPrice(safes[i], round))
+			if binds != atCap[i] {
+				atCap[i] = binds
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	for _, i := range post {
+		s := safes[i]
+		result := domain.SAFEConversionResult{SAFEID: s.ID, InheritedTermsFrom: s.InheritedTermsFrom}
+		if atCap[i] {
+			result.EffectivePPS = s.ValuationCap.Div(capitalization)
+			result.ConversionMethod = "cap"
+		} else {
+			result.EffectivePPS = fixedPrice(s, round)
+			result.ConversionMethod = fixedMethod(s, round)
+		}
+		result.SharesIssued = s.InvestmentAmount.Div(result.EffectivePPS).RoundFloor(4)
+		results[i] = result
+	}
+	return results, nil
+}
+
+func hasCap(s domain.SAFENote) bool {
+	return s.ValuationCap != nil && !s.ValuationCap.IsZero()
+}
+
+func hasDiscount(s domain.SAFENote) bool {
+	return s.DiscountRate != nil && !s.DiscountRate.IsZero()
+}
+
+// fixedPrice is the lower of the round price and the discount price: the
+// conversion price of a SAFE whose cap does not bind.
+func fixedPrice(s domain.SAFENote, round domain.FundingRound) decimal.Decimal {
+	if hasDiscount(s) {
+		return decimal.Min(round.PricePerShare, round.PricePerShare.Mul(decimal.NewFromInt(1).Sub(*s.DiscountRate)))
+	}
+	return round.PricePerShare
+}
+
+func fixedMethod(s domain.SAFENote, round domain.FundingRound) string {
+	if fixedPrice(s, round).LessThan(round.PricePerShare) {
+		return "discount"
+	}
+	return "round_price"
+}
@@ -0,0 +1,158 @@
+package safe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+func TestConvertAll(t *testing.T) {
+	round := domain.FundingRound{PricePerShare: dec("2.00")}
+
+	type want struct {
+		shares string
+		pps    string
+		method string
+	}
+	tests := []struct {
+		name           string
+		safes          []domain.SAFENote
+		preMoneyShares string
+		want           []want
+	}{
+		{
+			name: "single post-money SAFE matches ConvertPostMoney",
+			safes: []domain.SAFENote{
+				{ID: "a", InvestmentAmount: dec("500000"), ValuationCap: decPtr("5000000"), SAFEType: domain.SAFEPostMoney},
+			},
+			preMoneyShares: "5000000",
+			want:           []want{{"555555.5555", "0.9", "cap"}},
+		},
+		{
+			name: "post-money SAFEs include each other in the capitalization",
+			safes: []domain.SAFENote{
+				{ID: "a", InvestmentAmount: dec("1000000"), ValuationCap: decPtr("10000000"), SAFEType: domain.SAFEPostMoney},
+				{ID: "b", InvestmentAmount: dec("1000000"), ValuationCap: decPtr("10000000"), SAFEType: domain.SAFEPostMoney},
+			},
+			// C = 8M / (1 - 0.1 - 0.1) = 10M, so each converts at 10M / 10M = 1.00
+			// and owns exactly 10% of the capitalization.
+			preMoneyShares: "8000000",
+			want:           []want{{"1000000", "1", "cap"}, {"1000000", "1", "cap"}},
+		},
+		{
+			name: "a SAFE whose discount beats its cap leaves the cap set",
+			safes: []domain.SAFENote{
+				{ID: "a", InvestmentAmount: dec("1000000"), ValuationCap: decPtr("10000000"), SAFEType: domain.SAFEPostMoney},
+				{ID: "b", InvestmentAmount: dec("500000"), ValuationCap: decPtr("50000000"), DiscountRate: decPtr("0.20"), SAFEType: domain.SAFEPostMoney},
+			},
+			// b converts at the 1.60 discount price into 312,500 shares.
+			// C = (8M + 312.5K) / (1 - 0.1) = 9,236,111.11; a's price = 10M / C.
+			preMoneyShares: "8000000",
+			want:           []want{{"923611.1111", "1.0827067669172932", "cap"}, {"312500", "1.6", "discount"}},
+		},
+		{
+			name: "pre-money SAFEs are excluded from each other's share base",
+			safes: []domain.SAFENote{
+				{ID: "a", InvestmentAmount: dec("1000000"), ValuationCap: decPtr("10000000"), SAFEType: domain.SAFEPreMoney},
+				{ID: "b", InvestmentAmount: dec("500000"), ValuationCap: decPtr("5000000"), SAFEType: domain.SAFEPreMoney},
+			},
+			preMoneyShares: "10000000",
+			want:           []want{{"1000000", "1", "cap"}, {"1000000", "0.5", "cap"}},
+		},
+		{
+			name: "pre-money SAFE shares count in the post-money capitalization",
+			safes: []domain.SAFENote{
+				{ID: "pre", InvestmentAmount: dec("1000000"), ValuationCap: decPtr("10000000"), SAFEType: domain.SAFEPreMoney},
+				{ID: "post", InvestmentAmount: dec("1000000"), ValuationCap: decPtr("12000000"), SAFEType: domain.SAFEPostMoney},
+			},
+			// C = (10M + 1M) / (1 - 1/12) = 12M
+			preMoneyShares: "10000000",
+			want:           []want{{"1000000", "1", "cap"}, {"1000000", "1", "cap"}},
+		},
+		{
+			name: "uncapped post-money SAFE converts at the round price",
+			safes: []domain.SAFENote{
+				{ID: "a", InvestmentAmount: dec("100000"), SAFEType: domain.SAFEPostMoney},
+			},
+			preMoneyShares: "10000000",
+			want:           []want{{"50000", "2", "round_price"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertAll(tt.safes, round, dec(tt.preMoneyShares))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d results, want %d", len(got), len(tt.want))
+			}
+			for i, w := range tt.want {
+				if got[i].SAFEID != tt.safes[i].ID {
+					t.Errorf("[%d] SAFEID = %s, want %s", i, got[i].SAFEID, tt.safes[i].ID)
+				}
+				if !got[i].SharesIssued.Equal(dec(w.shares)) {
+					t.Errorf("[%d] SharesIssued = %s, want %s", i, got[i].SharesIssued, w.shares)
+				}
+				if !got[i].EffectivePPS.Equal(dec(w.pps)) {
+					t.Errorf("[%d] EffectivePPS = %s, want %s", i, got[i].EffectivePPS, w.pps)
+				}
+				if got[i].ConversionMethod != w.method {
+					t.Errorf("[%d] ConversionMethod = %s, want %s", i, got[i].ConversionMethod, w.method)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertAll_CapsOversubscribed(t *testing.T) {
+	safes := []domain.SAFENote{
+		{ID: "a", InvestmentAmount: dec("600000"), ValuationCap: decPtr("1000000"), SAFEType: domain.SAFEPostMoney},
+		{ID: "b", InvestmentAmount: dec("600000"), ValuationCap: decPtr("1000000"), SAFEType: domain.SAFEPostMoney},
+	}
+	_, err := ConvertAll(safes, domain.FundingRound{PricePerShare: dec("100")}, dec("1000000"))
+	var ve *domain.ErrValidation
+	if !errors.As(err, &ve) {
+		t.Fatalf("expected ErrValidation, got %v", err)
+	}
+}
+
+func TestConvertAll_CapBindsAtFinalCapitalization(t *testing.T) {
+	// Before any cap binds, C = 1000 + 48/0.74 + 203/0.98 + 196/0.97 =
+	// 1474.07, where a's cap price 956 / C is already below its 0.74
+	// discount price. Pricing a at its cap raises C to 1483.70, and a must
+	// stay at its cap price there, 0.6443, rather than its discount price.
+	safes := []domain.SAFENote{
+		{ID: "a", InvestmentAmount: dec("48"), ValuationCap: decPtr("956"), DiscountRate: decPtr("0.26"), SAFEType: domain.SAFEPostMoney},
+		{ID: "b", InvestmentAmount: dec("203"), ValuationCap: decPtr("2361"), DiscountRate: decPtr("0.02"), SAFEType: domain.SAFEPostMoney},
+		{ID: "c", InvestmentAmount: dec("196"), ValuationCap: decPtr("2546"), DiscountRate: decPtr("0.03"), SAFEType: domain.SAFEPostMoney},
+	}
+	got, err := ConvertAll(safes, domain.FundingRound{PricePerShare: dec("1")}, dec("1000"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct{ shares, method string }{
+		{"74.4954", "cap"},
+		{"207.1428", "discount"},
+		{"202.0618", "discount"},
+	}
+	capitalization := dec("1000")
+	for i, w := range want {
+		if !got[i].SharesIssued.Equal(dec(w.shares)) || got[i].ConversionMethod != w.method {
+			t.Errorf("[%d] = %s shares by %s, want %s by %s", i, got[i].SharesIssued, got[i].ConversionMethod, w.shares, w.method)
+		}
+		capitalization = capitalization.Add(got[i].SharesIssued)
+	}
+
+	// No SAFE converts above its cap price at the final capitalization.
+	tolerance := dec("0.0001")
+	for i, s := range safes {
+		capPrice := s.ValuationCap.Div(capitalization)
+		if got[i].EffectivePPS.Sub(capPrice).GreaterThan(tolerance) {
+			t.Errorf("[%d] EffectivePPS = %s, above the cap price %s", i, got[i].EffectivePPS, capPrice)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package safe
+
+import (
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// Capitalizations builds the company capitalization under each definition
+// for a round, from the company's grants and option pools and the round's
+// pool increase and promised options.
+func Capitalizations(grants []domain.Grant, pools []domain.OptionPool, round domain.FundingRound) map[domain.CapitalizationDefinition]domain.Capitalization {
+	base := outstandingCapitalization(grants)
+
+	fd := FullyDiluted(grants, pools)
+	available := fd.UnissuedPool
+	fd.PromisedOptions = round.PromisedOptions
+	fd.UnissuedPool = decimal.Max(available.Sub(round.PromisedOptions), decimal.Zero)
+	// The increase counts only as far as it covers promised options the
+	// existing pool cannot.
+	shortfall := decimal.Max(round.PromisedOptions.Sub(available), decimal.Zero)
+	fd.PoolIncrease = decimal.Min(round.OptionPoolIncrease, shortfall)
+	fd.Total = fd.IssuedShares.Add(fd.IssuedOptions).Add(fd.PromisedOptions).Add(fd.UnissuedPool).Add(fd.PoolIncrease)
+
+	withIncrease := fd
+	withIncrease.Definition = domain.CapitalizationFullyDilutedWithPoolIncrease
+	withIncrease.PoolIncrease = round.OptionPoolIncrease
+	withIncrease.Total = fd.Total.Sub(fd.PoolIncrease).Add(round.OptionPoolIncrease)
+
+	return map[domain.CapitalizationDefinition]domain.Capitalization{
+		base.Definition:         base,
+		fd.Definition:           fd,
+		withIncrease.Definition: withIncrease,
+	}
+}
+
+// FullyDiluted builds the company's fully diluted capitalization outside
+// any round, from its grants and option pools: the issued shares and
+// options plus every share still available in the pools.
+func FullyDiluted(grants []domain.Grant, pools []domain.OptionPool) domain.Capitalization {
+	fd := outstandingCapitalization(grants)
+	fd.Definition = domain.CapitalizationFullyDiluted
+	for _, p := range pools {
+		fd.UnissuedPool = fd.UnissuedPool.Add(p.AvailableShares())
+	}
+	fd.Total = fd.Total.Add(fd.UnissuedPool)
+	return fd
+}
+
+// outstandingCapitalization counts the issued shares and options of grants.
+func outstandingCapitalization(grants []domain.Grant) domain.Capitalization {
+	base := domain.Capitalization{Definition: domain.CapitalizationOutstanding}
+	for _, g := range grants {
+		outstanding := g.OutstandingQuantity()
+		if g.IssuedAtGrant() {
+			base.IssuedShares = base.IssuedShares.Add(outstanding)
+			continue
+		}
+		exercised := g.TotalExercised()
+		base.IssuedShares = base.IssuedShares.Add(exercised)
+		base.IssuedOptions = base.IssuedOptions.Add(outstanding.Sub(exercised))
+	}
+	base.Total = base.IssuedShares.Add(base.IssuedOptions)
+	return base
+}
+
+// definition is the SAFE's capitalization definition, defaulting to
+// outstanding.
+func definition(s domain.SAFENote) domain.CapitalizationDefinition {
+	if s.CapitalizationDefinition == "" {
+		return domain.CapitalizationOutstanding
+	}
+	return s.CapitalizationDefinition
+}
+
+// ConvertAt converts the SAFE, with its MFN provision resolved against
+// others, against the capitalization its definition selects from caps, and
+// records that capitalization on the result.
+func ConvertAt(safe domain.SAFENote, others []domain.SAFENote, round domain.FundingRound, caps map[domain.CapitalizationDefinition]domain.Capitalization) domain.SAFEConversionResult {
+	capitalization := caps[definition(safe)]
+	result := Convert(ResolveMFN(safe, others, round, capitalization.Total), round, capitalization.Total)
+	result.Capitalization = &capitalization
+	return result
+}
+
+// ConvertAllAt converts safes together as ConvertAll does, each against the
+// capitalization its definition selects from caps, with MFN provisions
+// resolved against others. Post-money SAFEs share a capitalization, so those
+// converting together must use the same definition.
+func ConvertAllAt(safes, others []domain.SAFENote, round domain.FundingRound, caps map[domain.CapitalizationDefinition]domain.Capitalization) ([]domain.SAFEConversionResult, error) {
+	results := make([]domain.SAFEConversionResult, len(safes))
+
+	var post []int
+	var postDef domain.CapitalizationDefinition
+	preMoneyShares := decimal.Zero
+	for i, s := range safes {
+		if s.SAFEType == domain.SAFEPostMoney {
+			if len(post) > 0 && definition(s) != postDef {
+				return nil, &domain.ErrValidation{
+					Field:   "capitalizationDefinition",
+					Message: fmt.Sprintf("post-money SAFEs converting together must share a capitalization definition; got %s and %s", postDef, definition(s)),
+				}
+			}
+			post = append(post, i)
+			postDef = definition(s)
+			continue
+		}
+		results[i] = ConvertAt(s, others, round, caps)
+		preMoneyShares = preMoneyShares.Add(results[i].SharesIssued)
+	}
+	if len(post) == 0 {
+		return results, nil
+	}
+
+	capitalization := caps[postDef]
+	base := capitalization.Total.Add(preMoneyShares)
+	resolved := make([]domain.SAFENote, len(post))
+	for j, i := range post {
+		resolved[j] = ResolveMFN(safes[i], others, round, capitalization.Total)
+	}
+	postResults, err := ConvertAll(resolved, round, base)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range post {
+		results[i] = postResults[j]
+		results[i].Capitalization = &capitalization
+	}
+	return results, nil
+}
@@ -0,0 +1,104 @@
+package safe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+func TestCapitalizations(t *testing.T) {
+	grants := []domain.Grant{
+		{Type: domain.GrantTypeStock, Quantity: dec("8000000")},
+		{Type: domain.GrantTypeRSA, Quantity: dec("500000"), RepurchasedQuantity: dec("100000")},
+		{Quantity: dec("1000000"), ForfeitedQuantity: dec("200000"), Exercises: []domain.GrantExercise{{Quantity: dec("300000")}}},
+	}
+	pools := []domain.OptionPool{{ReservedShares: dec("2000000"), GrantedShares: dec("800000")}}
+
+	tests := []struct {
+		name         string
+		promised     string
+		increase     string
+		def          domain.CapitalizationDefinition
+		wantPool     string
+		wantIncrease string
+		wantTotal    string
+	}{
+		{"outstanding", "100000", "1000000", domain.CapitalizationOutstanding, "0", "0", "9200000"},
+		{"fully diluted leaves out the increase", "100000", "1000000", domain.CapitalizationFullyDiluted, "1100000", "0", "10400000"},
+		{"fully diluted with the increase", "100000", "1000000", domain.CapitalizationFullyDilutedWithPoolIncrease, "1100000", "1000000", "11400000"},
+		{"increase covering promised options counts", "1500000", "1000000", domain.CapitalizationFullyDiluted, "0", "300000", "11000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			round := domain.FundingRound{PromisedOptions: dec(tt.promised), OptionPoolIncrease: dec(tt.increase)}
+			got := Capitalizations(grants, pools, round)[tt.def]
+			if got.Definition != tt.def {
+				t.Errorf("Definition = %s, want %s", got.Definition, tt.def)
+			}
+			if !got.IssuedShares.Equal(dec("8700000")) || !got.IssuedOptions.Equal(dec("500000")) {
+				t.Errorf("issued shares, options = %s, %s, want 8700000, 500000", got.IssuedShares, got.IssuedOptions)
+			}
+			if !got.UnissuedPool.Equal(dec(tt.wantPool)) {
+				t.Errorf("UnissuedPool = %s, want %s", got.UnissuedPool, tt.wantPool)
+			}
+			if !got.PoolIncrease.Equal(dec(tt.wantIncrease)) {
+				t.Errorf("PoolIncrease = %s, want %s", got.PoolIncrease, tt.wantIncrease)
+			}
+			if !got.Total.Equal(dec(tt.wantTotal)) {
+				t.Errorf("Total = %s, want %s", got.Total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestFullyDiluted(t *testing.T) {
+	grants := []domain.Grant{
+		{Type: domain.GrantTypeStock, Quantity: dec("8000000")},
+		{Quantity: dec("1000000"), ForfeitedQuantity: dec("200000"), Exercises: []domain.GrantExercise{{Quantity: dec("300000")}}},
+	}
+	pools := []domain.OptionPool{{ReservedShares: dec("2000000"), GrantedShares: dec("800000")}}
+
+	got := FullyDiluted(grants, pools)
+	if got.Definition != domain.CapitalizationFullyDiluted || !got.UnissuedPool.Equal(dec("1200000")) || !got.Total.Equal(dec("10000000")) {
+		t.Errorf("FullyDiluted = %s with %s unissued, total %s, want fully diluted with 1200000, total 10000000",
+			got.Definition, got.UnissuedPool, got.Total)
+	}
+	if want := Capitalizations(grants, pools, domain.FundingRound{})[domain.CapitalizationFullyDiluted]; !got.Total.Equal(want.Total) {
+		t.Errorf("Total = %s, want %s as for a round without pool terms", got.Total, want.Total)
+	}
+}
+
+func TestConvertAllAt(t *testing.T) {
+	// Outstanding is 8M shares; fully diluted adds a 2M unissued pool.
+	caps := Capitalizations(
+		[]domain.Grant{{Type: domain.GrantTypeStock, Quantity: dec("8000000")}},
+		[]domain.OptionPool{{ReservedShares: dec("2000000")}},
+		domain.FundingRound{},
+	)
+	round := domain.FundingRound{PricePerShare: dec("2")}
+	safes := []domain.SAFENote{
+		{ID: "pre", InvestmentAmount: dec("500000"), ValuationCap: decPtr("5000000"), SAFEType: domain.SAFEPreMoney},
+		{ID: "pre-fd", InvestmentAmount: dec("500000"), ValuationCap: decPtr("5000000"), SAFEType: domain.SAFEPreMoney, CapitalizationDefinition: domain.CapitalizationFullyDiluted},
+	}
+
+	got, err := ConvertAllAt(safes, safes, round, caps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got[0].SharesIssued.Equal(dec("800000")) || !got[0].Capitalization.Total.Equal(dec("8000000")) {
+		t.Errorf("outstanding: %s shares against %s, want 800000 against 8000000", got[0].SharesIssued, got[0].Capitalization.Total)
+	}
+	if !got[1].SharesIssued.Equal(dec("1000000")) || !got[1].Capitalization.Total.Equal(dec("10000000")) {
+		t.Errorf("fully diluted: %s shares against %s, want 1000000 against 10000000", got[1].SharesIssued, got[1].Capitalization.Total)
+	}
+
+	mixed := []domain.SAFENote{
+		{ID: "a", InvestmentAmount: dec("500000"), ValuationCap: decPtr("10000000"), SAFEType: domain.SAFEPostMoney},
+		{ID: "b", InvestmentAmount: dec("500000"), ValuationCap: decPtr("10000000"), SAFEType: domain.SAFEPostMoney, CapitalizationDefinition: domain.CapitalizationFullyDiluted},
+	}
+	var ve *domain.ErrValidation
+	if _, err := ConvertAllAt(mixed, mixed, round, caps); !errors.As(err, &ve) {
+		t.Errorf("mixed post-money definitions: expected ErrValidation, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package safe
+
+import (
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// LiquidityShares is the number of shares an unconverted SAFE would convert
+// into at a liquidity event: its purchase amount divided by the cap price,
+// the valuation cap (less the purchase amount for a post-money SAFE) over
+// capitalization. A SAFE without a cap has no conversion price and can only
+// cash out, so it converts into zero shares.
+func LiquidityShares(safe domain.SAFENote, capitalization decimal.Decimal) decimal.Decimal {
+	if !hasCap(safe) || !capitalization.IsPositive() {
+		return decimal.Zero
+	}
+	companyCap := *safe.ValuationCap
+	if safe.SAFEType == domain.SAFEPostMoney {
+		companyCap = companyCap.Sub(safe.InvestmentAmount)
+	}
+	if !companyCap.IsPositive() {
+		return decimal.Zero
+	}
+	return safe.InvestmentAmount.Div(companyCap.Div(capitalization)).RoundFloor(4)
+}
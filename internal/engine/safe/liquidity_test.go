@@ -0,0 +1,38 @@
+package safe
+
+import (
+	"testing"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+func TestLiquidityShares(t *testing.T) {
+	tests := []struct {
+		name string
+		safe domain.SAFENote
+		want string
+	}{
+		{
+			name: "pre-money cap",
+			safe: domain.SAFENote{InvestmentAmount: dec("500000"), ValuationCap: decPtr("5000000"), SAFEType: domain.SAFEPreMoney},
+			want: "1000000",
+		},
+		{
+			name: "post-money cap",
+			safe: domain.SAFENote{InvestmentAmount: dec("1000000"), ValuationCap: decPtr("11000000"), SAFEType: domain.SAFEPostMoney},
+			want: "1000000",
+		},
+		{
+			name: "uncapped",
+			safe: domain.SAFENote{InvestmentAmount: dec("500000"), DiscountRate: decPtr("0.20")},
+			want: "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LiquidityShares(tt.safe, dec("10000000")); !got.Equal(dec(tt.want)) {
+				t.Errorf("LiquidityShares = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
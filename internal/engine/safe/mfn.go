@@ -0,0 +1,75 @@
+package safe
+
+import (
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// ResolveMFN applies a most-favored-nation SAFE's provision ahead of a priced
+// round. The SAFE adopts the valuation cap and discount of whichever later
+// SAFE, issued after it and on or before the round date, would convert it at
+// the lowest price, provided that beats its own terms. Each candidate is
+// judged by converting the SAFE alone on its terms. Only SAFEs of the same
+// type are compared, since a pre-money cap and a post-money cap measure
+// different things.
+//
+// The returned SAFE carries the adopted terms, with InheritedTermsFrom set to
+// the source SAFE. SAFEs without the provision are returned unchanged.
+func ResolveMFN(safe domain.SAFENote, others []domain.SAFENote, round domain.FundingRound, preMoneyShares decimal.Decimal) domain.SAFENote {
+	if !safe.IsMFN {
+		return safe
+	}
+	best := safe
+	bestPPS := Convert(safe, round, preMoneyShares).EffectivePPS
+	for _, o := range others {
+		if o.ID == safe.ID || !sameType(o, safe) || !o.IssueDate.After(safe.IssueDate) || o.IssueDate.After(round.RoundDate) {
+			continue
+		}
+		candidate := safe
+		candidate.ValuationCap = o.ValuationCap
+		candidate.DiscountRate = o.DiscountRate
+		if pps := Convert(candidate, round, preMoneyShares).EffectivePPS; pps.LessThan(bestPPS) {
+			id := o.ID
+			candidate.InheritedTermsFrom = &id
+			best, bestPPS = candidate, pps
+		}
+	}
+	return best
+}
+
+// MFNBeneficiaries returns the MFN SAFEs to notify when issued is issued:
+// unconverted SAFEs with the provision and of the same type, issued before
+// it, for which it sets a new lowest cap or highest discount among their own
+// terms and those of every SAFE of that type issued since.
+func MFNBeneficiaries(safes []domain.SAFENote, issued domain.SAFENote) []domain.SAFENote {
+	var out []domain.SAFENote
+	for _, s := range safes {
+		if !s.IsMFN || s.IsConverted || s.ID == issued.ID || !sameType(s, issued) || !s.IssueDate.Before(issued.IssueDate) {
+			continue
+		}
+		bestCap, bestDiscount := s.ValuationCap, s.DiscountRate
+		for _, o := range safes {
+			if o.ID == s.ID || o.ID == issued.ID || !sameType(o, s) || !o.IssueDate.After(s.IssueDate) {
+				continue
+			}
+			if hasCap(o) && (bestCap == nil || o.ValuationCap.LessThan(*bestCap)) {
+				bestCap = o.ValuationCap
+			}
+			if hasDiscount(o) && (bestDiscount == nil || o.DiscountRate.GreaterThan(*bestDiscount)) {
+				bestDiscount = o.DiscountRate
+			}
+		}
+		lowerCap := hasCap(issued) && (bestCap == nil || issued.ValuationCap.LessThan(*bestCap))
+		higherDiscount := hasDiscount(issued) && (bestDiscount == nil || issued.DiscountRate.GreaterThan(*bestDiscount))
+		if lowerCap || higherDiscount {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// sameType reports whether a and b are both post-money SAFEs or both
+// pre-money, the default.
+func sameType(a, b domain.SAFENote) bool {
+	return (a.SAFEType == domain.SAFEPostMoney) == (b.SAFEType == domain.SAFEPostMoney)
+}
@@ -0,0 +1,135 @@
+package safe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestResolveMFN(t *testing.T) {
+	round := domain.FundingRound{PricePerShare: dec("2"), RoundDate: day(2025, 6, 1)}
+	mfn := domain.SAFENote{
+		ID:               "mfn",
+		InvestmentAmount: dec("100000"),
+		SAFEType:         domain.SAFEPreMoney,
+		IsMFN:            true,
+		IssueDate:        day(2024, 1, 1),
+	}
+	earlier := domain.SAFENote{ID: "earlier", ValuationCap: decPtr("5000000"), IssueDate: day(2023, 6, 1)}
+	capped := domain.SAFENote{ID: "capped", ValuationCap: decPtr("10000000"), IssueDate: day(2024, 3, 1)}
+	discounted := domain.SAFENote{ID: "discounted", ValuationCap: decPtr("15000000"), DiscountRate: decPtr("0.60"), IssueDate: day(2024, 5, 1)}
+	afterRound := domain.SAFENote{ID: "after", ValuationCap: decPtr("1000000"), IssueDate: day(2025, 7, 1)}
+	postMoney := domain.SAFENote{ID: "post", ValuationCap: decPtr("2000000"), SAFEType: domain.SAFEPostMoney, IssueDate: day(2024, 4, 1)}
+
+	tests := []struct {
+		name       string
+		safe       domain.SAFENote
+		others     []domain.SAFENote
+		wantSource string
+		wantPPS    string
+		wantMethod string
+	}{
+		{
+			name:       "adopts the later SAFE that converts lowest",
+			safe:       mfn,
+			others:     []domain.SAFENote{mfn, earlier, capped, discounted, afterRound},
+			wantSource: "discounted",
+			wantPPS:    "0.8",
+			wantMethod: "discount",
+		},
+		{
+			name:       "ignores SAFEs issued before it or after the round",
+			safe:       mfn,
+			others:     []domain.SAFENote{mfn, earlier, afterRound},
+			wantPPS:    "2",
+			wantMethod: "round_price",
+		},
+		{
+			name: "keeps its own terms when they are better",
+			safe: func() domain.SAFENote {
+				s := mfn
+				s.DiscountRate = decPtr("0.70")
+				return s
+			}(),
+			others:     []domain.SAFENote{capped, discounted},
+			wantPPS:    "0.6",
+			wantMethod: "discount",
+		},
+		{
+			name:       "ignores SAFEs of the other type",
+			safe:       mfn,
+			others:     []domain.SAFENote{mfn, postMoney, capped},
+			wantSource: "capped",
+			wantPPS:    "1",
+			wantMethod: "cap",
+		},
+		{
+			name: "non-MFN SAFEs are unchanged",
+			safe: func() domain.SAFENote {
+				s := mfn
+				s.IsMFN = false
+				return s
+			}(),
+			others:     []domain.SAFENote{capped, discounted},
+			wantPPS:    "2",
+			wantMethod: "round_price",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved := ResolveMFN(tt.safe, tt.others, round, dec("10000000"))
+			got := Convert(resolved, round, dec("10000000"))
+			source := ""
+			if got.InheritedTermsFrom != nil {
+				source = *got.InheritedTermsFrom
+			}
+			if source != tt.wantSource {
+				t.Errorf("InheritedTermsFrom = %q, want %q", source, tt.wantSource)
+			}
+			if !got.EffectivePPS.Equal(dec(tt.wantPPS)) {
+				t.Errorf("EffectivePPS = %s, want %s", got.EffectivePPS, tt.wantPPS)
+			}
+			if got.ConversionMethod != tt.wantMethod {
+				t.Errorf("ConversionMethod = %q, want %q", got.ConversionMethod, tt.wantMethod)
+			}
+		})
+	}
+}
+
+func TestMFNBeneficiaries(t *testing.T) {
+	mfn := domain.SAFENote{ID: "mfn", IsMFN: true, IssueDate: day(2024, 1, 1)}
+	converted := domain.SAFENote{ID: "converted", IsMFN: true, IsConverted: true, IssueDate: day(2024, 1, 1)}
+	laterMFN := domain.SAFENote{ID: "later-mfn", IsMFN: true, IssueDate: day(2024, 9, 1)}
+	prior := domain.SAFENote{ID: "prior", ValuationCap: decPtr("10000000"), IssueDate: day(2024, 3, 1)}
+	existing := []domain.SAFENote{mfn, converted, laterMFN, prior}
+
+	tests := []struct {
+		name   string
+		issued domain.SAFENote
+		want   []string
+	}{
+		{"higher cap, no discount", domain.SAFENote{ID: "n", ValuationCap: decPtr("12000000"), IssueDate: day(2024, 6, 1)}, nil},
+		{"lower cap", domain.SAFENote{ID: "n", ValuationCap: decPtr("8000000"), IssueDate: day(2024, 6, 1)}, []string{"mfn"}},
+		{"first discount", domain.SAFENote{ID: "n", ValuationCap: decPtr("12000000"), DiscountRate: decPtr("0.20"), IssueDate: day(2024, 6, 1)}, []string{"mfn"}},
+		{"issued after a later MFN SAFE", domain.SAFENote{ID: "n", ValuationCap: decPtr("8000000"), IssueDate: day(2024, 10, 1)}, []string{"mfn", "later-mfn"}},
+		{"post-money SAFE with a lower cap", domain.SAFENote{ID: "n", ValuationCap: decPtr("8000000"), SAFEType: domain.SAFEPostMoney, IssueDate: day(2024, 6, 1)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MFNBeneficiaries(append(existing, tt.issued), tt.issued)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d beneficiaries, want %v", len(got), tt.want)
+			}
+			for i, s := range got {
+				if s.ID != tt.want[i] {
+					t.Errorf("beneficiary %d = %s, want %s", i, s.ID, tt.want[i])
+				}
+			}
+		})
+	}
+}
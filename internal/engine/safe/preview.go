@@ -0,0 +1,57 @@
+package safe
+
+import (
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// Preview converts each SAFE on its own at a candidate round, as ConvertAt
+// would, and reports each holder's ownership once the round closes. existing
+// holds the shares each stakeholder already owns. The post-money share count
+// is the outstanding shares plus every SAFE's shares plus the new investors'
+// round.AmountRaised at round.PricePerShare.
+func Preview(safes []domain.SAFENote, round domain.FundingRound, caps map[domain.CapitalizationDefinition]domain.Capitalization, existing map[string]decimal.Decimal) (domain.SAFEConversionPreview, error) {
+	preMoneyShares := caps[domain.CapitalizationOutstanding].Total
+	if !round.PricePerShare.IsPositive() {
+		return domain.SAFEConversionPreview{}, &domain.ErrValidation{Field: "pricePerShare", Message: "must be positive"}
+	}
+	if round.AmountRaised.IsNegative() {
+		return domain.SAFEConversionPreview{}, &domain.ErrValidation{Field: "amountRaised", Message: "must not be negative"}
+	}
+	if !preMoneyShares.IsPositive() {
+		return domain.SAFEConversionPreview{}, &domain.ErrValidation{Field: "companyID", Message: "company has no outstanding shares"}
+	}
+
+	preview := domain.SAFEConversionPreview{
+		Round:       round,
+		Conversions: make([]domain.SAFEConversionResult, len(safes)),
+	}
+	postMoney := preMoneyShares.Add(round.AmountRaised.Div(round.PricePerShare).RoundFloor(4))
+
+	holderIdx := make(map[string]int)
+	for i, s := range safes {
+		result := ConvertAt(s, safes, round, caps)
+		preview.Conversions[i] = result
+		postMoney = postMoney.Add(result.SharesIssued)
+
+		idx, ok := holderIdx[s.StakeholderID]
+		if !ok {
+			idx = len(preview.Holders)
+			holderIdx[s.StakeholderID] = idx
+			preview.Holders = append(preview.Holders, domain.SAFEHolderOwnership{
+				StakeholderID:  s.StakeholderID,
+				ExistingShares: existing[s.StakeholderID],
+			})
+		}
+		h := &preview.Holders[idx]
+		h.ConversionShares = h.ConversionShares.Add(result.SharesIssued)
+	}
+
+	hundred := decimal.NewFromInt(100)
+	for i := range preview.Holders {
+		h := &preview.Holders[i]
+		h.OwnershipPct = h.ExistingShares.Add(h.ConversionShares).Div(postMoney).Mul(hundred).RoundFloor(4)
+	}
+	preview.PostMoneyShares = postMoney
+	return preview, nil
+}
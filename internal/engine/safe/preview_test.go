@@ -0,0 +1,84 @@
+package safe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+func TestPreview(t *testing.T) {
+	safes := []domain.SAFENote{
+		{ID: "s1", StakeholderID: "a", InvestmentAmount: dec("500000"), ValuationCap: decPtr("5000000"), SAFEType: domain.SAFEPreMoney},
+		{ID: "s2", StakeholderID: "b", InvestmentAmount: dec("400000"), DiscountRate: decPtr("0.20"), SAFEType: domain.SAFEPreMoney},
+		{ID: "s3", StakeholderID: "a", InvestmentAmount: dec("100000"), SAFEType: domain.SAFEPreMoney},
+	}
+	existing := map[string]decimal.Decimal{"a": dec("1000000")}
+
+	tests := []struct {
+		name        string
+		pps         string
+		wantShares  []string
+		wantMethods []string
+		wantPost    string
+		wantPctA    string
+		wantPctB    string
+	}{
+		{
+			// Cap price 0.5, discount price 0.8. New investors buy 1M shares.
+			name:        "round at 1.00",
+			pps:         "1",
+			wantShares:  []string{"1000000", "500000", "100000"},
+			wantMethods: []string{"cap", "discount", "round_price"},
+			wantPost:    "12600000",
+			wantPctA:    "16.6666",
+			wantPctB:    "3.9682",
+		},
+		{
+			// Below the cap price, every SAFE converts at the round price or its discount.
+			name:        "round at 0.40",
+			pps:         "0.4",
+			wantShares:  []string{"1250000", "1250000", "250000"},
+			wantMethods: []string{"round_price", "discount", "round_price"},
+			wantPost:    "13750000",
+			wantPctA:    "18.1818",
+			wantPctB:    "9.0909",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			round := domain.FundingRound{PricePerShare: dec(tt.pps), AmountRaised: dec(tt.pps).Mul(dec("1000000"))}
+			got, err := Preview(safes, round, outstandingCaps("10000000"), existing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, c := range got.Conversions {
+				if !c.SharesIssued.Equal(dec(tt.wantShares[i])) || c.ConversionMethod != tt.wantMethods[i] {
+					t.Errorf("SAFE %s: %s shares by %s, want %s by %s", c.SAFEID, c.SharesIssued, c.ConversionMethod, tt.wantShares[i], tt.wantMethods[i])
+				}
+			}
+			if !got.PostMoneyShares.Equal(dec(tt.wantPost)) {
+				t.Errorf("PostMoneyShares = %s, want %s", got.PostMoneyShares, tt.wantPost)
+			}
+			if len(got.Holders) != 2 {
+				t.Fatalf("got %d holders, want 2", len(got.Holders))
+			}
+			if !got.Holders[0].OwnershipPct.Equal(dec(tt.wantPctA)) {
+				t.Errorf("holder a OwnershipPct = %s, want %s", got.Holders[0].OwnershipPct, tt.wantPctA)
+			}
+			if !got.Holders[1].OwnershipPct.Equal(dec(tt.wantPctB)) {
+				t.Errorf("holder b OwnershipPct = %s, want %s", got.Holders[1].OwnershipPct, tt.wantPctB)
+			}
+		})
+	}
+
+	var ve *domain.ErrValidation
+	if _, err := Preview(safes, domain.FundingRound{}, outstandingCaps("10000000"), existing); !errors.As(err, &ve) || ve.Field != "pricePerShare" {
+		t.Errorf("zero price: expected ErrValidation on pricePerShare, got %v", err)
+	}
+}
+
+func outstandingCaps(shares string) map[domain.CapitalizationDefinition]domain.Capitalization {
+	return Capitalizations([]domain.Grant{{Type: domain.GrantTypeStock, Quantity: dec(shares)}}, nil, domain.FundingRound{})
+}
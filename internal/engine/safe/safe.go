@@ -100,10 +100,13 @@ func ConvertPostMoney(safe domain.SAFENote, round domain.FundingRound, preMoneyS
 
 // Convert dispatches to the correct conversion logic based on SAFE type.
 func Convert(safe domain.SAFENote, round domain.FundingRound, preMoneyShares decimal.Decimal) domain.SAFEConversionResult {
+	var result domain.SAFEConversionResult
 	switch safe.SAFEType {
 	case domain.SAFEPostMoney:
-		return ConvertPostMoney(safe, round, preMoneyShares)
+		result = ConvertPostMoney(safe, round, preMoneyShares)
 	default:
-		return ConvertPreMoney(safe, round, preMoneyShares)
+		result = ConvertPreMoney(safe, round, preMoneyShares)
 	}
+	result.InheritedTermsFrom = safe.InheritedTermsFrom
+	return result
 }
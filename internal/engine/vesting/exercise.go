@@ -0,0 +1,98 @@
+package vesting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// withExercises fills in the exercised, released and repurchasable shares of
+// a status. Issued shares count against vesting first, so only those beyond
+// the vested amount are repurchasable.
+func withExercises(grant domain.Grant, status domain.VestingStatus) domain.VestingStatus {
+	issued := grant.IssuedQuantity(status.AsOfDate)
+	if !grant.IssuedAtGrant() {
+		status.ExercisedShares = grant.ExercisedQuantity(status.AsOfDate)
+	}
+	status.ReleasedShares = decimal.Min(issued, status.VestedShares)
+	status.RepurchasableShares = decimal.Max(issued.Sub(status.VestedShares), decimal.Zero)
+	return status
+}
+
+// Exercisable returns how many options of the grant can be exercised on date.
+// Grants that allow early exercise can exercise any outstanding option until
+// the holder leaves; otherwise, and after termination, only vested options
+// are exercisable. Options left after the exercise deadline have expired.
+func Exercisable(grant domain.Grant, date time.Time) decimal.Decimal {
+	if grant.IssuedAtGrant() || (grant.ExerciseDeadline != nil && date.After(*grant.ExerciseDeadline)) {
+		return decimal.Zero
+	}
+	exercised := grant.TotalExercised()
+	limit := Calculate(grant, date).VestedShares
+	if grant.EarlyExerciseAllowed && (grant.TerminationDate == nil || !date.After(*grant.TerminationDate)) {
+		limit = grant.OutstandingQuantity()
+	}
+	return decimal.Max(limit.Sub(exercised), decimal.Zero)
+}
+
+// ValidateExercise checks an exercise of quantity options on date against the
+// grant's vesting, early exercise terms and post-termination deadline.
+func ValidateExercise(grant domain.Grant, quantity decimal.Decimal, date time.Time) error {
+	if grant.IssuedAtGrant() {
+		return &domain.ErrValidation{Field: "grantID", Message: "shares issued at grant have no options to exercise"}
+	}
+	if !quantity.IsPositive() {
+		return &domain.ErrValidation{Field: "quantity", Message: "must be positive"}
+	}
+	if date.Before(grant.GrantDate) {
+		return &domain.ErrValidation{Field: "exerciseDate", Message: "must not be before the grant date"}
+	}
+	if grant.TerminationDate != nil && date.After(*grant.TerminationDate) &&
+		(grant.ExerciseDeadline == nil || date.After(*grant.ExerciseDeadline)) {
+		return &domain.ErrValidation{Field: "exerciseDate", Message: "is after the post-termination exercise deadline"}
+	}
+	if available := Exercisable(grant, date); quantity.GreaterThan(available) {
+		return &domain.ErrValidation{Field: "quantity", Message: fmt.Sprintf("exceeds the %s exercisable options", available)}
+	}
+	return nil
+}
+
+// RepurchasePrice is the per-share price of the company's repurchase right on
+// unvested exercised shares: the lower of the holder's cost and the fair
+// market value. A nil fairMarketValue prices the repurchase at cost.
+func RepurchasePrice(cost decimal.Decimal, fairMarketValue *decimal.Decimal) decimal.Decimal {
+	if fairMarketValue == nil {
+		return cost
+	}
+	return decimal.Min(cost, *fairMarketValue)
+}
+
+// Repurchase exercises the company's right to buy back the shares still
+// subject to repurchase after the holder's departure, moving them to
+// treasury on date. Without a price set at termination, the repurchase is at
+// the holder's cost.
+func Repurchase(grant domain.Grant, date time.Time) (domain.Grant, error) {
+	if grant.TerminationDate == nil {
+		return grant, &domain.ErrConflict{Message: fmt.Sprintf("grant %s holder has not left the company", grant.ID)}
+	}
+	if grant.RepurchaseDate != nil {
+		return grant, &domain.ErrConflict{Message: fmt.Sprintf("grant %s has already been repurchased", grant.ID)}
+	}
+	if date.Before(*grant.TerminationDate) {
+		return grant, &domain.ErrValidation{Field: "repurchaseDate", Message: "must not be before the termination date"}
+	}
+	shares := Calculate(grant, date).RepurchasableShares
+	if !shares.IsPositive() {
+		return grant, &domain.ErrConflict{Message: fmt.Sprintf("grant %s has no shares subject to repurchase", grant.ID)}
+	}
+
+	grant.RepurchasedQuantity = shares
+	grant.RepurchaseDate = &date
+	if grant.RepurchasePrice == nil {
+		price := grant.ExercisePrice
+		grant.RepurchasePrice = &price
+	}
+	return grant, nil
+}
@@ -0,0 +1,260 @@
+package vesting
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+func earlyExerciseGrant(exercises ...domain.GrantExercise) domain.Grant {
+	return domain.Grant{
+		Quantity:             dec("48000"),
+		GrantDate:            date(2024, 1, 1),
+		ExercisePrice:        dec("0.50"),
+		EarlyExerciseAllowed: true,
+		VestingSchedule:      &domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly},
+		Exercises:            exercises,
+	}
+}
+
+func exercise(quantity string, on time.Time) domain.GrantExercise {
+	return domain.GrantExercise{Quantity: dec(quantity), ExerciseDate: on}
+}
+
+func TestCalculate_Repurchasable(t *testing.T) {
+	grant := earlyExerciseGrant(exercise("48000", date(2024, 1, 15)))
+
+	tests := []struct {
+		name              string
+		asOf              time.Time
+		wantExercised     string
+		wantRepurchasable string
+	}{
+		{"before exercise", date(2024, 1, 10), "0", "0"},
+		{"exercised before cliff", date(2024, 6, 1), "48000", "48000"},
+		{"half vested", date(2026, 1, 1), "48000", "24000"},
+		{"fully vested", date(2028, 1, 1), "48000", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Calculate(grant, tt.asOf)
+			if !got.ExercisedShares.Equal(dec(tt.wantExercised)) {
+				t.Errorf("ExercisedShares = %s, want %s", got.ExercisedShares, tt.wantExercised)
+			}
+			if !got.RepurchasableShares.Equal(dec(tt.wantRepurchasable)) {
+				t.Errorf("RepurchasableShares = %s, want %s", got.RepurchasableShares, tt.wantRepurchasable)
+			}
+		})
+	}
+
+	// Exercising fewer shares than have vested leaves nothing to repurchase.
+	partial := earlyExerciseGrant(exercise("10000", date(2025, 6, 1)))
+	if got := Calculate(partial, date(2026, 1, 1)); !got.RepurchasableShares.IsZero() {
+		t.Errorf("RepurchasableShares = %s, want 0", got.RepurchasableShares)
+	}
+}
+
+func TestValidateExercise(t *testing.T) {
+	noEarly := earlyExerciseGrant()
+	noEarly.EarlyExerciseAllowed = false
+
+	terminated := Terminate(earlyExerciseGrant(), date(2026, 1, 1), DefaultExerciseWindowDays, nil)
+
+	tests := []struct {
+		name      string
+		grant     domain.Grant
+		quantity  string
+		on        time.Time
+		wantField string
+	}{
+		{"early exercise of unvested options", earlyExerciseGrant(), "48000", date(2024, 2, 1), ""},
+		{"beyond the grant", earlyExerciseGrant(exercise("40000", date(2024, 2, 1))), "8001", date(2024, 3, 1), "quantity"},
+		{"unvested without early exercise", noEarly, "1", date(2024, 6, 1), "quantity"},
+		{"vested without early exercise", noEarly, "24000", date(2026, 1, 1), ""},
+		{"zero quantity", earlyExerciseGrant(), "0", date(2024, 6, 1), "quantity"},
+		{"before grant date", earlyExerciseGrant(), "100", date(2023, 12, 31), "exerciseDate"},
+		{"vested within the window", terminated, "24000", date(2026, 3, 1), ""},
+		{"unvested after termination", terminated, "24001", date(2026, 3, 1), "quantity"},
+		{"after the deadline", terminated, "100", date(2026, 4, 2), "exerciseDate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExercise(tt.grant, dec(tt.quantity), tt.on)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var ve *domain.ErrValidation
+			if !errors.As(err, &ve) || ve.Field != tt.wantField {
+				t.Fatalf("error = %v, want validation error on %s", err, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestExercisable_Deadline(t *testing.T) {
+	terminated := Terminate(earlyExerciseGrant(), date(2026, 1, 1), DefaultExerciseWindowDays, nil)
+
+	if got := Exercisable(terminated, *terminated.ExerciseDeadline); !got.Equal(dec("24000")) {
+		t.Errorf("Exercisable on the deadline = %s, want the 24000 vested options", got)
+	}
+	if got := Exercisable(terminated, terminated.ExerciseDeadline.AddDate(0, 0, 1)); !got.IsZero() {
+		t.Errorf("Exercisable after the deadline = %s, want 0 as the options have expired", got)
+	}
+}
+
+func TestTerminate_EarlyExercise(t *testing.T) {
+	grant := earlyExerciseGrant(exercise("30000", date(2024, 2, 1)))
+
+	tests := []struct {
+		name              string
+		fmv               string
+		wantForfeited     string
+		wantRepurchasable string
+		wantPrice         string
+	}{
+		{"priced at cost when FMV is higher", "2.00", "18000", "6000", "0.50"},
+		{"priced at FMV when it has fallen below cost", "0.20", "18000", "6000", "0.20"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fmv := dec(tt.fmv)
+			got := Terminate(grant, date(2026, 1, 1), DefaultExerciseWindowDays, &fmv)
+			if !got.ForfeitedQuantity.Equal(dec(tt.wantForfeited)) {
+				t.Errorf("ForfeitedQuantity = %s, want %s", got.ForfeitedQuantity, tt.wantForfeited)
+			}
+			status := Calculate(got, date(2027, 1, 1))
+			if !status.RepurchasableShares.Equal(dec(tt.wantRepurchasable)) {
+				t.Errorf("RepurchasableShares = %s, want %s", status.RepurchasableShares, tt.wantRepurchasable)
+			}
+			if got.RepurchasePrice == nil || !got.RepurchasePrice.Equal(dec(tt.wantPrice)) {
+				t.Errorf("RepurchasePrice = %v, want %s", got.RepurchasePrice, tt.wantPrice)
+			}
+		})
+	}
+
+	// Nothing exercised beyond what vested, so there is no repurchase right.
+	got := Terminate(earlyExerciseGrant(exercise("1000", date(2025, 6, 1))), date(2026, 1, 1), DefaultExerciseWindowDays, nil)
+	if got.RepurchasePrice != nil {
+		t.Errorf("RepurchasePrice = %v, want none", got.RepurchasePrice)
+	}
+	if !got.ForfeitedQuantity.Equal(dec("24000")) {
+		t.Errorf("ForfeitedQuantity = %s, want 24000", got.ForfeitedQuantity)
+	}
+}
+
+func rsaGrant() domain.Grant {
+	return domain.Grant{
+		Type:            domain.GrantTypeRSA,
+		Quantity:        dec("4000000"),
+		GrantDate:       date(2024, 1, 1),
+		ExercisePrice:   dec("0.0001"),
+		VestingSchedule: &domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly},
+	}
+}
+
+func TestCalculate_RSA(t *testing.T) {
+	tests := []struct {
+		name              string
+		asOf              time.Time
+		wantReleased      string
+		wantRepurchasable string
+	}{
+		{"before grant", date(2023, 12, 1), "0", "0"},
+		{"all shares subject to repurchase at grant", date(2024, 1, 1), "0", "4000000"},
+		{"cliff releases a quarter", date(2025, 1, 1), "1000000", "3000000"},
+		{"fully released", date(2028, 1, 1), "4000000", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Calculate(rsaGrant(), tt.asOf)
+			if !got.ReleasedShares.Equal(dec(tt.wantReleased)) {
+				t.Errorf("ReleasedShares = %s, want %s", got.ReleasedShares, tt.wantReleased)
+			}
+			if !got.RepurchasableShares.Equal(dec(tt.wantRepurchasable)) {
+				t.Errorf("RepurchasableShares = %s, want %s", got.RepurchasableShares, tt.wantRepurchasable)
+			}
+			if !got.ExercisedShares.IsZero() {
+				t.Errorf("ExercisedShares = %s, want 0 for restricted stock", got.ExercisedShares)
+			}
+		})
+	}
+
+	if err := ValidateExercise(rsaGrant(), dec("1"), date(2024, 6, 1)); err == nil {
+		t.Error("expected restricted stock exercise to be rejected")
+	}
+}
+
+func TestCalculate_Stock(t *testing.T) {
+	stock := domain.Grant{
+		Type:          domain.GrantTypeStock,
+		Quantity:      dec("555555.5555"),
+		GrantDate:     date(2025, 6, 1),
+		ExercisePrice: dec("0.9"),
+	}
+
+	got := Calculate(stock, date(2025, 6, 1))
+	if !got.ReleasedShares.Equal(stock.Quantity) || !got.RepurchasableShares.IsZero() {
+		t.Errorf("Released/Repurchasable = %s/%s, want %s/0", got.ReleasedShares, got.RepurchasableShares, stock.Quantity)
+	}
+	if err := ValidateExercise(stock, dec("1"), date(2025, 7, 1)); err == nil {
+		t.Error("expected stock exercise to be rejected")
+	}
+
+	terminated := Terminate(stock, date(2026, 1, 1), DefaultExerciseWindowDays, nil)
+	if !terminated.ForfeitedQuantity.IsZero() || terminated.ExerciseDeadline != nil || terminated.RepurchasePrice != nil {
+		t.Errorf("Terminate changed stock: forfeited %s, deadline %v, repurchase price %v",
+			terminated.ForfeitedQuantity, terminated.ExerciseDeadline, terminated.RepurchasePrice)
+	}
+}
+
+func TestRepurchase(t *testing.T) {
+	var conflict *domain.ErrConflict
+	if _, err := Repurchase(rsaGrant(), date(2026, 1, 1)); !errors.As(err, &conflict) {
+		t.Fatalf("repurchase before departure: expected ErrConflict, got %v", err)
+	}
+
+	fmv := dec("0.00005")
+	terminated := Terminate(rsaGrant(), date(2026, 1, 1), DefaultExerciseWindowDays, &fmv)
+	if !terminated.ForfeitedQuantity.IsZero() {
+		t.Errorf("ForfeitedQuantity = %s, want 0: restricted stock is repurchased, not forfeited", terminated.ForfeitedQuantity)
+	}
+	if terminated.ExerciseDeadline != nil {
+		t.Errorf("ExerciseDeadline = %v, want none for restricted stock", terminated.ExerciseDeadline)
+	}
+
+	var ve *domain.ErrValidation
+	if _, err := Repurchase(terminated, date(2025, 12, 31)); !errors.As(err, &ve) {
+		t.Errorf("repurchase before termination date: expected ErrValidation, got %v", err)
+	}
+
+	got, err := Repurchase(terminated, date(2026, 2, 1))
+	if err != nil {
+		t.Fatalf("Repurchase: %v", err)
+	}
+	if !got.RepurchasedQuantity.Equal(dec("2000000")) {
+		t.Errorf("RepurchasedQuantity = %s, want 2000000", got.RepurchasedQuantity)
+	}
+	if got.RepurchasePrice == nil || !got.RepurchasePrice.Equal(fmv) {
+		t.Errorf("RepurchasePrice = %v, want %s", got.RepurchasePrice, fmv)
+	}
+	if !got.OutstandingQuantity().Equal(dec("2000000")) {
+		t.Errorf("OutstandingQuantity = %s, want 2000000", got.OutstandingQuantity())
+	}
+
+	// Before the repurchase the shares were still held subject to repurchase.
+	if s := Calculate(got, date(2026, 1, 15)); !s.RepurchasableShares.Equal(dec("2000000")) {
+		t.Errorf("RepurchasableShares before repurchase = %s, want 2000000", s.RepurchasableShares)
+	}
+	if s := Calculate(got, date(2026, 3, 1)); !s.RepurchasableShares.IsZero() || !s.ReleasedShares.Equal(dec("2000000")) {
+		t.Errorf("after repurchase: released %s, repurchasable %s; want 2000000 and 0", s.ReleasedShares, s.RepurchasableShares)
+	}
+
+	if _, err := Repurchase(got, date(2026, 3, 1)); !errors.As(err, &conflict) {
+		t.Errorf("second repurchase: expected ErrConflict, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package vesting
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// ForecastGrant is a grant with the attributes a forecast is split by. The
+// grant's VestingSchedule must already be attached.
+type ForecastGrant struct {
+	Grant          domain.Grant
+	ShareClassName string
+	Role           domain.StakeholderRole
+}
+
+// Forecast totals the shares that vest across grants in each calendar bucket
+// between from and to, inclusive. The first and last buckets are clipped to
+// the range. Each grant's contribution to a bucket is the change in
+// Calculate's vested shares from the previous bucket's last day to this one's.
+func Forecast(grants []ForecastGrant, from, to time.Time, bucket domain.ForecastBucket) []domain.VestingForecastPeriod {
+	type lineKey struct {
+		shareClassID string
+		role         domain.StakeholderRole
+	}
+
+	prev := make([]decimal.Decimal, len(grants))
+	for i, fg := range grants {
+		prev[i] = Calculate(fg.Grant, from.AddDate(0, 0, -1)).VestedShares
+	}
+
+	var periods []domain.VestingForecastPeriod
+	for start := from; !start.After(to); {
+		end := bucketEnd(start, bucket)
+		if end.After(to) {
+			end = to
+		}
+
+		lines := map[lineKey]*domain.VestingForecastLine{}
+		total := decimal.Zero
+		for i, fg := range grants {
+			cumulative := Calculate(fg.Grant, end).VestedShares
+			vested := cumulative.Sub(prev[i])
+			prev[i] = cumulative
+			if vested.IsZero() {
+				continue
+			}
+			k := lineKey{fg.Grant.ShareClassID, fg.Role}
+			if _, ok := lines[k]; !ok {
+				lines[k] = &domain.VestingForecastLine{
+					ShareClassID:   fg.Grant.ShareClassID,
+					ShareClassName: fg.ShareClassName,
+					Role:           fg.Role,
+					VestedShares:   decimal.Zero,
+				}
+			}
+			lines[k].VestedShares = lines[k].VestedShares.Add(vested)
+			total = total.Add(vested)
+		}
+
+		breakdown := make([]domain.VestingForecastLine, 0, len(lines))
+		for _, l := range lines {
+			breakdown = append(breakdown, *l)
+		}
+		sort.Slice(breakdown, func(i, j int) bool {
+			if breakdown[i].ShareClassName != breakdown[j].ShareClassName {
+				return breakdown[i].ShareClassName < breakdown[j].ShareClassName
+			}
+			return breakdown[i].Role < breakdown[j].Role
+		})
+
+		periods = append(periods, domain.VestingForecastPeriod{
+			PeriodStart:  start,
+			PeriodEnd:    end,
+			VestedShares: total,
+			Breakdown:    breakdown,
+		})
+		start = end.AddDate(0, 0, 1)
+	}
+	return periods
+}
+
+// bucketEnd returns the last day of the calendar bucket containing d.
+func bucketEnd(d time.Time, bucket domain.ForecastBucket) time.Time {
+	switch bucket {
+	case domain.BucketQuarter:
+		firstMonth := (d.Month()-1)/3*3 + 1
+		return time.Date(d.Year(), firstMonth+3, 0, 0, 0, 0, 0, d.Location())
+	case domain.BucketYear:
+		return time.Date(d.Year(), time.December, 31, 0, 0, 0, 0, d.Location())
+	default:
+		return time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location())
+	}
+}
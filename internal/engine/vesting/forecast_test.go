@@ -0,0 +1,84 @@
+package vesting
+
+import (
+	"testing"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+func TestForecast(t *testing.T) {
+	monthly := &domain.VestingSchedule{CliffMonths: 10, TotalMonths: 40, Frequency: domain.FrequencyMonthly}
+	grants := []ForecastGrant{
+		{
+			Grant:          domain.Grant{ShareClassID: "common", Quantity: dec("40000"), GrantDate: date(2024, 3, 1), VestingSchedule: monthly},
+			ShareClassName: "Common",
+			Role:           domain.RoleEmployee,
+		},
+		{
+			Grant:          domain.Grant{ShareClassID: "common", Quantity: dec("4000"), GrantDate: date(2024, 3, 1), VestingSchedule: monthly},
+			ShareClassName: "Common",
+			Role:           domain.RoleAdvisor,
+		},
+		{
+			Grant:          domain.Grant{ShareClassID: "common", Quantity: dec("20000"), GrantDate: date(2024, 5, 1), VestingSchedule: monthly},
+			ShareClassName: "Common",
+			Role:           domain.RoleEmployee,
+		},
+	}
+
+	t.Run("monthly buckets include cliff catch-up", func(t *testing.T) {
+		periods := Forecast(grants, date(2025, 1, 1), date(2025, 3, 31), domain.BucketMonth)
+		if len(periods) != 3 {
+			t.Fatalf("got %d periods, want 3", len(periods))
+		}
+
+		// January: cliff for the first two grants (10000 + 1000).
+		jan := periods[0]
+		if !jan.PeriodEnd.Equal(date(2025, 1, 31)) {
+			t.Errorf("January ends %s, want 2025-01-31", jan.PeriodEnd.Format("2006-01-02"))
+		}
+		if !jan.VestedShares.Equal(dec("11000")) {
+			t.Errorf("January vested = %s, want 11000", jan.VestedShares)
+		}
+		if len(jan.Breakdown) != 2 {
+			t.Fatalf("January breakdown has %d lines, want 2", len(jan.Breakdown))
+		}
+		if jan.Breakdown[0].Role != domain.RoleAdvisor || !jan.Breakdown[0].VestedShares.Equal(dec("1000")) {
+			t.Errorf("January advisor line = %+v, want 1000", jan.Breakdown[0])
+		}
+
+		// March: monthly vest for the first two, cliff for the third (5000).
+		if !periods[2].VestedShares.Equal(dec("6100")) {
+			t.Errorf("March vested = %s, want 6100", periods[2].VestedShares)
+		}
+	})
+
+	t.Run("quarterly buckets clip to range", func(t *testing.T) {
+		periods := Forecast(grants, date(2025, 2, 15), date(2025, 12, 31), domain.BucketQuarter)
+		if len(periods) != 4 {
+			t.Fatalf("got %d periods, want 4", len(periods))
+		}
+		if !periods[0].PeriodStart.Equal(date(2025, 2, 15)) || !periods[0].PeriodEnd.Equal(date(2025, 3, 31)) {
+			t.Errorf("first period = %s..%s, want 2025-02-15..2025-03-31",
+				periods[0].PeriodStart.Format("2006-01-02"), periods[0].PeriodEnd.Format("2006-01-02"))
+		}
+	})
+
+	t.Run("buckets sum to vesting over the range", func(t *testing.T) {
+		from, to := date(2024, 6, 1), date(2027, 5, 31)
+		periods := Forecast(grants, from, to, domain.BucketYear)
+
+		sum := decimal.Zero
+		for _, p := range periods {
+			sum = sum.Add(p.VestedShares)
+		}
+		want := decimal.Zero
+		for _, fg := range grants {
+			want = want.Add(Calculate(fg.Grant, to).VestedShares.Sub(Calculate(fg.Grant, from.AddDate(0, 0, -1)).VestedShares))
+		}
+		if !sum.Equal(want) {
+			t.Errorf("sum of buckets = %s, want %s", sum, want)
+		}
+	})
+}
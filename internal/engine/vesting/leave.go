@@ -0,0 +1,89 @@
+package vesting
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+// MinTolledLeaveDays is the policy threshold for suspending vesting: only
+// unpaid leaves longer than this many days toll the schedule.
+const MinTolledLeaveDays = 30
+
+// tolledPeriod is a stretch of calendar time that does not count as service.
+type tolledPeriod struct {
+	start time.Time
+	days  int
+}
+
+func (p tolledPeriod) end() time.Time {
+	return p.start.AddDate(0, 0, p.days)
+}
+
+// tolledPeriods returns the grant's qualifying leaves on or after the
+// vesting commencement date, sorted and with overlaps merged.
+func tolledPeriods(grant domain.Grant) []tolledPeriod {
+	start := commencementDate(grant)
+
+	var periods []tolledPeriod
+	for _, l := range grant.Leaves {
+		if l.IsPaid || daysBetween(l.StartDate, l.EndDate) <= MinTolledLeaveDays {
+			continue
+		}
+		from := l.StartDate
+		if from.Before(start) {
+			from = start
+		}
+		if !l.EndDate.After(from) {
+			continue
+		}
+		periods = append(periods, tolledPeriod{start: from, days: daysBetween(from, l.EndDate)})
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].start.Before(periods[j].start) })
+
+	merged := periods[:0]
+	for _, p := range periods {
+		if n := len(merged); n > 0 && !p.start.After(merged[n-1].end()) {
+			if p.end().After(merged[n-1].end()) {
+				merged[n-1].days = daysBetween(merged[n-1].start, p.end())
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+// serviceDate maps a calendar date to the date it would be had the holder
+// never taken a tolled leave: time spent on leave before asOf is removed.
+func serviceDate(grant domain.Grant, asOf time.Time) time.Time {
+	service := asOf
+	for _, p := range tolledPeriods(grant) {
+		if !asOf.After(p.start) {
+			break
+		}
+		end := p.end()
+		if asOf.Before(end) {
+			end = asOf
+		}
+		service = service.AddDate(0, 0, -daysBetween(p.start, end))
+	}
+	return service
+}
+
+// calendarDate is the inverse of serviceDate: the first calendar date whose
+// service date reaches service.
+func calendarDate(grant domain.Grant, service time.Time) time.Time {
+	d := service
+	for _, p := range tolledPeriods(grant) {
+		if p.start.Before(d) {
+			d = d.AddDate(0, 0, p.days)
+		}
+	}
+	return d
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
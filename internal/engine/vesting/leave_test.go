@@ -0,0 +1,105 @@
+package vesting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+func leave(start, end time.Time, paid bool) domain.LeaveOfAbsence {
+	return domain.LeaveOfAbsence{StartDate: start, EndDate: end, IsPaid: paid}
+}
+
+func TestCalculate_LeaveTolling(t *testing.T) {
+	schedule := &domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly}
+
+	tests := []struct {
+		name            string
+		leaves          []domain.LeaveOfAbsence
+		asOf            time.Time
+		wantVested      string
+		wantCliff       time.Time
+		wantFullyVested time.Time
+	}{
+		{
+			name:            "no leave",
+			asOf:            date(2025, 1, 1),
+			wantVested:      "12000",
+			wantCliff:       date(2025, 1, 1),
+			wantFullyVested: date(2028, 1, 1),
+		},
+		{
+			name:            "unpaid 60-day leave pushes the cliff out",
+			leaves:          []domain.LeaveOfAbsence{leave(date(2024, 6, 1), date(2024, 7, 31), false)},
+			asOf:            date(2025, 1, 1),
+			wantVested:      "0",
+			wantCliff:       date(2025, 3, 2),
+			wantFullyVested: date(2028, 3, 1),
+		},
+		{
+			name:            "tolled schedule reaches the cliff on the shifted date",
+			leaves:          []domain.LeaveOfAbsence{leave(date(2024, 6, 1), date(2024, 7, 31), false)},
+			asOf:            date(2025, 3, 2),
+			wantVested:      "12000",
+			wantCliff:       date(2025, 3, 2),
+			wantFullyVested: date(2028, 3, 1),
+		},
+		{
+			name:            "paid leave does not toll",
+			leaves:          []domain.LeaveOfAbsence{leave(date(2024, 6, 1), date(2024, 7, 31), true)},
+			asOf:            date(2025, 1, 1),
+			wantVested:      "12000",
+			wantCliff:       date(2025, 1, 1),
+			wantFullyVested: date(2028, 1, 1),
+		},
+		{
+			name:            "30-day unpaid leave does not toll",
+			leaves:          []domain.LeaveOfAbsence{leave(date(2024, 6, 1), date(2024, 7, 1), false)},
+			asOf:            date(2025, 1, 1),
+			wantVested:      "12000",
+			wantCliff:       date(2025, 1, 1),
+			wantFullyVested: date(2028, 1, 1),
+		},
+		{
+			name:            "vesting is suspended during a leave after the cliff",
+			leaves:          []domain.LeaveOfAbsence{leave(date(2025, 6, 1), date(2025, 9, 1), false)},
+			asOf:            date(2025, 8, 15),
+			wantVested:      "17000",
+			wantCliff:       date(2025, 1, 1),
+			wantFullyVested: date(2028, 4, 2),
+		},
+		{
+			name: "overlapping stakeholder and grant leaves are counted once",
+			leaves: []domain.LeaveOfAbsence{
+				leave(date(2024, 6, 1), date(2024, 7, 31), false),
+				leave(date(2024, 7, 1), date(2024, 7, 31), false),
+			},
+			asOf:            date(2025, 1, 1),
+			wantVested:      "0",
+			wantCliff:       date(2025, 3, 2),
+			wantFullyVested: date(2028, 3, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := domain.Grant{
+				Quantity:        dec("48000"),
+				GrantDate:       date(2024, 1, 1),
+				VestingSchedule: schedule,
+				Leaves:          tt.leaves,
+			}
+			got := Calculate(g, tt.asOf)
+			if !got.VestedShares.Equal(dec(tt.wantVested)) {
+				t.Errorf("VestedShares = %s, want %s", got.VestedShares, tt.wantVested)
+			}
+			if !got.CliffDate.Equal(tt.wantCliff) {
+				t.Errorf("CliffDate = %s, want %s", got.CliffDate.Format("2006-01-02"), tt.wantCliff.Format("2006-01-02"))
+			}
+			if !got.FullyVestedAt.Equal(tt.wantFullyVested) {
+				t.Errorf("FullyVestedAt = %s, want %s", got.FullyVestedAt.Format("2006-01-02"), tt.wantFullyVested.Format("2006-01-02"))
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package vesting
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// Timeline returns every vest event for a grant in date order. Each event's
+// cumulative total equals Calculate(grant, event.Date).VestedShares, and the
+// vested amount is unchanged between consecutive events, so the cliff
+// catch-up and milestone achievements each appear as a single event.
+func Timeline(grant domain.Grant) []domain.VestEvent {
+	var events []domain.VestEvent
+	prev := decimal.Zero
+	for _, d := range candidateDates(grant) {
+		vested := Calculate(grant, d).VestedShares
+		if !vested.GreaterThan(prev) {
+			continue
+		}
+		events = append(events, domain.VestEvent{
+			Date:             d,
+			Shares:           vested.Sub(prev),
+			CumulativeVested: vested,
+		})
+		prev = vested
+	}
+	return events
+}
+
+// candidateDates returns, in order, every date on which Calculate's result
+// can change: the commencement date, cliff and final vest dates, tranche
+// dates, milestone achievements, and each date on which another full month
+// of service has elapsed since commencement.
+func candidateDates(grant domain.Grant) []time.Time {
+	start := commencementDate(grant)
+	cliffDate, fullyVestedAt := scheduleDates(grant)
+	dates := []time.Time{start, cliffDate, fullyVestedAt}
+
+	if vs := grant.VestingSchedule; vs != nil {
+		for _, t := range vs.Tranches {
+			dates = append(dates, calendarDate(grant, addMonths(start, t.OffsetMonths)))
+		}
+		if len(vs.Tranches) == 0 {
+			for k := 1; k <= vs.TotalMonths; k++ {
+				dates = append(dates, calendarDate(grant, monthBoundary(start, k)))
+			}
+		}
+	}
+	for _, m := range grant.Milestones {
+		if m.AchievedDate != nil {
+			dates = append(dates, *m.AchievedDate)
+		}
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	unique := dates[:0]
+	for i, d := range dates {
+		if i == 0 || !d.Equal(dates[i-1]) {
+			unique = append(unique, d)
+		}
+	}
+	return unique
+}
+
+// monthBoundary returns the first date on which monthsDiff(start, date)
+// reaches k. When the target month is too short to contain start's day of
+// month, that is the first day of the following month.
+func monthBoundary(start time.Time, k int) time.Time {
+	first := time.Date(start.Year(), start.Month()+time.Month(k), 1, 0, 0, 0, 0, start.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if start.Day() > lastDay {
+		return first.AddDate(0, 1, 0)
+	}
+	return time.Date(first.Year(), first.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+}
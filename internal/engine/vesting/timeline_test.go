@@ -0,0 +1,160 @@
+package vesting
+
+import (
+	"testing"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+func TestTimeline(t *testing.T) {
+	monthly := &domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly}
+
+	tests := []struct {
+		name       string
+		grant      domain.Grant
+		wantEvents int
+		wantFirst  domain.VestEvent
+		wantLast   domain.VestEvent
+	}{
+		{
+			name: "monthly with cliff catch-up",
+			grant: domain.Grant{
+				Quantity:        dec("48000"),
+				GrantDate:       date(2024, 1, 1),
+				VestingSchedule: monthly,
+			},
+			wantEvents: 37,
+			wantFirst:  domain.VestEvent{Date: date(2025, 1, 1), Shares: dec("12000"), CumulativeVested: dec("12000")},
+			wantLast:   domain.VestEvent{Date: date(2028, 1, 1), Shares: dec("1000"), CumulativeVested: dec("48000")},
+		},
+		{
+			name: "quarterly",
+			grant: domain.Grant{
+				Quantity:  dec("40000"),
+				GrantDate: date(2024, 1, 1),
+				VestingSchedule: &domain.VestingSchedule{
+					CliffMonths: 0,
+					TotalMonths: 30,
+					Frequency:   domain.FrequencyQuarterly,
+				},
+			},
+			wantEvents: 10,
+			wantFirst:  domain.VestEvent{Date: date(2024, 4, 1), Shares: dec("4000"), CumulativeVested: dec("4000")},
+			wantLast:   domain.VestEvent{Date: date(2026, 7, 1), Shares: dec("4000"), CumulativeVested: dec("40000")},
+		},
+		{
+			name: "tranches",
+			grant: domain.Grant{
+				Quantity:  dec("1000"),
+				GrantDate: date(2024, 1, 1),
+				VestingSchedule: &domain.VestingSchedule{Tranches: []domain.VestingTranche{
+					pctTranche(12, "10"), pctTranche(24, "20"), pctTranche(36, "30"), pctTranche(48, "40"),
+				}},
+			},
+			wantEvents: 4,
+			wantFirst:  domain.VestEvent{Date: date(2025, 1, 1), Shares: dec("100"), CumulativeVested: dec("100")},
+			wantLast:   domain.VestEvent{Date: date(2028, 1, 1), Shares: dec("400"), CumulativeVested: dec("1000")},
+		},
+		{
+			name: "no schedule vests at commencement",
+			grant: domain.Grant{
+				Quantity:                dec("500"),
+				GrantDate:               date(2024, 3, 1),
+				VestingCommencementDate: date(2024, 2, 1),
+			},
+			wantEvents: 1,
+			wantFirst:  domain.VestEvent{Date: date(2024, 2, 1), Shares: dec("500"), CumulativeVested: dec("500")},
+			wantLast:   domain.VestEvent{Date: date(2024, 2, 1), Shares: dec("500"), CumulativeVested: dec("500")},
+		},
+		{
+			name: "milestone achieved between monthly events",
+			grant: domain.Grant{
+				Quantity:  dec("1000"),
+				GrantDate: date(2024, 1, 1),
+				VestingSchedule: &domain.VestingSchedule{
+					CliffMonths: 0,
+					TotalMonths: 2,
+					Frequency:   domain.FrequencyMonthly,
+				},
+				Milestones: []domain.GrantMilestone{
+					{Shares: dec("600"), Condition: domain.MilestoneOnly, AchievedDate: datePtr(2024, 1, 20)},
+				},
+			},
+			wantEvents: 3,
+			wantFirst:  domain.VestEvent{Date: date(2024, 1, 20), Shares: dec("600"), CumulativeVested: dec("600")},
+			wantLast:   domain.VestEvent{Date: date(2024, 3, 1), Shares: dec("200"), CumulativeVested: dec("1000")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := Timeline(tt.grant)
+			if len(events) != tt.wantEvents {
+				t.Fatalf("got %d events, want %d", len(events), tt.wantEvents)
+			}
+			assertEvent(t, "first", events[0], tt.wantFirst)
+			assertEvent(t, "last", events[len(events)-1], tt.wantLast)
+		})
+	}
+}
+
+func assertEvent(t *testing.T, label string, got, want domain.VestEvent) {
+	t.Helper()
+	if !got.Date.Equal(want.Date) || !got.Shares.Equal(want.Shares) || !got.CumulativeVested.Equal(want.CumulativeVested) {
+		t.Errorf("%s event = %s %s (cum %s), want %s %s (cum %s)", label,
+			got.Date.Format("2006-01-02"), got.Shares, got.CumulativeVested,
+			want.Date.Format("2006-01-02"), want.Shares, want.CumulativeVested)
+	}
+}
+
+// TestTimeline_ConsistentWithCalculate checks every day of the schedule,
+// including month-end commencement dates where calendar months are short
+// and schedules tolled by leave.
+func TestTimeline_ConsistentWithCalculate(t *testing.T) {
+	grants := []domain.Grant{
+		{
+			Quantity:        dec("10000"),
+			GrantDate:       date(2024, 1, 31),
+			VestingSchedule: &domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly},
+		},
+		{
+			Quantity:        dec("7777"),
+			GrantDate:       date(2023, 8, 30),
+			VestingSchedule: &domain.VestingSchedule{CliffMonths: 6, TotalMonths: 36, Frequency: domain.FrequencyQuarterly},
+			Milestones: []domain.GrantMilestone{
+				{Shares: dec("1000"), Condition: domain.MilestoneAndTime, AchievedDate: datePtr(2025, 2, 14)},
+			},
+		},
+		{
+			Quantity:        dec("48000"),
+			GrantDate:       date(2024, 1, 31),
+			VestingSchedule: &domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly},
+			Leaves: []domain.LeaveOfAbsence{
+				leave(date(2024, 11, 20), date(2025, 2, 3), false),
+				leave(date(2026, 2, 27), date(2026, 4, 30), false),
+			},
+		},
+	}
+
+	for _, g := range grants {
+		events := Timeline(g)
+		_, fullyVestedAt := scheduleDates(g)
+
+		i := 0
+		cumulative := decimal.Zero
+		for d := g.GrantDate; !d.After(fullyVestedAt.AddDate(0, 1, 0)); d = d.AddDate(0, 0, 1) {
+			for i < len(events) && !events[i].Date.After(d) {
+				cumulative = events[i].CumulativeVested
+				i++
+			}
+			if want := Calculate(g, d).VestedShares; !cumulative.Equal(want) {
+				t.Fatalf("grant %s on %s: timeline cumulative %s, Calculate %s",
+					g.GrantDate.Format("2006-01-02"), d.Format("2006-01-02"), cumulative, want)
+			}
+		}
+		if !cumulative.Equal(g.Quantity) {
+			t.Errorf("final cumulative = %s, want %s", cumulative, g.Quantity)
+		}
+	}
+}
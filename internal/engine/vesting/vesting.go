@@ -1,6 +1,7 @@
 package vesting
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/hutfut/vestigo/internal/domain"
@@ -8,85 +9,413 @@ import (
 )
 
 // Calculate computes the vesting status for a grant at a given point in time.
-// The grant must have a VestingSchedule attached. If it does not, the entire
-// grant is treated as fully vested (immediate grant with no schedule).
+// The grant must have a VestingSchedule attached. If it does not, the
+// time-based portion of the grant is treated as fully vested (immediate grant
+// with no schedule).
+//
+// Shares allocated to milestones are carved out of the time-based schedule.
+// A milestone-only allocation vests in full on its achievement date; a
+// milestone-and-time allocation vests on the grant's schedule but only once
+// the milestone has been achieved, catching up on the achievement date.
+//
+// Vesting stops on the grant's TerminationDate, if set; shares unvested on
+// that date stay unvested.
+//
+// Options exercised early and restricted stock stay on the schedule; issued
+// shares are reported as released once vested and as repurchasable until
+// then.
 func Calculate(grant domain.Grant, asOf time.Time) domain.VestingStatus {
-	result := domain.VestingStatus{
-		GrantID:     grant.ID,
-		AsOfDate:    asOf,
-		TotalShares: grant.Quantity,
+	if grant.TerminationDate == nil || !asOf.After(*grant.TerminationDate) {
+		return withExercises(grant, calculate(grant, asOf))
 	}
+	status := calculate(grant, *grant.TerminationDate)
+	status.AsOfDate = asOf
+	return withExercises(grant, status)
+}
+
+// calculate is Calculate without the termination freeze.
+func calculate(grant domain.Grant, asOf time.Time) domain.VestingStatus {
+	cliffDate, fullyVestedAt := scheduleDates(grant)
+
+	timeQuantity := grant.Quantity
+	vested := decimal.Zero
+	for _, m := range grant.Milestones {
+		timeQuantity = timeQuantity.Sub(m.Shares)
+		if m.AchievedDate == nil || m.AchievedDate.After(asOf) {
+			continue
+		}
+		switch m.Condition {
+		case domain.MilestoneAndTime:
+			vested = vested.Add(vestedOnSchedule(grant, m.Shares, asOf))
+		default:
+			vested = vested.Add(m.Shares)
+		}
+	}
+	vested = vested.Add(vestedOnSchedule(grant, timeQuantity, asOf))
+
+	vestedShares := decimal.Min(vested.RoundFloor(4), grant.Quantity)
+	return domain.VestingStatus{
+		GrantID:        grant.ID,
+		AsOfDate:       asOf,
+		TotalShares:    grant.Quantity,
+		VestedShares:   vestedShares,
+		UnvestedShares: grant.Quantity.Sub(vestedShares),
+		PercentVested:  decimal.Min(vested.Div(grant.Quantity), decimal.NewFromInt(1)).Mul(decimal.NewFromInt(100)).RoundFloor(2),
+		CliffDate:      cliffDate,
+		FullyVestedAt:  fullyVestedAt,
+		IsFullyVested:  vestedShares.Equal(grant.Quantity),
+	}
+}
+
+// scheduleDates returns the cliff and fully-vested dates of the grant's
+// time-based schedule, pushed out by any tolled leave. For tranche schedules
+// these are the first and last tranche dates.
+func scheduleDates(grant domain.Grant) (cliffDate, fullyVestedAt time.Time) {
+	cliffDate, fullyVestedAt = serviceScheduleDates(grant)
+	return calendarDate(grant, cliffDate), calendarDate(grant, fullyVestedAt)
+}
 
-	if grant.VestingSchedule == nil {
-		result.VestedShares = grant.Quantity
-		result.UnvestedShares = decimal.Zero
-		result.PercentVested = decimal.NewFromInt(100)
-		result.CliffDate = grant.GrantDate
-		result.FullyVestedAt = grant.GrantDate
-		result.IsFullyVested = true
-		return result
+// serviceScheduleDates returns the schedule's cliff and fully-vested dates
+// measured in service time, before tolling.
+func serviceScheduleDates(grant domain.Grant) (cliffDate, fullyVestedAt time.Time) {
+	start := commencementDate(grant)
+	vs := grant.VestingSchedule
+	switch {
+	case vs == nil:
+		return start, start
+	case len(vs.Tranches) > 0:
+		return addMonths(start, vs.Tranches[0].OffsetMonths), addMonths(start, vs.Tranches[len(vs.Tranches)-1].OffsetMonths)
+	default:
+		return addMonths(start, vs.CliffMonths), addMonths(start, vs.TotalMonths)
 	}
+}
 
+// commencementDate returns the date vesting is anchored on. Grants without an
+// explicit vesting commencement date vest from the grant date.
+func commencementDate(grant domain.Grant) time.Time {
+	if grant.VestingCommencementDate.IsZero() {
+		return grant.GrantDate
+	}
+	return grant.VestingCommencementDate
+}
+
+// vestedOnSchedule returns how many of quantity shares have vested by asOf
+// under the grant's time-based schedule, before rounding. Tolled leave does
+// not count towards the cliff or any vesting period.
+func vestedOnSchedule(grant domain.Grant, quantity decimal.Decimal, asOf time.Time) decimal.Decimal {
 	vs := grant.VestingSchedule
-	grantDate := grant.GrantDate
-	cliffDate := addMonths(grantDate, vs.CliffMonths)
-	fullyVestedAt := addMonths(grantDate, vs.TotalMonths)
+	if vs == nil {
+		return quantity
+	}
 
-	result.CliffDate = cliffDate
-	result.FullyVestedAt = fullyVestedAt
+	asOf = serviceDate(grant, asOf)
+	start := commencementDate(grant)
+	if len(vs.Tranches) > 0 {
+		vested := decimal.Zero
+		for i, t := range vs.Tranches {
+			if asOf.Before(addMonths(start, t.OffsetMonths)) {
+				return roundCumulative(vs.Rounding, vested)
+			}
+			if i == len(vs.Tranches)-1 {
+				return quantity
+			}
+			shares := trancheShares(t, quantity)
+			if vs.Rounding == domain.RoundingPerPeriodFloor {
+				shares = shares.Floor()
+			}
+			vested = vested.Add(shares)
+		}
+		return vested
+	}
 
+	cliffDate, fullyVestedAt := serviceScheduleDates(grant)
 	if asOf.Before(cliffDate) {
-		result.VestedShares = decimal.Zero
-		result.UnvestedShares = grant.Quantity
-		result.PercentVested = decimal.Zero
-		result.IsFullyVested = false
-		return result
+		return decimal.Zero
 	}
-
 	if !asOf.Before(fullyVestedAt) {
-		result.VestedShares = grant.Quantity
-		result.UnvestedShares = decimal.Zero
-		result.PercentVested = decimal.NewFromInt(100)
-		result.IsFullyVested = true
-		return result
+		return quantity
 	}
 
-	periodsElapsed := countPeriods(grantDate, asOf, vs.Frequency)
-	totalPeriods := countPeriods(grantDate, fullyVestedAt, vs.Frequency)
-
+	periodsElapsed := countPeriods(start, asOf, vs.Frequency)
+	totalPeriods := countPeriods(start, fullyVestedAt, vs.Frequency)
 	if totalPeriods == 0 {
-		result.VestedShares = grant.Quantity
-		result.UnvestedShares = decimal.Zero
-		result.PercentVested = decimal.NewFromInt(100)
-		result.IsFullyVested = true
-		return result
+		return quantity
 	}
 
+	if vs.Rounding == domain.RoundingPerPeriodFloor {
+		perPeriod := quantity.Div(decimal.NewFromInt(int64(totalPeriods))).Floor()
+		return perPeriod.Mul(decimal.NewFromInt(int64(periodsElapsed)))
+	}
 	vestedFraction := decimal.NewFromInt(int64(periodsElapsed)).
 		Div(decimal.NewFromInt(int64(totalPeriods)))
+	return roundCumulative(vs.Rounding, quantity.Mul(vestedFraction))
+}
+
+// roundCumulative applies a cumulative rounding policy to a partially vested
+// amount. Per-period rounding is applied as the periods are summed instead.
+func roundCumulative(policy domain.VestingRounding, vested decimal.Decimal) decimal.Decimal {
+	switch policy {
+	case domain.RoundingCumulativeFloor:
+		return vested.Floor()
+	case domain.RoundingBankers:
+		return vested.RoundBank(0)
+	default:
+		return vested
+	}
+}
+
+// roundShares floors an amount to the precision the rounding policy vests in.
+func roundShares(policy domain.VestingRounding, shares decimal.Decimal) decimal.Decimal {
+	switch policy {
+	case domain.RoundingCumulativeFloor, domain.RoundingPerPeriodFloor, domain.RoundingBankers:
+		return shares.Floor()
+	default:
+		return shares.RoundFloor(4)
+	}
+}
+
+func trancheShares(t domain.VestingTranche, quantity decimal.Decimal) decimal.Decimal {
+	if t.Shares != nil {
+		return *t.Shares
+	}
+	return quantity.Mul(*t.Percent).Div(decimal.NewFromInt(100))
+}
+
+// DefaultExerciseWindowDays is the standard post-termination exercise period.
+const DefaultExerciseWindowDays = 90
+
+// Terminate freezes a grant's vesting on terminationDate. Unexercised options
+// unvested on that date are forfeited, and vested options remain exercisable
+// for exerciseWindowDays. Grants with nothing vested, and grants of shares
+// rather than options, get no exercise deadline.
+//
+// Issued shares unvested on that date, whether exercised early or held as
+// restricted stock, are not forfeited; the company may repurchase them at the
+// lower of their cost and fairMarketValue. A nil fairMarketValue prices the
+// repurchase at cost.
+func Terminate(grant domain.Grant, terminationDate time.Time, exerciseWindowDays int, fairMarketValue *decimal.Decimal) domain.Grant {
+	status := Calculate(grant, terminationDate)
+
+	grant.TerminationDate = &terminationDate
+	grant.ForfeitedQuantity = status.UnvestedShares.Sub(status.RepurchasableShares)
+	grant.ExerciseDeadline = nil
+	if status.VestedShares.IsPositive() && !grant.IssuedAtGrant() {
+		deadline := terminationDate.AddDate(0, 0, exerciseWindowDays)
+		grant.ExerciseDeadline = &deadline
+	}
+	grant.RepurchasePrice = nil
+	if status.RepurchasableShares.IsPositive() {
+		price := RepurchasePrice(grant.ExercisePrice, fairMarketValue)
+		grant.RepurchasePrice = &price
+	}
+	return grant
+}
+
+// AccelerationEvents records the events that can trigger accelerated vesting.
+// A nil date means the event has not happened.
+type AccelerationEvents struct {
+	ChangeOfControlDate *time.Time
+	TerminationDate     *time.Time
+}
+
+// CalculateWithEvents computes the vesting status at asOf given a change of
+// control and/or a termination of service, applying the schedule's
+// acceleration terms. The grant's own TerminationDate is used when events
+// does not give one.
+//
+// Termination freezes time-based vesting on the termination date. Acceleration
+// fires on the change of control for single-trigger schedules, and on the
+// termination for double-trigger schedules when the termination falls within
+// AccelerationWindowMonths after the change of control. Accelerated shares are
+// taken from the end of the schedule, so a holder who keeps vesting after a
+// partial single-trigger acceleration finishes early rather than receiving the
+// same shares twice.
+func CalculateWithEvents(grant domain.Grant, asOf time.Time, events AccelerationEvents) domain.VestingStatus {
+	if events.TerminationDate == nil {
+		events.TerminationDate = grant.TerminationDate
+	}
+	serviceEnd := asOf
+	if events.TerminationDate != nil && events.TerminationDate.Before(asOf) {
+		serviceEnd = *events.TerminationDate
+	}
+
+	result := calculate(grant, serviceEnd)
+	result.AsOfDate = asOf
 
-	result.VestedShares = grant.Quantity.Mul(vestedFraction).RoundFloor(4)
-	result.UnvestedShares = grant.Quantity.Sub(result.VestedShares)
-	result.PercentVested = vestedFraction.Mul(decimal.NewFromInt(100)).RoundFloor(2)
-	result.IsFullyVested = false
+	vs := grant.VestingSchedule
+	if vs == nil || result.IsFullyVested {
+		return withExercises(grant, result)
+	}
 
-	return result
+	triggerDate, fired := accelerationTriggerDate(*vs, asOf, events)
+	if !fired {
+		return withExercises(grant, result)
+	}
+
+	var vested decimal.Decimal
+	if vs.AccelerationMonths != nil {
+		vested = calculate(grant, addMonths(serviceEnd, *vs.AccelerationMonths)).VestedShares
+	} else {
+		pct := decimal.NewFromInt(100)
+		if vs.AccelerationPercent != nil {
+			pct = *vs.AccelerationPercent
+		}
+		unvestedAtTrigger := calculate(grant, triggerDate).UnvestedShares
+		accelerated := roundShares(vs.Rounding, unvestedAtTrigger.Mul(pct).Div(decimal.NewFromInt(100)))
+		vested = decimal.Min(grant.Quantity, result.VestedShares.Add(accelerated))
+	}
+
+	result.AcceleratedShares = vested.Sub(result.VestedShares)
+	result.VestedShares = vested
+	result.UnvestedShares = grant.Quantity.Sub(vested)
+	result.PercentVested = vested.Div(grant.Quantity).Mul(decimal.NewFromInt(100)).RoundFloor(2)
+	result.IsFullyVested = vested.Equal(grant.Quantity)
+	return withExercises(grant, result)
 }
 
-// CalculateAccelerated returns the vesting status as if single-trigger
-// acceleration fires on the given date (100% immediate vesting).
+// CalculateAccelerated returns the vesting status as if a change of control
+// closes on triggerDate and the holder is terminated the same day, which fires
+// both single- and double-trigger acceleration under the schedule's terms.
 func CalculateAccelerated(grant domain.Grant, triggerDate time.Time) domain.VestingStatus {
-	return domain.VestingStatus{
-		GrantID:        grant.ID,
-		AsOfDate:       triggerDate,
-		TotalShares:    grant.Quantity,
-		VestedShares:   grant.Quantity,
-		UnvestedShares: decimal.Zero,
-		PercentVested:  decimal.NewFromInt(100),
-		CliffDate:      grant.GrantDate,
-		FullyVestedAt:  triggerDate,
-		IsFullyVested:  true,
+	return CalculateWithEvents(grant, triggerDate, AccelerationEvents{
+		ChangeOfControlDate: &triggerDate,
+		TerminationDate:     &triggerDate,
+	})
+}
+
+// accelerationTriggerDate reports whether the schedule's acceleration has
+// fired by asOf, and on which date.
+func accelerationTriggerDate(vs domain.VestingSchedule, asOf time.Time, events AccelerationEvents) (time.Time, bool) {
+	coc, term := events.ChangeOfControlDate, events.TerminationDate
+	if coc == nil || coc.After(asOf) {
+		return time.Time{}, false
+	}
+	// Vesting stopped before the deal closed, so there is nothing left to accelerate.
+	if term != nil && term.Before(*coc) {
+		return time.Time{}, false
+	}
+
+	switch vs.AccelerationTrigger {
+	case domain.AccelerationSingleTrigger:
+		return *coc, true
+	case domain.AccelerationDoubleTrigger:
+		if term == nil || term.After(asOf) {
+			return time.Time{}, false
+		}
+		if term.After(addMonths(*coc, vs.AccelerationWindowMonths)) {
+			return time.Time{}, false
+		}
+		return *term, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// ValidateSchedule checks a vesting schedule's terms before it is persisted.
+func ValidateSchedule(vs domain.VestingSchedule) error {
+	if vs.AccelerationPercent != nil && vs.AccelerationMonths != nil {
+		return &domain.ErrValidation{Field: "accelerationPercent", Message: "cannot be combined with accelerationMonths"}
+	}
+	if vs.AccelerationPercent != nil &&
+		(!vs.AccelerationPercent.IsPositive() || vs.AccelerationPercent.GreaterThan(decimal.NewFromInt(100))) {
+		return &domain.ErrValidation{Field: "accelerationPercent", Message: "must be greater than 0 and at most 100"}
+	}
+	if vs.AccelerationMonths != nil && *vs.AccelerationMonths <= 0 {
+		return &domain.ErrValidation{Field: "accelerationMonths", Message: "must be positive"}
+	}
+	switch vs.Rounding {
+	case "", domain.RoundingFractional, domain.RoundingCumulativeFloor, domain.RoundingPerPeriodFloor, domain.RoundingBankers:
+	default:
+		return &domain.ErrValidation{Field: "rounding", Message: fmt.Sprintf("unknown rounding policy %q", vs.Rounding)}
+	}
+	if vs.AccelerationWindowMonths < 0 {
+		return &domain.ErrValidation{Field: "accelerationWindowMonths", Message: "must not be negative"}
+	}
+	if len(vs.Tranches) > 0 {
+		return validateTranches(vs.Tranches)
+	}
+	return nil
+}
+
+// validateTranches requires strictly increasing offsets, a single kind of
+// amount across the schedule, and percentages that sum to exactly 100.
+// Share-denominated tranches are checked against the grant by ValidateGrant.
+func validateTranches(tranches []domain.VestingTranche) error {
+	byPercent := tranches[0].Percent != nil
+	totalPct := decimal.Zero
+
+	for i, t := range tranches {
+		if t.OffsetMonths < 0 {
+			return &domain.ErrValidation{Field: "tranches", Message: "offsetMonths must not be negative"}
+		}
+		if i > 0 && t.OffsetMonths <= tranches[i-1].OffsetMonths {
+			return &domain.ErrValidation{Field: "tranches", Message: "offsetMonths must be strictly increasing"}
+		}
+		if (t.Percent == nil) == (t.Shares == nil) {
+			return &domain.ErrValidation{Field: "tranches", Message: "each tranche needs exactly one of percent or shares"}
+		}
+		if (t.Percent != nil) != byPercent {
+			return &domain.ErrValidation{Field: "tranches", Message: "cannot mix percent and share tranches"}
+		}
+		amount := t.Shares
+		if byPercent {
+			amount = t.Percent
+			totalPct = totalPct.Add(*t.Percent)
+		}
+		if !amount.IsPositive() {
+			return &domain.ErrValidation{Field: "tranches", Message: "tranche amounts must be positive"}
+		}
+	}
+
+	if tranches[len(tranches)-1].OffsetMonths == 0 {
+		return &domain.ErrValidation{Field: "tranches", Message: "at least one tranche must vest after the start date"}
+	}
+	if byPercent && !totalPct.Equal(decimal.NewFromInt(100)) {
+		return &domain.ErrValidation{Field: "tranches", Message: fmt.Sprintf("percentages sum to %s, want 100", totalPct)}
+	}
+	return nil
+}
+
+// ValidateGrant checks a grant against its vesting schedule and milestones.
+// Share-denominated tranches must add up to exactly the granted quantity, and
+// milestone allocations cannot exceed it.
+func ValidateGrant(grant domain.Grant) error {
+	vs := grant.VestingSchedule
+	shareTranches := vs != nil && len(vs.Tranches) > 0 && vs.Tranches[0].Shares != nil
+
+	if shareTranches {
+		total := decimal.Zero
+		for _, t := range vs.Tranches {
+			total = total.Add(*t.Shares)
+		}
+		if !total.Equal(grant.Quantity) {
+			return &domain.ErrValidation{
+				Field:   "quantity",
+				Message: fmt.Sprintf("vesting schedule tranches total %s shares, grant is for %s", total, grant.Quantity),
+			}
+		}
+	}
+
+	if len(grant.Milestones) == 0 {
+		return nil
+	}
+	if shareTranches {
+		return &domain.ErrValidation{Field: "milestones", Message: "cannot be combined with share-denominated tranches"}
+	}
+	allocated := decimal.Zero
+	for _, m := range grant.Milestones {
+		if !m.Shares.IsPositive() {
+			return &domain.ErrValidation{Field: "milestones", Message: "milestone shares must be positive"}
+		}
+		allocated = allocated.Add(m.Shares)
+	}
+	if allocated.GreaterThan(grant.Quantity) {
+		return &domain.ErrValidation{
+			Field:   "milestones",
+			Message: fmt.Sprintf("milestones allocate %s shares, grant is for %s", allocated, grant.Quantity),
+		}
 	}
+	return nil
 }
 
 func addMonths(t time.Time, months int) time.Time {
@@ -203,6 +203,44 @@ func TestCalculate(t *testing.T) {
 			wantPct:         "27.08",
 			wantFullyVested: false,
 		},
+		{
+			name: "cliff anchored on commencement date, not grant date",
+			grant: domain.Grant{
+				ID:                      "g11",
+				Quantity:                dec("48000"),
+				GrantDate:               date(2024, 3, 1),
+				VestingCommencementDate: date(2024, 1, 1),
+				VestingSchedule: &domain.VestingSchedule{
+					CliffMonths: 12,
+					TotalMonths: 48,
+					Frequency:   domain.FrequencyMonthly,
+				},
+			},
+			asOf:            date(2025, 1, 1),
+			wantVested:      "12000",
+			wantUnvested:    "36000",
+			wantPct:         "25",
+			wantFullyVested: false,
+		},
+		{
+			name: "commencement after grant date delays the cliff",
+			grant: domain.Grant{
+				ID:                      "g12",
+				Quantity:                dec("48000"),
+				GrantDate:               date(2024, 1, 1),
+				VestingCommencementDate: date(2024, 2, 1),
+				VestingSchedule: &domain.VestingSchedule{
+					CliffMonths: 12,
+					TotalMonths: 48,
+					Frequency:   domain.FrequencyMonthly,
+				},
+			},
+			asOf:            date(2025, 1, 15),
+			wantVested:      "0",
+			wantUnvested:    "48000",
+			wantPct:         "0",
+			wantFullyVested: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -256,3 +294,604 @@ func TestCalculateAccelerated(t *testing.T) {
 		t.Error("expected IsFullyVested = true")
 	}
 }
+
+func datePtr(y, m, d int) *time.Time {
+	t := date(y, m, d)
+	return &t
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func decPtr(v string) *decimal.Decimal {
+	d := dec(v)
+	return &d
+}
+
+func TestCalculateWithEvents(t *testing.T) {
+	schedule := func(trigger domain.AccelerationTrigger) *domain.VestingSchedule {
+		return &domain.VestingSchedule{
+			CliffMonths:              12,
+			TotalMonths:              48,
+			Frequency:                domain.FrequencyMonthly,
+			AccelerationTrigger:      trigger,
+			AccelerationWindowMonths: 12,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		schedule        *domain.VestingSchedule
+		asOf            time.Time
+		events          AccelerationEvents
+		wantVested      string
+		wantAccelerated string
+	}{
+		{
+			name:     "double trigger: terminated 6 months after acquisition, full acceleration",
+			schedule: schedule(domain.AccelerationDoubleTrigger),
+			asOf:     date(2026, 7, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+				TerminationDate:     datePtr(2026, 7, 1),
+			},
+			// 30 of 48 months vested at termination, remaining 18000 accelerates
+			wantVested:      "48000",
+			wantAccelerated: "18000",
+		},
+		{
+			name: "double trigger: 50% partial acceleration",
+			schedule: func() *domain.VestingSchedule {
+				vs := schedule(domain.AccelerationDoubleTrigger)
+				vs.AccelerationPercent = decPtr("50")
+				return vs
+			}(),
+			asOf: date(2026, 7, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+				TerminationDate:     datePtr(2026, 7, 1),
+			},
+			wantVested:      "39000",
+			wantAccelerated: "9000",
+		},
+		{
+			name: "double trigger: 12 months of additional vesting",
+			schedule: func() *domain.VestingSchedule {
+				vs := schedule(domain.AccelerationDoubleTrigger)
+				vs.AccelerationMonths = intPtr(12)
+				return vs
+			}(),
+			asOf: date(2026, 7, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+				TerminationDate:     datePtr(2026, 7, 1),
+			},
+			wantVested:      "42000",
+			wantAccelerated: "12000",
+		},
+		{
+			name:     "double trigger: termination outside window, vesting frozen without acceleration",
+			schedule: schedule(domain.AccelerationDoubleTrigger),
+			asOf:     date(2027, 6, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+				TerminationDate:     datePtr(2027, 3, 1),
+			},
+			wantVested:      "38000",
+			wantAccelerated: "0",
+		},
+		{
+			name:     "double trigger: change of control alone does not accelerate",
+			schedule: schedule(domain.AccelerationDoubleTrigger),
+			asOf:     date(2026, 7, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+			},
+			wantVested:      "30000",
+			wantAccelerated: "0",
+		},
+		{
+			name:     "double trigger: termination before change of control forfeits acceleration",
+			schedule: schedule(domain.AccelerationDoubleTrigger),
+			asOf:     date(2026, 7, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 3, 1),
+				TerminationDate:     datePtr(2026, 1, 1),
+			},
+			wantVested:      "24000",
+			wantAccelerated: "0",
+		},
+		{
+			name:     "double trigger: termination after asOf not yet effective",
+			schedule: schedule(domain.AccelerationDoubleTrigger),
+			asOf:     date(2026, 3, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+				TerminationDate:     datePtr(2026, 7, 1),
+			},
+			wantVested:      "26000",
+			wantAccelerated: "0",
+		},
+		{
+			name: "single trigger: 25% at close, accelerated shares come off the end",
+			schedule: func() *domain.VestingSchedule {
+				vs := schedule(domain.AccelerationSingleTrigger)
+				vs.AccelerationPercent = decPtr("25")
+				return vs
+			}(),
+			asOf: date(2026, 7, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+			},
+			// 24000 unvested at close, 6000 accelerate; 30000 vested by schedule at asOf
+			wantVested:      "36000",
+			wantAccelerated: "6000",
+		},
+		{
+			name:     "no acceleration trigger: termination only freezes vesting",
+			schedule: schedule(domain.AccelerationNone),
+			asOf:     date(2026, 7, 1),
+			events: AccelerationEvents{
+				ChangeOfControlDate: datePtr(2026, 1, 1),
+				TerminationDate:     datePtr(2026, 1, 1),
+			},
+			wantVested:      "24000",
+			wantAccelerated: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := domain.Grant{
+				ID:              "g1",
+				Quantity:        dec("48000"),
+				GrantDate:       date(2024, 1, 1),
+				VestingSchedule: tt.schedule,
+			}
+			got := CalculateWithEvents(grant, tt.asOf, tt.events)
+
+			if !got.VestedShares.Equal(dec(tt.wantVested)) {
+				t.Errorf("VestedShares = %s, want %s", got.VestedShares, tt.wantVested)
+			}
+			if !got.AcceleratedShares.Equal(dec(tt.wantAccelerated)) {
+				t.Errorf("AcceleratedShares = %s, want %s", got.AcceleratedShares, tt.wantAccelerated)
+			}
+			if !got.VestedShares.Add(got.UnvestedShares).Equal(got.TotalShares) {
+				t.Errorf("invariant violation: vested(%s) + unvested(%s) != total(%s)",
+					got.VestedShares, got.UnvestedShares, got.TotalShares)
+			}
+		})
+	}
+}
+
+func TestCalculateAccelerated_NoTriggerDoesNotAccelerate(t *testing.T) {
+	grant := domain.Grant{
+		ID:        "g1",
+		Quantity:  dec("48000"),
+		GrantDate: date(2024, 1, 1),
+		VestingSchedule: &domain.VestingSchedule{
+			CliffMonths:         12,
+			TotalMonths:         48,
+			Frequency:           domain.FrequencyMonthly,
+			AccelerationTrigger: domain.AccelerationNone,
+		},
+	}
+
+	got := CalculateAccelerated(grant, date(2026, 1, 1))
+
+	if !got.VestedShares.Equal(dec("24000")) {
+		t.Errorf("VestedShares = %s, want 24000", got.VestedShares)
+	}
+	if got.IsFullyVested {
+		t.Error("expected IsFullyVested = false")
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		vs      domain.VestingSchedule
+		wantErr bool
+	}{
+		{name: "no acceleration terms", vs: domain.VestingSchedule{}},
+		{name: "percent only", vs: domain.VestingSchedule{AccelerationPercent: decPtr("50")}},
+		{name: "months only", vs: domain.VestingSchedule{AccelerationMonths: intPtr(6)}},
+		{name: "percent and months", vs: domain.VestingSchedule{AccelerationPercent: decPtr("50"), AccelerationMonths: intPtr(6)}, wantErr: true},
+		{name: "percent over 100", vs: domain.VestingSchedule{AccelerationPercent: decPtr("150")}, wantErr: true},
+		{name: "zero months", vs: domain.VestingSchedule{AccelerationMonths: intPtr(0)}, wantErr: true},
+		{name: "negative window", vs: domain.VestingSchedule{AccelerationWindowMonths: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchedule(tt.vs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func pctTranche(offset int, pct string) domain.VestingTranche {
+	return domain.VestingTranche{OffsetMonths: offset, Percent: decPtr(pct)}
+}
+
+func TestCalculate_Tranches(t *testing.T) {
+	backWeighted := &domain.VestingSchedule{
+		Tranches: []domain.VestingTranche{
+			pctTranche(12, "10"),
+			pctTranche(24, "20"),
+			pctTranche(36, "30"),
+			pctTranche(48, "40"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		schedule        *domain.VestingSchedule
+		quantity        string
+		asOf            time.Time
+		wantVested      string
+		wantFullyVested bool
+	}{
+		{name: "10/20/30/40 before first tranche", schedule: backWeighted, quantity: "100000", asOf: date(2024, 12, 31), wantVested: "0"},
+		{name: "10/20/30/40 at first tranche", schedule: backWeighted, quantity: "100000", asOf: date(2025, 1, 1), wantVested: "10000"},
+		{name: "10/20/30/40 between tranches", schedule: backWeighted, quantity: "100000", asOf: date(2026, 6, 1), wantVested: "30000"},
+		{name: "10/20/30/40 at third tranche", schedule: backWeighted, quantity: "100000", asOf: date(2027, 1, 1), wantVested: "60000"},
+		{name: "10/20/30/40 fully vested", schedule: backWeighted, quantity: "100000", asOf: date(2028, 1, 1), wantVested: "100000", wantFullyVested: true},
+		{
+			name: "share-denominated tranches",
+			schedule: &domain.VestingSchedule{
+				Tranches: []domain.VestingTranche{
+					{OffsetMonths: 6, Shares: decPtr("1000")},
+					{OffsetMonths: 18, Shares: decPtr("2000")},
+				},
+			},
+			quantity:   "3000",
+			asOf:       date(2024, 9, 1),
+			wantVested: "1000",
+		},
+		{
+			name: "fractional percentages floor to 4 places",
+			schedule: &domain.VestingSchedule{
+				Tranches: []domain.VestingTranche{
+					pctTranche(12, "33.3333"),
+					pctTranche(24, "33.3333"),
+					pctTranche(36, "33.3334"),
+				},
+			},
+			quantity:   "1000",
+			asOf:       date(2025, 1, 1),
+			wantVested: "333.333",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := domain.Grant{
+				ID:              "g1",
+				Quantity:        dec(tt.quantity),
+				GrantDate:       date(2024, 1, 1),
+				VestingSchedule: tt.schedule,
+			}
+			got := Calculate(grant, tt.asOf)
+
+			if !got.VestedShares.Equal(dec(tt.wantVested)) {
+				t.Errorf("VestedShares = %s, want %s", got.VestedShares, tt.wantVested)
+			}
+			if got.IsFullyVested != tt.wantFullyVested {
+				t.Errorf("IsFullyVested = %v, want %v", got.IsFullyVested, tt.wantFullyVested)
+			}
+			if !got.VestedShares.Add(got.UnvestedShares).Equal(got.TotalShares) {
+				t.Errorf("invariant violation: vested(%s) + unvested(%s) != total(%s)",
+					got.VestedShares, got.UnvestedShares, got.TotalShares)
+			}
+		})
+	}
+
+	t.Run("cliff and fully vested dates follow first and last tranche", func(t *testing.T) {
+		got := Calculate(domain.Grant{Quantity: dec("100"), GrantDate: date(2024, 1, 1), VestingSchedule: backWeighted}, date(2024, 1, 1))
+		if !got.CliffDate.Equal(date(2025, 1, 1)) {
+			t.Errorf("CliffDate = %s, want 2025-01-01", got.CliffDate.Format("2006-01-02"))
+		}
+		if !got.FullyVestedAt.Equal(date(2028, 1, 1)) {
+			t.Errorf("FullyVestedAt = %s, want 2028-01-01", got.FullyVestedAt.Format("2006-01-02"))
+		}
+	})
+}
+
+func TestValidateSchedule_Tranches(t *testing.T) {
+	tests := []struct {
+		name     string
+		tranches []domain.VestingTranche
+		wantErr  bool
+	}{
+		{name: "back-weighted sums to 100", tranches: []domain.VestingTranche{pctTranche(12, "10"), pctTranche(24, "20"), pctTranche(36, "30"), pctTranche(48, "40")}},
+		{name: "sums to 90", tranches: []domain.VestingTranche{pctTranche(12, "50"), pctTranche(24, "40")}, wantErr: true},
+		{name: "sums to 110", tranches: []domain.VestingTranche{pctTranche(12, "60"), pctTranche(24, "50")}, wantErr: true},
+		{name: "duplicate offsets", tranches: []domain.VestingTranche{pctTranche(12, "50"), pctTranche(12, "50")}, wantErr: true},
+		{name: "offsets out of order", tranches: []domain.VestingTranche{pctTranche(24, "50"), pctTranche(12, "50")}, wantErr: true},
+		{name: "everything at start", tranches: []domain.VestingTranche{pctTranche(0, "100")}, wantErr: true},
+		{
+			name:     "mixed percent and shares",
+			tranches: []domain.VestingTranche{pctTranche(12, "50"), {OffsetMonths: 24, Shares: decPtr("500")}},
+			wantErr:  true,
+		},
+		{
+			name:     "tranche with both percent and shares",
+			tranches: []domain.VestingTranche{{OffsetMonths: 12, Percent: decPtr("100"), Shares: decPtr("500")}},
+			wantErr:  true,
+		},
+		{
+			name:     "share tranches defer total check to the grant",
+			tranches: []domain.VestingTranche{{OffsetMonths: 12, Shares: decPtr("500")}, {OffsetMonths: 24, Shares: decPtr("700")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchedule(domain.VestingSchedule{Tranches: tt.tranches})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGrant(t *testing.T) {
+	schedule := &domain.VestingSchedule{
+		Tranches: []domain.VestingTranche{
+			{OffsetMonths: 12, Shares: decPtr("500")},
+			{OffsetMonths: 24, Shares: decPtr("700")},
+		},
+	}
+
+	if err := ValidateGrant(domain.Grant{Quantity: dec("1200"), VestingSchedule: schedule}); err != nil {
+		t.Errorf("expected matching quantity to validate, got %v", err)
+	}
+	if err := ValidateGrant(domain.Grant{Quantity: dec("1000"), VestingSchedule: schedule}); err == nil {
+		t.Error("expected error when tranches do not sum to grant quantity")
+	}
+}
+
+func TestCalculate_Milestones(t *testing.T) {
+	standard := &domain.VestingSchedule{
+		CliffMonths: 12,
+		TotalMonths: 48,
+		Frequency:   domain.FrequencyMonthly,
+	}
+
+	tests := []struct {
+		name       string
+		schedule   *domain.VestingSchedule
+		milestones []domain.GrantMilestone
+		asOf       time.Time
+		wantVested string
+	}{
+		{
+			name:     "milestone not yet achieved: only time-based portion vests",
+			schedule: standard,
+			milestones: []domain.GrantMilestone{
+				{Name: "FDA approval", Shares: dec("24000"), Condition: domain.MilestoneOnly},
+			},
+			asOf: date(2026, 1, 1),
+			// time portion 24000 at 50%
+			wantVested: "12000",
+		},
+		{
+			name:     "milestone achieved: allocation vests in full",
+			schedule: standard,
+			milestones: []domain.GrantMilestone{
+				{Name: "FDA approval", Shares: dec("24000"), Condition: domain.MilestoneOnly, AchievedDate: datePtr(2025, 3, 15)},
+			},
+			asOf:       date(2026, 1, 1),
+			wantVested: "36000",
+		},
+		{
+			name:     "milestone achieved after asOf does not count",
+			schedule: standard,
+			milestones: []domain.GrantMilestone{
+				{Name: "FDA approval", Shares: dec("24000"), Condition: domain.MilestoneOnly, AchievedDate: datePtr(2026, 6, 1)},
+			},
+			asOf:       date(2026, 1, 1),
+			wantVested: "12000",
+		},
+		{
+			name:     "hybrid: achieved milestone catches up to the time schedule",
+			schedule: standard,
+			milestones: []domain.GrantMilestone{
+				{Name: "$10M ARR", Shares: dec("24000"), Condition: domain.MilestoneAndTime, AchievedDate: datePtr(2025, 7, 1)},
+			},
+			asOf: date(2026, 1, 1),
+			// both halves are 50% through the schedule
+			wantVested: "24000",
+		},
+		{
+			name:     "hybrid: unachieved milestone vests nothing even after time is served",
+			schedule: standard,
+			milestones: []domain.GrantMilestone{
+				{Name: "$10M ARR", Shares: dec("24000"), Condition: domain.MilestoneAndTime},
+			},
+			asOf:       date(2029, 1, 1),
+			wantVested: "24000",
+		},
+		{
+			name: "pure performance grant without a schedule",
+			milestones: []domain.GrantMilestone{
+				{Name: "Series B close", Shares: dec("20000"), Condition: domain.MilestoneOnly, AchievedDate: datePtr(2025, 1, 1)},
+				{Name: "IPO", Shares: dec("28000"), Condition: domain.MilestoneOnly},
+			},
+			asOf:       date(2026, 1, 1),
+			wantVested: "20000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := domain.Grant{
+				ID:              "g1",
+				Quantity:        dec("48000"),
+				GrantDate:       date(2024, 1, 1),
+				VestingSchedule: tt.schedule,
+				Milestones:      tt.milestones,
+			}
+			got := Calculate(grant, tt.asOf)
+
+			if !got.VestedShares.Equal(dec(tt.wantVested)) {
+				t.Errorf("VestedShares = %s, want %s", got.VestedShares, tt.wantVested)
+			}
+			if !got.VestedShares.Add(got.UnvestedShares).Equal(got.TotalShares) {
+				t.Errorf("invariant violation: vested(%s) + unvested(%s) != total(%s)",
+					got.VestedShares, got.UnvestedShares, got.TotalShares)
+			}
+		})
+	}
+}
+
+func TestValidateGrant_Milestones(t *testing.T) {
+	grant := domain.Grant{
+		Quantity: dec("1000"),
+		Milestones: []domain.GrantMilestone{
+			{Name: "a", Shares: dec("600")},
+			{Name: "b", Shares: dec("600")},
+		},
+	}
+	if err := ValidateGrant(grant); err == nil {
+		t.Error("expected error when milestones allocate more than the grant")
+	}
+
+	grant.Milestones[1].Shares = dec("400")
+	if err := ValidateGrant(grant); err != nil {
+		t.Errorf("expected milestones within quantity to validate, got %v", err)
+	}
+}
+
+func TestTerminate(t *testing.T) {
+	schedule := &domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly}
+
+	tests := []struct {
+		name          string
+		grant         domain.Grant
+		termination   time.Time
+		wantForfeited string
+		wantDeadline  *time.Time
+	}{
+		{
+			name:          "mid-schedule forfeits the unvested remainder",
+			grant:         domain.Grant{Quantity: dec("48000"), GrantDate: date(2024, 1, 1), VestingSchedule: schedule},
+			termination:   date(2026, 1, 15),
+			wantForfeited: "24000",
+			wantDeadline:  datePtr(2026, 4, 15),
+		},
+		{
+			name:          "before cliff forfeits everything with no deadline",
+			grant:         domain.Grant{Quantity: dec("48000"), GrantDate: date(2024, 1, 1), VestingSchedule: schedule},
+			termination:   date(2024, 11, 30),
+			wantForfeited: "48000",
+		},
+		{
+			name: "unachieved milestones are forfeited",
+			grant: domain.Grant{
+				Quantity:  dec("1000"),
+				GrantDate: date(2024, 1, 1),
+				Milestones: []domain.GrantMilestone{
+					{Shares: dec("400"), Condition: domain.MilestoneOnly},
+				},
+			},
+			termination:   date(2024, 6, 1),
+			wantForfeited: "400",
+			wantDeadline:  datePtr(2024, 8, 30),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Terminate(tt.grant, tt.termination, DefaultExerciseWindowDays, nil)
+			if !got.ForfeitedQuantity.Equal(dec(tt.wantForfeited)) {
+				t.Errorf("ForfeitedQuantity = %s, want %s", got.ForfeitedQuantity, tt.wantForfeited)
+			}
+			switch {
+			case tt.wantDeadline == nil && got.ExerciseDeadline != nil:
+				t.Errorf("ExerciseDeadline = %v, want none", got.ExerciseDeadline)
+			case tt.wantDeadline != nil && (got.ExerciseDeadline == nil || !got.ExerciseDeadline.Equal(*tt.wantDeadline)):
+				t.Errorf("ExerciseDeadline = %v, want %v", got.ExerciseDeadline, tt.wantDeadline)
+			}
+
+			// Vesting is frozen after termination.
+			later := Calculate(got, tt.termination.AddDate(2, 0, 0))
+			if !later.UnvestedShares.Equal(got.ForfeitedQuantity) {
+				t.Errorf("unvested two years later = %s, want %s", later.UnvestedShares, got.ForfeitedQuantity)
+			}
+		})
+	}
+}
+
+func TestCalculate_Rounding(t *testing.T) {
+	tests := []struct {
+		policy   domain.VestingRounding
+		monthly  []string // vested after months 1, 5 and 12 of a 12-month schedule of 1000 shares
+		tranches []string // vested after each of four 25% tranches of 10 shares
+	}{
+		{domain.RoundingFractional, []string{"83.3333", "416.6666", "1000"}, []string{"2.5", "5", "7.5", "10"}},
+		{domain.RoundingCumulativeFloor, []string{"83", "416", "1000"}, []string{"2", "5", "7", "10"}},
+		{domain.RoundingPerPeriodFloor, []string{"83", "415", "1000"}, []string{"2", "4", "6", "10"}},
+		{domain.RoundingBankers, []string{"83", "417", "1000"}, []string{"2", "5", "8", "10"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			monthly := domain.Grant{
+				Quantity:  dec("1000"),
+				GrantDate: date(2024, 1, 1),
+				VestingSchedule: &domain.VestingSchedule{
+					CliffMonths: 0,
+					TotalMonths: 12,
+					Frequency:   domain.FrequencyMonthly,
+					Rounding:    tt.policy,
+				},
+			}
+			for i, months := range []int{1, 5, 12} {
+				got := Calculate(monthly, addMonths(monthly.GrantDate, months)).VestedShares
+				if !got.Equal(dec(tt.monthly[i])) {
+					t.Errorf("month %d: VestedShares = %s, want %s", months, got, tt.monthly[i])
+				}
+			}
+
+			tranched := domain.Grant{
+				Quantity:  dec("10"),
+				GrantDate: date(2024, 1, 1),
+				VestingSchedule: &domain.VestingSchedule{
+					Tranches: []domain.VestingTranche{
+						pctTranche(12, "25"), pctTranche(24, "25"), pctTranche(36, "25"), pctTranche(48, "25"),
+					},
+					Rounding: tt.policy,
+				},
+			}
+			for i, months := range []int{12, 24, 36, 48} {
+				got := Calculate(tranched, addMonths(tranched.GrantDate, months)).VestedShares
+				if !got.Equal(dec(tt.tranches[i])) {
+					t.Errorf("tranche %d: VestedShares = %s, want %s", i+1, got, tt.tranches[i])
+				}
+			}
+
+			// Vest events always add up to the full grant.
+			for _, g := range []domain.Grant{monthly, tranched} {
+				sum := decimal.Zero
+				for _, e := range Timeline(g) {
+					sum = sum.Add(e.Shares)
+				}
+				if !sum.Equal(g.Quantity) {
+					t.Errorf("vest events sum to %s, want %s", sum, g.Quantity)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSchedule_Rounding(t *testing.T) {
+	vs := domain.VestingSchedule{CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly, Rounding: "nearest"}
+	if err := ValidateSchedule(vs); err == nil {
+		t.Error("expected error for unknown rounding policy")
+	}
+}
@@ -12,6 +12,8 @@ type ShareClassPosition struct {
 	ShareClass  domain.ShareClass
 	Holders     []HolderPosition
 	TotalShares decimal.Decimal
+
+	cashOut *decimal.Decimal // set for a ConvertibleClaim's position
 }
 
 // HolderPosition represents one stakeholder's position within a share class.
@@ -21,10 +23,26 @@ type HolderPosition struct {
 	Shares          decimal.Decimal
 }
 
+// ConvertibleClaim is a SAFE or convertible note still outstanding at an
+// exit. Its holder takes the greater of CashOut and the payout on
+// ConversionShares as common. A SAFE ranks with the most senior preferred
+// class, sharing pro rata with it when there is too little to pay both, as
+// under the YC SAFE; a note is debt and is paid ahead of every class.
+type ConvertibleClaim struct {
+	StakeholderID    string
+	StakeholderName  string
+	Instrument       string // reported as the payout's share class, e.g. "SAFE"
+	IsDebt           bool
+	CashOut          decimal.Decimal // purchase amount, or a note's principal and interest
+	ConversionShares decimal.Decimal // as-converted at the cap; zero if it can only cash out
+}
+
 // Calculate computes the liquidation waterfall for a given exit valuation.
 //
 // The algorithm proceeds in seniority order (highest first):
 //  1. Each preferred class receives its liquidation preference (multiple * invested).
+//     Classes of equal seniority rank pari passu: when the proceeds left cannot
+//     cover all their preferences, each receives the same fraction of its own.
 //  2. If participating, preferred also shares in remaining proceeds pro-rata
 //     with common, subject to the participation cap.
 //  3. Non-participating preferred compares its liquidation preference to its
@@ -32,6 +50,16 @@ type HolderPosition struct {
 //     converts, the waterfall is recalculated with that class in the common pool.
 //  4. Common shares receive whatever remains after all preferences are satisfied.
 func Calculate(positions []ShareClassPosition, exitValuation decimal.Decimal) domain.WaterfallResult {
+	return CalculateWithClaims(positions, nil, exitValuation)
+}
+
+// CalculateWithClaims computes the waterfall with outstanding SAFEs and
+// convertible notes. Each claim is modeled as its own non-participating
+// preferred class, level with the most senior real one for a SAFE and above
+// it for a note, whose preference is its cash-out
+// amount: the same election between preference and conversion then gives its
+// holder the greater of cash-out and as-converted.
+func CalculateWithClaims(positions []ShareClassPosition, claims []ConvertibleClaim, exitValuation decimal.Decimal) domain.WaterfallResult {
 	result := domain.WaterfallResult{
 		ExitValuation: exitValuation,
 		Payouts:       []domain.WaterfallPayout{},
@@ -41,26 +69,34 @@ func Calculate(positions []ShareClassPosition, exitValuation decimal.Decimal) do
 		return result
 	}
 
+	positions = withClaims(positions, claims)
 	converting := resolveConversions(positions, exitValuation)
 	payoutMap := distribute(positions, exitValuation, converting)
 
 	totalPayout := decimal.Zero
-	for _, pos := range positions {
-		for _, h := range pos.Holders {
-			payout := payoutMap[h.StakeholderID]
+	for i, pos := range positions {
+		for j, h := range pos.Holders {
+			payout := payoutMap[holderKey{i, j}]
 			if payout.GreaterThan(decimal.Zero) {
 				perShare := decimal.Zero
 				if h.Shares.GreaterThan(decimal.Zero) {
 					perShare = payout.Div(h.Shares).RoundFloor(4)
 				}
-				result.Payouts = append(result.Payouts, domain.WaterfallPayout{
+				p := domain.WaterfallPayout{
 					StakeholderID:   h.StakeholderID,
 					StakeholderName: h.StakeholderName,
 					ShareClassName:  pos.ShareClass.Name,
 					Shares:          h.Shares,
 					Payout:          payout,
 					PayoutPerShare:  perShare,
-				})
+				}
+				if pos.cashOut != nil {
+					p.ClaimElection = "cash_out"
+					if converting[i] {
+						p.ClaimElection = "convert"
+					}
+				}
+				result.Payouts = append(result.Payouts, p)
 				totalPayout = totalPayout.Add(payout)
 			}
 		}
@@ -70,6 +106,47 @@ func Calculate(positions []ShareClassPosition, exitValuation decimal.Decimal) do
 	return result
 }
 
+// withClaims appends a single-holder position for each claim: at the
+// seniority of the most senior preferred class for SAFEs, and one level above
+// it for notes.
+func withClaims(positions []ShareClassPosition, claims []ConvertibleClaim) []ShareClassPosition {
+	if len(claims) == 0 {
+		return positions
+	}
+	top := 0
+	for _, p := range positions {
+		if p.ShareClass.IsPreferred && p.ShareClass.Seniority > top {
+			top = p.ShareClass.Seniority
+		}
+	}
+	out := make([]ShareClassPosition, len(positions), len(positions)+len(claims))
+	copy(out, positions)
+	for _, c := range claims {
+		seniority := top
+		if c.IsDebt {
+			seniority = top + 1
+		}
+		cashOut := c.CashOut
+		out = append(out, ShareClassPosition{
+			ShareClass: domain.ShareClass{
+				Name:                c.Instrument,
+				IsPreferred:         true,
+				LiquidationMultiple: decimal.NewFromInt(1),
+				Seniority:           seniority,
+			},
+			Holders:     []HolderPosition{{StakeholderID: c.StakeholderID, StakeholderName: c.StakeholderName, Shares: c.ConversionShares}},
+			TotalShares: c.ConversionShares,
+			cashOut:     &cashOut,
+		})
+	}
+	return out
+}
+
+// holderKey identifies a holder by position and index within it, so a
+// stakeholder holding several classes, or several grants in one, is paid for
+// each separately.
+type holderKey struct{ class, holder int }
+
 // resolveConversions determines which non-participating preferred classes should
 // convert to common. For each such class it compares the preference payout to the
 // as-converted payout and picks whichever is higher. Because one class converting
@@ -93,12 +170,12 @@ func resolveConversions(positions []ShareClassPosition, exitValuation decimal.De
 			withPref := cloneIntSet(converting)
 			delete(withPref, idx)
 			prefPayouts := distribute(positions, exitValuation, withPref)
-			prefTotal := holderPayoutSum(positions[idx].Holders, prefPayouts)
+			prefTotal := classPayoutSum(positions, idx, prefPayouts)
 
 			withConv := cloneIntSet(converting)
 			withConv[idx] = true
 			convPayouts := distribute(positions, exitValuation, withConv)
-			convTotal := holderPayoutSum(positions[idx].Holders, convPayouts)
+			convTotal := classPayoutSum(positions, idx, convPayouts)
 
 			shouldConvert := convTotal.GreaterThan(prefTotal)
 			if shouldConvert != converting[idx] {
@@ -122,50 +199,63 @@ func resolveConversions(positions []ShareClassPosition, exitValuation decimal.De
 // distribute runs the waterfall payout with the given conversion decisions.
 // Classes whose index appears in converting forfeit their liquidation preference
 // and are treated as common for pro-rata distribution.
-func distribute(positions []ShareClassPosition, exitValuation decimal.Decimal, converting map[int]bool) map[string]decimal.Decimal {
-	type indexedPos struct {
-		pos ShareClassPosition
-	}
-
-	var preferred []ShareClassPosition
-	var commonPool []ShareClassPosition
+func distribute(positions []ShareClassPosition, exitValuation decimal.Decimal, converting map[int]bool) map[holderKey]decimal.Decimal {
+	var preferred []int
+	var commonPool []int
 
 	for i, p := range positions {
 		if p.ShareClass.IsPreferred && !converting[i] {
-			preferred = append(preferred, p)
+			preferred = append(preferred, i)
 		} else {
-			commonPool = append(commonPool, p)
+			commonPool = append(commonPool, i)
 		}
 	}
 
-	sort.Slice(preferred, func(i, j int) bool {
-		return preferred[i].ShareClass.Seniority > preferred[j].ShareClass.Seniority
+	sort.SliceStable(preferred, func(i, j int) bool {
+		return positions[preferred[i]].ShareClass.Seniority > positions[preferred[j]].ShareClass.Seniority
 	})
 
 	remaining := exitValuation
-	payoutMap := make(map[string]decimal.Decimal)
-	var participatingClasses []ShareClassPosition
+	payoutMap := make(map[holderKey]decimal.Decimal)
+	var participatingClasses []int
+
+	// Phase 1: Pay liquidation preferences to non-converting preferred
+	// shareholders, one seniority level at a time.
+	for start := 0; start < len(preferred); {
+		end := start + 1
+		for end < len(preferred) && positions[preferred[end]].ShareClass.Seniority == positions[preferred[start]].ShareClass.Seniority {
+			end++
+		}
+		tier := preferred[start:end]
+		start = end
 
-	// Phase 1: Pay liquidation preferences to non-converting preferred shareholders.
-	for _, pref := range preferred {
-		if remaining.LessThanOrEqual(decimal.Zero) {
-			break
+		owed := decimal.Zero
+		for _, idx := range tier {
+			owed = owed.Add(preference(positions[idx]))
+		}
+		short := owed.GreaterThan(remaining)
+		paidFraction := decimal.NewFromInt(1)
+		if short {
+			paidFraction = decimal.Max(remaining, decimal.Zero).Div(owed)
 		}
 
-		investedAmount := pref.TotalShares.Mul(derefOrOne(pref.ShareClass.PricePerShare))
-		preference := investedAmount.Mul(pref.ShareClass.LiquidationMultiple)
+		for _, idx := range tier {
+			pref := positions[idx]
+			paid := preference(pref).Mul(paidFraction)
+			remaining = remaining.Sub(paid)
 
-		paid := decimal.Min(preference, remaining)
-		remaining = remaining.Sub(paid)
+			for j := range pref.Holders {
+				holderPayout := paid.Mul(holderFraction(pref, j)).RoundFloor(4)
+				k := holderKey{idx, j}
+				payoutMap[k] = payoutMap[k].Add(holderPayout)
+			}
 
-		for _, h := range pref.Holders {
-			holderFraction := h.Shares.Div(pref.TotalShares)
-			holderPayout := paid.Mul(holderFraction).RoundFloor(4)
-			payoutMap[h.StakeholderID] = payoutMap[h.StakeholderID].Add(holderPayout)
+			if pref.ShareClass.IsParticipating {
+				participatingClasses = append(participatingClasses, idx)
+			}
 		}
-
-		if pref.ShareClass.IsParticipating {
-			participatingClasses = append(participatingClasses, pref)
+		if short {
+			remaining = decimal.Zero
 		}
 	}
 
@@ -173,22 +263,41 @@ func distribute(positions []ShareClassPosition, exitValuation decimal.Decimal, c
 	if remaining.GreaterThan(decimal.Zero) {
 		totalParticipating := decimal.Zero
 
-		for _, c := range commonPool {
-			totalParticipating = totalParticipating.Add(c.TotalShares)
+		for _, i := range commonPool {
+			totalParticipating = totalParticipating.Add(positions[i].TotalShares)
 		}
-		for _, p := range participatingClasses {
-			totalParticipating = totalParticipating.Add(p.TotalShares)
+		for _, i := range participatingClasses {
+			totalParticipating = totalParticipating.Add(positions[i].TotalShares)
 		}
 
 		if totalParticipating.GreaterThan(decimal.Zero) {
-			distributeProRata(commonPool, remaining, totalParticipating, payoutMap, nil)
-			distributeProRata(participatingClasses, remaining, totalParticipating, payoutMap, capFor)
+			distributeProRata(positions, commonPool, remaining, totalParticipating, payoutMap, nil)
+			distributeProRata(positions, participatingClasses, remaining, totalParticipating, payoutMap, capFor)
 		}
 	}
 
 	return payoutMap
 }
 
+// preference is a class's liquidation preference: its multiple of the amount
+// invested, or a claim's cash-out amount.
+func preference(pos ShareClassPosition) decimal.Decimal {
+	if pos.cashOut != nil {
+		return *pos.cashOut
+	}
+	investedAmount := pos.TotalShares.Mul(derefOrOne(pos.ShareClass.PricePerShare))
+	return investedAmount.Mul(pos.ShareClass.LiquidationMultiple)
+}
+
+// holderFraction is holder j's share of its class. A class with no shares,
+// such as a claim that can only cash out, is split evenly.
+func holderFraction(pos ShareClassPosition, j int) decimal.Decimal {
+	if pos.TotalShares.IsZero() {
+		return decimal.NewFromInt(1).Div(decimal.NewFromInt(int64(len(pos.Holders))))
+	}
+	return pos.Holders[j].Shares.Div(pos.TotalShares)
+}
+
 type capFunc func(ShareClassPosition) *decimal.Decimal
 
 func capFor(pos ShareClassPosition) *decimal.Decimal {
@@ -203,17 +312,18 @@ func capFor(pos ShareClassPosition) *decimal.Decimal {
 	return &totalCap
 }
 
-func distributeProRata(classes []ShareClassPosition, pool, totalShares decimal.Decimal, payoutMap map[string]decimal.Decimal, capFn capFunc) {
-	for _, cls := range classes {
+func distributeProRata(positions []ShareClassPosition, classes []int, pool, totalShares decimal.Decimal, payoutMap map[holderKey]decimal.Decimal, capFn capFunc) {
+	for _, idx := range classes {
+		cls := positions[idx]
+		if cls.TotalShares.IsZero() {
+			continue
+		}
 		classFraction := cls.TotalShares.Div(totalShares)
 		classPool := pool.Mul(classFraction)
 
 		if capFn != nil {
 			if cap := capFn(cls); cap != nil {
-				alreadyPaid := decimal.Zero
-				for _, h := range cls.Holders {
-					alreadyPaid = alreadyPaid.Add(payoutMap[h.StakeholderID])
-				}
+				alreadyPaid := classPayoutSum(positions, idx, payoutMap)
 				maxAdditional := cap.Sub(alreadyPaid)
 				if maxAdditional.LessThanOrEqual(decimal.Zero) {
 					continue
@@ -222,10 +332,10 @@ func distributeProRata(classes []ShareClassPosition, pool, totalShares decimal.D
 			}
 		}
 
-		for _, h := range cls.Holders {
-			holderFraction := h.Shares.Div(cls.TotalShares)
-			holderPayout := classPool.Mul(holderFraction).RoundFloor(4)
-			payoutMap[h.StakeholderID] = payoutMap[h.StakeholderID].Add(holderPayout)
+		for j := range cls.Holders {
+			holderPayout := classPool.Mul(holderFraction(cls, j)).RoundFloor(4)
+			k := holderKey{idx, j}
+			payoutMap[k] = payoutMap[k].Add(holderPayout)
 		}
 	}
 }
@@ -245,10 +355,11 @@ func cloneIntSet(m map[int]bool) map[int]bool {
 	return c
 }
 
-func holderPayoutSum(holders []HolderPosition, payouts map[string]decimal.Decimal) decimal.Decimal {
+// classPayoutSum totals the payouts to the holders of positions[idx].
+func classPayoutSum(positions []ShareClassPosition, idx int, payouts map[holderKey]decimal.Decimal) decimal.Decimal {
 	total := decimal.Zero
-	for _, h := range holders {
-		total = total.Add(payouts[h.StakeholderID])
+	for j := range positions[idx].Holders {
+		total = total.Add(payouts[holderKey{idx, j}])
 	}
 	return total
 }
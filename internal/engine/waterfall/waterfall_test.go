@@ -544,6 +544,166 @@ func TestCalculate_MixedParticipatingAndNonParticipating(t *testing.T) {
 	}
 }
 
+func TestCalculateWithClaims(t *testing.T) {
+	// 9M common held by the founder. A $1M SAFE converts into 1M shares at
+	// its cap; a $600K note has no cap and can only be repaid, ahead of the SAFE.
+	common := ShareClassPosition{
+		ShareClass:  domain.ShareClass{Name: "Common"},
+		Holders:     []HolderPosition{{StakeholderID: "f1", StakeholderName: "Founder", Shares: dec("9000000")}},
+		TotalShares: dec("9000000"),
+	}
+	safeClaim := ConvertibleClaim{StakeholderID: "s1", StakeholderName: "SAFE Investor", Instrument: "SAFE", CashOut: dec("1000000"), ConversionShares: dec("1000000")}
+	noteClaim := ConvertibleClaim{StakeholderID: "n1", StakeholderName: "Noteholder", Instrument: "Convertible Note", IsDebt: true, CashOut: dec("600000"), ConversionShares: decimal.Zero}
+
+	tests := []struct {
+		name         string
+		claims       []ConvertibleClaim
+		exit         string
+		wantSAFE     string
+		wantElection string
+		wantNote     string
+		wantFounder  string
+	}{
+		{"SAFE cashes out", []ConvertibleClaim{safeClaim}, "5000000", "1000000", "cash_out", "", "4000000"},
+		{"SAFE converts", []ConvertibleClaim{safeClaim}, "20000000", "2000000", "convert", "", "18000000"},
+		{"note repaid ahead of SAFE", []ConvertibleClaim{safeClaim, noteClaim}, "1200000", "600000", "cash_out", "600000", ""},
+		{"note repaid, SAFE converts", []ConvertibleClaim{safeClaim, noteClaim}, "20600000", "2000000", "convert", "600000", "18000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CalculateWithClaims([]ShareClassPosition{common}, tt.claims, dec(tt.exit))
+
+			s := findPayout(result, "s1")
+			if s == nil || !s.Payout.Equal(dec(tt.wantSAFE)) || s.ClaimElection != tt.wantElection {
+				t.Fatalf("SAFE payout = %+v, want %s by %s", s, tt.wantSAFE, tt.wantElection)
+			}
+			if s.ShareClassName != "SAFE" {
+				t.Errorf("SAFE share class = %q, want SAFE", s.ShareClassName)
+			}
+			checkPayout(t, result, "n1", tt.wantNote)
+			checkPayout(t, result, "f1", tt.wantFounder)
+			if !result.TotalPayout.Equal(dec(tt.exit)) {
+				t.Errorf("total payout = %s, want %s", result.TotalPayout, tt.exit)
+			}
+		})
+	}
+}
+
+func TestCalculateWithClaims_SAFERanksWithPreferred(t *testing.T) {
+	// A $2M Series A and a $1M SAFE rank together behind a $500K note. A $2M
+	// exit repays the note and leaves $1.5M for $3M of preferences, so the
+	// Series A and the SAFE each get half of theirs.
+	positions := []ShareClassPosition{
+		{
+			ShareClass: domain.ShareClass{
+				Name:                "Series A",
+				IsPreferred:         true,
+				LiquidationMultiple: dec("1"),
+				PricePerShare:       ppsPtr("2.00"),
+				Seniority:           1,
+			},
+			Holders:     []HolderPosition{{StakeholderID: "inv1", StakeholderName: "Investor A", Shares: dec("1000000")}},
+			TotalShares: dec("1000000"),
+		},
+		{
+			ShareClass:  domain.ShareClass{Name: "Common"},
+			Holders:     []HolderPosition{{StakeholderID: "f1", StakeholderName: "Founder", Shares: dec("5000000")}},
+			TotalShares: dec("5000000"),
+		},
+	}
+	claims := []ConvertibleClaim{
+		{StakeholderID: "s1", StakeholderName: "SAFE Investor", Instrument: "SAFE", CashOut: dec("1000000"), ConversionShares: dec("500000")},
+		{StakeholderID: "n1", StakeholderName: "Noteholder", Instrument: "Convertible Note", IsDebt: true, CashOut: dec("500000")},
+	}
+
+	result := CalculateWithClaims(positions, claims, dec("2000000"))
+
+	checkPayout(t, result, "n1", "500000")
+	checkPayout(t, result, "inv1", "1000000")
+	checkPayout(t, result, "s1", "500000")
+	checkPayout(t, result, "f1", "")
+}
+
+func TestCalculate_ShadowSeries(t *testing.T) {
+	// A SAFE converted at $1 into Series A-1, a shadow series of the $2
+	// Series A. Its preference is what the holder paid, not the round price.
+	seriesA := domain.ShareClass{
+		Name:                "Series A",
+		IsPreferred:         true,
+		LiquidationMultiple: dec("1"),
+		PricePerShare:       ppsPtr("2.00"),
+		Seniority:           1,
+	}
+	shadow := seriesA
+	shadow.Name = "Series A-1"
+	shadow.PricePerShare = ppsPtr("1.00")
+	positions := []ShareClassPosition{
+		{
+			ShareClass:  seriesA,
+			Holders:     []HolderPosition{{StakeholderID: "inv1", StakeholderName: "Investor A", Shares: dec("1000000")}},
+			TotalShares: dec("1000000"),
+		},
+		{
+			ShareClass:  shadow,
+			Holders:     []HolderPosition{{StakeholderID: "safe1", StakeholderName: "SAFE Investor", Shares: dec("500000")}},
+			TotalShares: dec("500000"),
+		},
+		{
+			ShareClass:  domain.ShareClass{Name: "Common"},
+			Holders:     []HolderPosition{{StakeholderID: "f1", StakeholderName: "Founder", Shares: dec("5000000")}},
+			TotalShares: dec("5000000"),
+		},
+	}
+
+	result := Calculate(positions, dec("3000000"))
+
+	checkPayout(t, result, "inv1", "2000000")
+	checkPayout(t, result, "safe1", "500000")
+	checkPayout(t, result, "f1", "500000")
+}
+
+func TestCalculate_HolderInSeveralClasses(t *testing.T) {
+	// The investor's preferred and common are paid, and reported, separately.
+	positions := []ShareClassPosition{
+		{
+			ShareClass: domain.ShareClass{
+				Name:                "Preferred A",
+				IsPreferred:         true,
+				LiquidationMultiple: dec("1"),
+				PricePerShare:       ppsPtr("1.00"),
+				Seniority:           1,
+			},
+			Holders:     []HolderPosition{{StakeholderID: "inv1", StakeholderName: "Investor A", Shares: dec("2000000")}},
+			TotalShares: dec("2000000"),
+		},
+		{
+			ShareClass: domain.ShareClass{Name: "Common"},
+			Holders: []HolderPosition{
+				{StakeholderID: "inv1", StakeholderName: "Investor A", Shares: dec("1000000")},
+				{StakeholderID: "f1", StakeholderName: "Founder", Shares: dec("4000000")},
+			},
+			TotalShares: dec("5000000"),
+		},
+	}
+
+	result := Calculate(positions, dec("7000000"))
+
+	if len(result.Payouts) != 3 {
+		t.Fatalf("got %d payouts, want 3", len(result.Payouts))
+	}
+	for _, p := range result.Payouts {
+		if p.StakeholderID == "inv1" && p.ShareClassName == "Preferred A" && !p.Payout.Equal(dec("2000000")) {
+			t.Errorf("investor preferred payout = %s, want 2000000", p.Payout)
+		}
+		if p.StakeholderID == "inv1" && p.ShareClassName == "Common" && !p.Payout.Equal(dec("1000000")) {
+			t.Errorf("investor common payout = %s, want 1000000", p.Payout)
+		}
+	}
+	if !result.TotalPayout.Equal(dec("7000000")) {
+		t.Errorf("total payout = %s, want 7000000", result.TotalPayout)
+	}
+}
+
 func findPayout(result domain.WaterfallResult, stakeholderID string) *domain.WaterfallPayout {
 	for _, p := range result.Payouts {
 		if p.StakeholderID == stakeholderID {
@@ -552,3 +712,17 @@ func findPayout(result domain.WaterfallResult, stakeholderID string) *domain.Wat
 	}
 	return nil
 }
+
+func checkPayout(t *testing.T, result domain.WaterfallResult, stakeholderID, want string) {
+	t.Helper()
+	p := findPayout(result, stakeholderID)
+	if want == "" {
+		if p != nil {
+			t.Errorf("%s payout = %s, want none", stakeholderID, p.Payout)
+		}
+		return
+	}
+	if p == nil || !p.Payout.Equal(dec(want)) {
+		t.Errorf("%s payout = %v, want %s", stakeholderID, p, want)
+	}
+}
@@ -2,6 +2,7 @@ package convert
 
 import (
 	"strings"
+	"time"
 
 	"github.com/hutfut/vestigo/internal/domain"
 	"github.com/hutfut/vestigo/internal/graph/model"
@@ -19,19 +20,40 @@ func ToGQLCompany(c *domain.Company) *model.Company {
 		Grants:        []*model.Grant{},
 		FundingRounds: []*model.FundingRound{},
 		SafeNotes:     []*model.SAFENote{},
+		OptionPools:   []*model.OptionPool{},
 		CreatedAt:     model.DateTime(c.CreatedAt),
 	}
 }
 
 func ToGQLStakeholder(sh *domain.Stakeholder) *model.Stakeholder {
-	return &model.Stakeholder{
-		ID:        sh.ID,
-		CompanyID: sh.CompanyID,
-		Name:      sh.Name,
-		Email:     sh.Email,
-		Role:      DomainRoleToGQL(sh.Role),
-		Grants:    []*model.Grant{},
-		CreatedAt: model.DateTime(sh.CreatedAt),
+	msh := &model.Stakeholder{
+		ID:              sh.ID,
+		CompanyID:       sh.CompanyID,
+		Name:            sh.Name,
+		Email:           sh.Email,
+		Role:            DomainRoleToGQL(sh.Role),
+		TerminationDate: TimePtrToDatePtr(sh.TerminationDate),
+		Grants:          []*model.Grant{},
+		Leaves:          []*model.LeaveOfAbsence{},
+		CreatedAt:       model.DateTime(sh.CreatedAt),
+	}
+	if sh.TerminationReason != nil {
+		reason := DomainTerminationReasonToGQL(*sh.TerminationReason)
+		msh.TerminationReason = &reason
+	}
+	return msh
+}
+
+func ToGQLOptionPool(p *domain.OptionPool) *model.OptionPool {
+	return &model.OptionPool{
+		ID:              p.ID,
+		CompanyID:       p.CompanyID,
+		ShareClassID:    p.ShareClassID,
+		Name:            p.Name,
+		ReservedShares:  model.Decimal(p.ReservedShares),
+		GrantedShares:   model.Decimal(p.GrantedShares),
+		AvailableShares: model.Decimal(p.AvailableShares()),
+		CreatedAt:       model.DateTime(p.CreatedAt),
 	}
 }
 
@@ -47,91 +69,390 @@ func ToGQLShareClass(sc *domain.ShareClass) *model.ShareClass {
 		PricePerShare:       DecPtrToGQLDecPtr(sc.PricePerShare),
 		Seniority:           sc.Seniority,
 		AuthorizedShares:    model.Decimal(sc.AuthorizedShares),
+		ParentShareClassID:  sc.ParentShareClassID,
 		CreatedAt:           model.DateTime(sc.CreatedAt),
 	}
 }
 
 func ToGQLVestingSchedule(vs *domain.VestingSchedule) *model.VestingSchedule {
-	return &model.VestingSchedule{
-		ID:                  vs.ID,
-		CliffMonths:         vs.CliffMonths,
-		TotalMonths:         vs.TotalMonths,
-		Frequency:           DomainFreqToGQL(vs.Frequency),
-		AccelerationTrigger: DomainAccelToGQL(vs.AccelerationTrigger),
+	mvs := &model.VestingSchedule{
+		ID:                       vs.ID,
+		CompanyID:                vs.CompanyID,
+		Name:                     vs.Name,
+		CliffMonths:              vs.CliffMonths,
+		TotalMonths:              vs.TotalMonths,
+		Frequency:                DomainFreqToGQL(vs.Frequency),
+		AccelerationTrigger:      DomainAccelToGQL(vs.AccelerationTrigger),
+		AccelerationPercent:      DecPtrToGQLDecPtr(vs.AccelerationPercent),
+		AccelerationMonths:       vs.AccelerationMonths,
+		AccelerationWindowMonths: vs.AccelerationWindowMonths,
+		Tranches:                 ToGQLVestingTranches(vs.Tranches),
+		Rounding:                 DomainRoundingToGQL(vs.Rounding),
+	}
+	if vs.ArchivedAt != nil {
+		archivedAt := model.DateTime(*vs.ArchivedAt)
+		mvs.ArchivedAt = &archivedAt
 	}
+	return mvs
+}
+
+func ToGQLVestingTranches(tranches []domain.VestingTranche) []*model.VestingTranche {
+	result := make([]*model.VestingTranche, len(tranches))
+	for i, t := range tranches {
+		result[i] = &model.VestingTranche{
+			OffsetMonths: t.OffsetMonths,
+			Percent:      DecPtrToGQLDecPtr(t.Percent),
+			Shares:       DecPtrToGQLDecPtr(t.Shares),
+		}
+	}
+	return result
 }
 
 func ToGQLGrant(g *domain.Grant) *model.Grant {
 	mg := &model.Grant{
-		ID:                g.ID,
-		CompanyID:         g.CompanyID,
-		StakeholderID:     g.StakeholderID,
-		ShareClassID:      g.ShareClassID,
-		VestingScheduleID: g.VestingScheduleID,
-		Quantity:          model.Decimal(g.Quantity),
-		GrantDate:         model.Date(g.GrantDate),
-		ExercisePrice:     model.Decimal(g.ExercisePrice),
-		IsExercised:       g.IsExercised,
-		Notes:             g.Notes,
-		CreatedAt:         model.DateTime(g.CreatedAt),
+		ID:                      g.ID,
+		CompanyID:               g.CompanyID,
+		StakeholderID:           g.StakeholderID,
+		ShareClassID:            g.ShareClassID,
+		VestingScheduleID:       g.VestingScheduleID,
+		OptionPoolID:            g.OptionPoolID,
+		Type:                    DomainGrantTypeToGQL(g.Type),
+		Quantity:                model.Decimal(g.Quantity),
+		GrantDate:               model.Date(g.GrantDate),
+		VestingCommencementDate: model.Date(g.VestingCommencementDate),
+		ExercisePrice:           model.Decimal(g.ExercisePrice),
+		IsExercised:             g.IsExercised,
+		EarlyExerciseAllowed:    g.EarlyExerciseAllowed,
+		Notes:                   g.Notes,
+		TerminationDate:         TimePtrToDatePtr(g.TerminationDate),
+		ForfeitedQuantity:       model.Decimal(g.ForfeitedQuantity),
+		ExerciseDeadline:        TimePtrToDatePtr(g.ExerciseDeadline),
+		ExpiredQuantity:         model.Decimal(g.ExpiredQuantity),
+		RepurchasePrice:         DecPtrToGQLDecPtr(g.RepurchasePrice),
+		RepurchasedQuantity:     model.Decimal(g.RepurchasedQuantity),
+		RepurchaseDate:          TimePtrToDatePtr(g.RepurchaseDate),
+		CreatedAt:               model.DateTime(g.CreatedAt),
 	}
 	if g.VestingSchedule != nil {
 		mg.VestingSchedule = ToGQLVestingSchedule(g.VestingSchedule)
 	}
+	mg.Milestones = make([]*model.GrantMilestone, len(g.Milestones))
+	for i := range g.Milestones {
+		mg.Milestones[i] = ToGQLGrantMilestone(&g.Milestones[i])
+	}
+	mg.Leaves = make([]*model.LeaveOfAbsence, len(g.Leaves))
+	for i := range g.Leaves {
+		mg.Leaves[i] = ToGQLLeave(&g.Leaves[i])
+	}
+	mg.Exercises = make([]*model.GrantExercise, len(g.Exercises))
+	for i := range g.Exercises {
+		mg.Exercises[i] = ToGQLGrantExercise(&g.Exercises[i])
+	}
 	return mg
 }
 
+func ToGQLGrantExercise(e *domain.GrantExercise) *model.GrantExercise {
+	return &model.GrantExercise{
+		ID:           e.ID,
+		GrantID:      e.GrantID,
+		Quantity:     model.Decimal(e.Quantity),
+		ExerciseDate: model.Date(e.ExerciseDate),
+		CreatedAt:    model.DateTime(e.CreatedAt),
+	}
+}
+
+func ToGQLLeave(l *domain.LeaveOfAbsence) *model.LeaveOfAbsence {
+	return &model.LeaveOfAbsence{
+		ID:            l.ID,
+		StakeholderID: l.StakeholderID,
+		GrantID:       l.GrantID,
+		StartDate:     model.Date(l.StartDate),
+		EndDate:       model.Date(l.EndDate),
+		IsPaid:        l.IsPaid,
+		CreatedAt:     model.DateTime(l.CreatedAt),
+	}
+}
+
+func ToGQLGrantMilestone(m *domain.GrantMilestone) *model.GrantMilestone {
+	return &model.GrantMilestone{
+		ID:           m.ID,
+		GrantID:      m.GrantID,
+		Name:         m.Name,
+		Shares:       model.Decimal(m.Shares),
+		Condition:    DomainMilestoneConditionToGQL(m.Condition),
+		AchievedDate: TimePtrToDatePtr(m.AchievedDate),
+		CreatedAt:    model.DateTime(m.CreatedAt),
+	}
+}
+
 func ToGQLFundingRound(fr *domain.FundingRound) *model.FundingRound {
 	return &model.FundingRound{
-		ID:                fr.ID,
-		CompanyID:         fr.CompanyID,
-		Name:              fr.Name,
-		PreMoneyValuation: model.Decimal(fr.PreMoneyVal),
-		AmountRaised:      model.Decimal(fr.AmountRaised),
-		PricePerShare:     model.Decimal(fr.PricePerShare),
-		ShareClassID:      fr.ShareClassID,
-		RoundDate:         model.Date(fr.RoundDate),
-		CreatedAt:         model.DateTime(fr.CreatedAt),
+		ID:                 fr.ID,
+		CompanyID:          fr.CompanyID,
+		Name:               fr.Name,
+		PreMoneyValuation:  model.Decimal(fr.PreMoneyVal),
+		AmountRaised:       model.Decimal(fr.AmountRaised),
+		PricePerShare:      model.Decimal(fr.PricePerShare),
+		ShareClassID:       fr.ShareClassID,
+		RoundDate:          model.Date(fr.RoundDate),
+		OptionPoolIncrease: model.Decimal(fr.OptionPoolIncrease),
+		PromisedOptions:    model.Decimal(fr.PromisedOptions),
+		CreatedAt:          model.DateTime(fr.CreatedAt),
 	}
 }
 
 func ToGQLSAFENote(sn *domain.SAFENote) *model.SAFENote {
 	return &model.SAFENote{
-		ID:               sn.ID,
-		CompanyID:        sn.CompanyID,
-		StakeholderID:    sn.StakeholderID,
-		InvestmentAmount: model.Decimal(sn.InvestmentAmount),
-		ValuationCap:     DecPtrToGQLDecPtr(sn.ValuationCap),
-		DiscountRate:     DecPtrToGQLDecPtr(sn.DiscountRate),
-		SafeType:         DomainSAFETypeToGQL(sn.SAFEType),
-		IsConverted:      sn.IsConverted,
-		ConvertedInRound: sn.ConvertedInRound,
-		IssueDate:        model.Date(sn.IssueDate),
-		CreatedAt:        model.DateTime(sn.CreatedAt),
+		ID:                       sn.ID,
+		CompanyID:                sn.CompanyID,
+		StakeholderID:            sn.StakeholderID,
+		InvestmentAmount:         model.Decimal(sn.InvestmentAmount),
+		ValuationCap:             DecPtrToGQLDecPtr(sn.ValuationCap),
+		DiscountRate:             DecPtrToGQLDecPtr(sn.DiscountRate),
+		SafeType:                 DomainSAFETypeToGQL(sn.SAFEType),
+		IsMfn:                    sn.IsMFN,
+		CapitalizationDefinition: DomainCapitalizationDefinitionToGQL(sn.CapitalizationDefinition),
+		IsConverted:              sn.IsConverted,
+		ConvertedInRound:         sn.ConvertedInRound,
+		Conversion:               ToGQLSAFEConversionResult(sn),
+		IssueDate:                model.Date(sn.IssueDate),
+		CreatedAt:                model.DateTime(sn.CreatedAt),
+	}
+}
+
+// ToGQLSAFEConversionResult returns the SAFE's conversion, or nil if it has
+// not converted.
+func ToGQLSAFEConversionResult(sn *domain.SAFENote) *model.SAFEConversionResult {
+	if sn.Conversion == nil {
+		return nil
+	}
+	return &model.SAFEConversionResult{
+		SafeID:           sn.ID,
+		SharesIssued:     model.Decimal(sn.Conversion.SharesIssued),
+		EffectivePps:     model.Decimal(sn.Conversion.EffectivePPS),
+		ConversionMethod: sn.Conversion.ConversionMethod,
+		GrantID:          sn.ConversionGrantID,
+
+		InheritedTermsFrom: sn.Conversion.InheritedTermsFrom,
+		Capitalization:     ToGQLCapitalization(sn.Conversion.Capitalization),
+	}
+}
+
+func ToGQLCapitalization(c *domain.Capitalization) *model.Capitalization {
+	if c == nil {
+		return nil
+	}
+	return &model.Capitalization{
+		Definition:      DomainCapitalizationDefinitionToGQL(c.Definition),
+		IssuedShares:    model.Decimal(c.IssuedShares),
+		IssuedOptions:   model.Decimal(c.IssuedOptions),
+		PromisedOptions: model.Decimal(c.PromisedOptions),
+		UnissuedPool:    model.Decimal(c.UnissuedPool),
+		PoolIncrease:    model.Decimal(c.PoolIncrease),
+		Total:           model.Decimal(c.Total),
+	}
+}
+
+func ToGQLSAFEConversionPreview(p *domain.SAFEConversionPreview) *model.SAFEConversionPreview {
+	conversions := make([]*model.SAFEConversionResult, len(p.Conversions))
+	for i, c := range p.Conversions {
+		conversions[i] = &model.SAFEConversionResult{
+			SafeID:             c.SAFEID,
+			SharesIssued:       model.Decimal(c.SharesIssued),
+			EffectivePps:       model.Decimal(c.EffectivePPS),
+			ConversionMethod:   c.ConversionMethod,
+			InheritedTermsFrom: c.InheritedTermsFrom,
+			Capitalization:     ToGQLCapitalization(c.Capitalization),
+		}
+	}
+	holders := make([]*model.SAFEHolderOwnership, len(p.Holders))
+	for i, h := range p.Holders {
+		holders[i] = &model.SAFEHolderOwnership{
+			StakeholderID:    h.StakeholderID,
+			StakeholderName:  h.StakeholderName,
+			ExistingShares:   model.Decimal(h.ExistingShares),
+			ConversionShares: model.Decimal(h.ConversionShares),
+			OwnershipPct:     model.Decimal(h.OwnershipPct),
+		}
+	}
+	return &model.SAFEConversionPreview{
+		ScenarioName:      p.Round.Name,
+		PreMoneyValuation: model.Decimal(p.Round.PreMoneyVal),
+		AmountRaised:      model.Decimal(p.Round.AmountRaised),
+		PricePerShare:     model.Decimal(p.Round.PricePerShare),
+		PostMoneyShares:   model.Decimal(p.PostMoneyShares),
+		Conversions:       conversions,
+		Holders:           holders,
+	}
+}
+
+func ToGQLMFNNotice(n *domain.MFNNotice) *model.MFNNotice {
+	return &model.MFNNotice{
+		ID:           n.ID,
+		SafeID:       n.SAFEID,
+		SourceSafeID: n.SourceSAFEID,
+		ValuationCap: DecPtrToGQLDecPtr(n.ValuationCap),
+		DiscountRate: DecPtrToGQLDecPtr(n.DiscountRate),
+		CreatedAt:    model.DateTime(n.CreatedAt),
+	}
+}
+
+func ToGQLConvertibleNote(n *domain.ConvertibleNote) *model.ConvertibleNote {
+	return &model.ConvertibleNote{
+		ID:                       n.ID,
+		CompanyID:                n.CompanyID,
+		StakeholderID:            n.StakeholderID,
+		Principal:                model.Decimal(n.Principal),
+		InterestRate:             model.Decimal(n.InterestRate),
+		InterestType:             DomainInterestTypeToGQL(n.InterestType),
+		DayCount:                 DomainDayCountToGQL(n.DayCount),
+		IssueDate:                model.Date(n.IssueDate),
+		MaturityDate:             model.Date(n.MaturityDate),
+		ValuationCap:             DecPtrToGQLDecPtr(n.ValuationCap),
+		DiscountRate:             DecPtrToGQLDecPtr(n.DiscountRate),
+		QualifiedFinancingAmount: DecPtrToGQLDecPtr(n.QualifiedFinancingAmount),
+		MaturityValuationCap:     DecPtrToGQLDecPtr(n.MaturityValuationCap),
+		Settlement:               ToGQLNoteSettlement(n),
+		SettledInRound:           n.SettledInRound,
+		CreatedAt:                model.DateTime(n.CreatedAt),
+	}
+}
+
+// ToGQLNoteSettlement returns the note's settlement, or nil if it is still
+// outstanding.
+func ToGQLNoteSettlement(n *domain.ConvertibleNote) *model.NoteSettlement {
+	st := n.Settlement
+	if st == nil {
+		return nil
+	}
+	return &model.NoteSettlement{
+		NoteID:          n.ID,
+		Date:            model.Date(st.Date),
+		Principal:       model.Decimal(st.Principal),
+		AccruedInterest: model.Decimal(st.AccruedInterest),
+		SharesIssued:    model.Decimal(st.SharesIssued),
+		EffectivePps:    model.Decimal(st.EffectivePPS),
+		RepaymentAmount: model.Decimal(st.RepaymentAmount),
+		Method:          st.Method,
+		GrantID:         n.SettlementGrantID,
+	}
+}
+
+func ToGQLNoteAccrual(a *domain.NoteAccrual) *model.NoteAccrual {
+	return &model.NoteAccrual{
+		NoteID:          a.NoteID,
+		AsOfDate:        model.Date(a.AsOfDate),
+		Principal:       model.Decimal(a.Principal),
+		AccruedInterest: model.Decimal(a.AccruedInterest),
+		Balance:         model.Decimal(a.Balance),
 	}
 }
 
 func ToGQLVestingStatus(vs *domain.VestingStatus) *model.VestingStatus {
 	return &model.VestingStatus{
-		GrantID:        vs.GrantID,
-		AsOfDate:       model.Date(vs.AsOfDate),
-		TotalShares:    model.Decimal(vs.TotalShares),
-		VestedShares:   model.Decimal(vs.VestedShares),
-		UnvestedShares: model.Decimal(vs.UnvestedShares),
-		PercentVested:  model.Decimal(vs.PercentVested),
-		CliffDate:      model.Date(vs.CliffDate),
-		FullyVestedAt:  model.Date(vs.FullyVestedAt),
-		IsFullyVested:  vs.IsFullyVested,
+		GrantID:           vs.GrantID,
+		AsOfDate:          model.Date(vs.AsOfDate),
+		TotalShares:       model.Decimal(vs.TotalShares),
+		VestedShares:      model.Decimal(vs.VestedShares),
+		UnvestedShares:    model.Decimal(vs.UnvestedShares),
+		PercentVested:     model.Decimal(vs.PercentVested),
+		CliffDate:         model.Date(vs.CliffDate),
+		FullyVestedAt:     model.Date(vs.FullyVestedAt),
+		IsFullyVested:     vs.IsFullyVested,
+		AcceleratedShares: model.Decimal(vs.AcceleratedShares),
+
+		ExercisedShares:     model.Decimal(vs.ExercisedShares),
+		ReleasedShares:      model.Decimal(vs.ReleasedShares),
+		RepurchasableShares: model.Decimal(vs.RepurchasableShares),
 	}
 }
 
+func ToGQLVestEvents(events []domain.VestEvent) []*model.VestEvent {
+	result := make([]*model.VestEvent, len(events))
+	for i, e := range events {
+		result[i] = &model.VestEvent{
+			Date:             model.Date(e.Date),
+			Shares:           model.Decimal(e.Shares),
+			CumulativeVested: model.Decimal(e.CumulativeVested),
+		}
+	}
+	return result
+}
+
+func ToGQLVestingForecast(periods []domain.VestingForecastPeriod) []*model.VestingForecastPeriod {
+	result := make([]*model.VestingForecastPeriod, len(periods))
+	for i, p := range periods {
+		breakdown := make([]*model.VestingForecastLine, len(p.Breakdown))
+		for j, l := range p.Breakdown {
+			breakdown[j] = &model.VestingForecastLine{
+				ShareClassID:   l.ShareClassID,
+				ShareClassName: l.ShareClassName,
+				Role:           DomainRoleToGQL(l.Role),
+				VestedShares:   model.Decimal(l.VestedShares),
+			}
+		}
+		result[i] = &model.VestingForecastPeriod{
+			PeriodStart:  model.Date(p.PeriodStart),
+			PeriodEnd:    model.Date(p.PeriodEnd),
+			VestedShares: model.Decimal(p.VestedShares),
+			Breakdown:    breakdown,
+		}
+	}
+	return result
+}
+
 func ToGQLDilutionResult(r *domain.DilutionResult) *model.DilutionResult {
+	investors := make([]*model.CapTableEntry, len(r.Investors))
+	for i := range r.Investors {
+		investors[i] = ToGQLCapTableEntry(&r.Investors[i])
+	}
+	conversions := make([]*model.CapTableEntry, len(r.Conversions))
+	for i := range r.Conversions {
+		conversions[i] = ToGQLCapTableEntry(&r.Conversions[i])
+	}
 	return &model.DilutionResult{
 		PreRound:    ToGQLCapTableSnapshot(&r.PreRound),
 		PostRound:   ToGQLCapTableSnapshot(&r.PostRound),
 		NewInvestor: ToGQLCapTableEntry(&r.NewInvestor),
 		RoundName:   r.RoundName,
+
+		Investors:          investors,
+		AllocatedAmount:    model.Decimal(r.AllocatedAmount),
+		AllocationMismatch: r.AllocationMismatch,
+
+		EffectivePps:        model.Decimal(r.EffectivePPS),
+		PoolIncrease:        model.Decimal(r.PoolIncrease),
+		PoolDilutionPct:     model.Decimal(r.PoolDilutionPct),
+		NewMoneyDilutionPct: model.Decimal(r.NewMoneyDilutionPct),
+
+		Conversions:           conversions,
+		ConversionDilutionPct: model.Decimal(r.ConversionDilutionPct),
+	}
+}
+
+func ToGQLRoundSequenceResult(r *domain.RoundSequenceResult) *model.RoundSequenceResult {
+	steps := make([]*model.DilutionResult, len(r.Steps))
+	for i := range r.Steps {
+		steps[i] = ToGQLDilutionResult(&r.Steps[i])
 	}
+	trajectories := make([]*model.OwnershipTrajectory, len(r.Trajectories))
+	for i, t := range r.Trajectories {
+		var shID *string
+		if t.StakeholderID != "" {
+			shID = &r.Trajectories[i].StakeholderID
+		}
+		pcts := make([]*model.Decimal, len(t.OwnershipPct))
+		for j, p := range t.OwnershipPct {
+			d := model.Decimal(p)
+			pcts[j] = &d
+		}
+		trajectories[i] = &model.OwnershipTrajectory{
+			StakeholderID:   shID,
+			StakeholderName: t.StakeholderName,
+			OwnershipPct:    pcts,
+		}
+	}
+	return &model.RoundSequenceResult{Steps: steps, Trajectories: trajectories}
 }
 
 func ToGQLCapTableSnapshot(s *domain.CapTableSnapshot) *model.CapTableSnapshot {
@@ -139,10 +460,21 @@ func ToGQLCapTableSnapshot(s *domain.CapTableSnapshot) *model.CapTableSnapshot {
 	for i := range s.Entries {
 		entries[i] = ToGQLCapTableEntry(&s.Entries[i])
 	}
+	var basis *model.CapTableBasis
+	if s.Basis != "" {
+		b := DomainCapTableBasisToGQL(s.Basis)
+		basis = &b
+	}
 	return &model.CapTableSnapshot{
 		CompanyID:   &s.CompanyID,
 		TotalShares: model.Decimal(s.TotalShares),
 		Entries:     entries,
+
+		Basis:             basis,
+		IssuedShares:      model.Decimal(s.IssuedShares),
+		OptionShares:      model.Decimal(s.OptionShares),
+		ConvertibleShares: model.Decimal(s.ConvertibleShares),
+		PoolShares:        model.Decimal(s.PoolShares),
 	}
 }
 
@@ -157,9 +489,51 @@ func ToGQLCapTableEntry(e *domain.CapTableEntry) *model.CapTableEntry {
 		ShareClassName:  e.ShareClassName,
 		Shares:          model.Decimal(e.Shares),
 		OwnershipPct:    model.Decimal(e.OwnershipPct),
+
+		ExercisedUnvestedShares: model.Decimal(e.ExercisedUnvestedShares),
+		IssuedShares:            model.Decimal(e.IssuedShares),
+		OptionShares:            model.Decimal(e.OptionShares),
+		ConvertibleShares:       model.Decimal(e.ConvertibleShares),
+		PoolShares:              model.Decimal(e.PoolShares),
 	}
 }
 
+// ─── GraphQL → Domain ─────────────────────────────────────────────────────────
+
+func GQLVestingTranchesToDomain(inputs []*model.VestingTrancheInput) []domain.VestingTranche {
+	if len(inputs) == 0 {
+		return nil
+	}
+	result := make([]domain.VestingTranche, len(inputs))
+	for i, t := range inputs {
+		result[i] = domain.VestingTranche{
+			OffsetMonths: t.OffsetMonths,
+			Percent:      GQLDecToDecPtr(t.Percent),
+			Shares:       GQLDecToDecPtr(t.Shares),
+		}
+	}
+	return result
+}
+
+func GQLGrantMilestonesToDomain(inputs []*model.GrantMilestoneInput) []domain.GrantMilestone {
+	if len(inputs) == 0 {
+		return nil
+	}
+	result := make([]domain.GrantMilestone, len(inputs))
+	for i, m := range inputs {
+		cond := domain.MilestoneOnly
+		if m.Condition != nil {
+			cond = GQLMilestoneConditionToDomain(*m.Condition)
+		}
+		result[i] = domain.GrantMilestone{
+			Name:      m.Name,
+			Shares:    decimal.Decimal(m.Shares),
+			Condition: cond,
+		}
+	}
+	return result
+}
+
 // ─── Enum converters ──────────────────────────────────────────────────────────
 
 func GQLRoleToDomain(r model.StakeholderRole) domain.StakeholderRole {
@@ -170,6 +544,14 @@ func DomainRoleToGQL(r domain.StakeholderRole) model.StakeholderRole {
 	return model.StakeholderRole(strings.ToUpper(string(r)))
 }
 
+func GQLTerminationReasonToDomain(r model.TerminationReason) domain.TerminationReason {
+	return domain.TerminationReason(strings.ToLower(string(r)))
+}
+
+func DomainTerminationReasonToGQL(r domain.TerminationReason) model.TerminationReason {
+	return model.TerminationReason(strings.ToUpper(string(r)))
+}
+
 func GQLFreqToDomain(f model.VestingFrequency) domain.VestingFrequency {
 	return domain.VestingFrequency(strings.ToLower(string(f)))
 }
@@ -186,6 +568,28 @@ func DomainAccelToGQL(a domain.AccelerationTrigger) model.AccelerationTrigger {
 	return model.AccelerationTrigger(strings.ToUpper(string(a)))
 }
 
+func GQLGrantTypeToDomain(t model.GrantType) domain.GrantType {
+	return domain.GrantType(strings.ToLower(string(t)))
+}
+
+func DomainGrantTypeToGQL(t domain.GrantType) model.GrantType {
+	if t == "" {
+		return model.GrantTypeOption
+	}
+	return model.GrantType(strings.ToUpper(string(t)))
+}
+
+func GQLRoundingToDomain(r model.VestingRounding) domain.VestingRounding {
+	return domain.VestingRounding(strings.ToLower(string(r)))
+}
+
+func DomainRoundingToGQL(r domain.VestingRounding) model.VestingRounding {
+	if r == "" {
+		return model.VestingRoundingFractional
+	}
+	return model.VestingRounding(strings.ToUpper(string(r)))
+}
+
 func GQLSAFETypeToDomain(t model.SAFEType) domain.SAFEType {
 	return domain.SAFEType(strings.ToLower(string(t)))
 }
@@ -194,6 +598,72 @@ func DomainSAFETypeToGQL(t domain.SAFEType) model.SAFEType {
 	return model.SAFEType(strings.ToUpper(string(t)))
 }
 
+func GQLCapitalizationDefinitionToDomain(d *model.CapitalizationDefinition) domain.CapitalizationDefinition {
+	if d == nil {
+		return domain.CapitalizationOutstanding
+	}
+	return domain.CapitalizationDefinition(strings.ToLower(string(*d)))
+}
+
+func DomainCapitalizationDefinitionToGQL(d domain.CapitalizationDefinition) model.CapitalizationDefinition {
+	if d == "" {
+		return model.CapitalizationDefinitionOutstanding
+	}
+	return model.CapitalizationDefinition(strings.ToUpper(string(d)))
+}
+
+func GQLCapTableBasisToDomain(b *model.CapTableBasis) domain.CapTableBasis {
+	if b == nil {
+		return domain.CapTableFullyDiluted
+	}
+	return domain.CapTableBasis(strings.ToLower(string(*b)))
+}
+
+func DomainCapTableBasisToGQL(b domain.CapTableBasis) model.CapTableBasis {
+	return model.CapTableBasis(strings.ToUpper(string(b)))
+}
+
+func GQLInterestTypeToDomain(t model.InterestType) domain.InterestType {
+	return domain.InterestType(strings.ToLower(string(t)))
+}
+
+func DomainInterestTypeToGQL(t domain.InterestType) model.InterestType {
+	if t == "" {
+		return model.InterestTypeSimple
+	}
+	return model.InterestType(strings.ToUpper(string(t)))
+}
+
+// GraphQL enum values cannot start with a digit, so 30/360 is spelled out.
+func GQLDayCountToDomain(dc model.DayCount) domain.DayCount {
+	if dc == model.DayCountThirty360 {
+		return domain.DayCount30360
+	}
+	return domain.DayCount(strings.ToLower(string(dc)))
+}
+
+func DomainDayCountToGQL(dc domain.DayCount) model.DayCount {
+	switch dc {
+	case "":
+		return model.DayCountActual365
+	case domain.DayCount30360:
+		return model.DayCountThirty360
+	}
+	return model.DayCount(strings.ToUpper(string(dc)))
+}
+
+func GQLMilestoneConditionToDomain(c model.MilestoneCondition) domain.MilestoneCondition {
+	return domain.MilestoneCondition(strings.ToLower(string(c)))
+}
+
+func DomainMilestoneConditionToGQL(c domain.MilestoneCondition) model.MilestoneCondition {
+	return model.MilestoneCondition(strings.ToUpper(string(c)))
+}
+
+func GQLForecastBucketToDomain(b model.ForecastBucket) domain.ForecastBucket {
+	return domain.ForecastBucket(strings.ToLower(string(b)))
+}
+
 // ─── Decimal / input helpers ──────────────────────────────────────────────────
 
 func GQLDecToDecPtr(d *model.Decimal) *decimal.Decimal {
@@ -212,6 +682,22 @@ func DecPtrToGQLDecPtr(d *decimal.Decimal) *model.Decimal {
 	return &v
 }
 
+func DatePtrToTimePtr(d *model.Date) *time.Time {
+	if d == nil {
+		return nil
+	}
+	t := time.Time(*d)
+	return &t
+}
+
+func TimePtrToDatePtr(t *time.Time) *model.Date {
+	if t == nil {
+		return nil
+	}
+	d := model.Date(*t)
+	return &d
+}
+
 func DecOrDefault(d *model.Decimal, def decimal.Decimal) decimal.Decimal {
 	if d == nil {
 		return def
@@ -48,103 +48,291 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	CapTableEntry struct {
-		OwnershipPct    func(childComplexity int) int
-		ShareClassName  func(childComplexity int) int
-		Shares          func(childComplexity int) int
-		StakeholderID   func(childComplexity int) int
-		StakeholderName func(childComplexity int) int
+		ConvertibleShares       func(childComplexity int) int
+		ExercisedUnvestedShares func(childComplexity int) int
+		IssuedShares            func(childComplexity int) int
+		OptionShares            func(childComplexity int) int
+		OwnershipPct            func(childComplexity int) int
+		PoolShares              func(childComplexity int) int
+		ShareClassName          func(childComplexity int) int
+		Shares                  func(childComplexity int) int
+		StakeholderID           func(childComplexity int) int
+		StakeholderName         func(childComplexity int) int
 	}
 
 	CapTableSnapshot struct {
-		CompanyID   func(childComplexity int) int
-		Entries     func(childComplexity int) int
-		TotalShares func(childComplexity int) int
+		Basis             func(childComplexity int) int
+		CompanyID         func(childComplexity int) int
+		ConvertibleShares func(childComplexity int) int
+		Entries           func(childComplexity int) int
+		IssuedShares      func(childComplexity int) int
+		OptionShares      func(childComplexity int) int
+		PoolShares        func(childComplexity int) int
+		TotalShares       func(childComplexity int) int
+	}
+
+	Capitalization struct {
+		Definition      func(childComplexity int) int
+		IssuedOptions   func(childComplexity int) int
+		IssuedShares    func(childComplexity int) int
+		PoolIncrease    func(childComplexity int) int
+		PromisedOptions func(childComplexity int) int
+		Total           func(childComplexity int) int
+		UnissuedPool    func(childComplexity int) int
 	}
 
 	Company struct {
-		CreatedAt     func(childComplexity int) int
-		FundingRounds func(childComplexity int) int
-		Grants        func(childComplexity int) int
-		ID            func(childComplexity int) int
-		Name          func(childComplexity int) int
-		SafeNotes     func(childComplexity int) int
-		ShareClasses  func(childComplexity int) int
-		Stakeholders  func(childComplexity int) int
+		ConvertibleNotes func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		FundingRounds    func(childComplexity int) int
+		Grants           func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Name             func(childComplexity int) int
+		OptionPools      func(childComplexity int) int
+		SafeNotes        func(childComplexity int) int
+		ShareClasses     func(childComplexity int) int
+		Stakeholders     func(childComplexity int) int
+		VestingSchedules func(childComplexity int) int
+	}
+
+	ConvertibleNote struct {
+		CompanyID                func(childComplexity int) int
+		CreatedAt                func(childComplexity int) int
+		DayCount                 func(childComplexity int) int
+		DiscountRate             func(childComplexity int) int
+		ID                       func(childComplexity int) int
+		InterestRate             func(childComplexity int) int
+		InterestType             func(childComplexity int) int
+		IssueDate                func(childComplexity int) int
+		MaturityDate             func(childComplexity int) int
+		MaturityValuationCap     func(childComplexity int) int
+		Principal                func(childComplexity int) int
+		QualifiedFinancingAmount func(childComplexity int) int
+		SettledInRound           func(childComplexity int) int
+		Settlement               func(childComplexity int) int
+		StakeholderID            func(childComplexity int) int
+		ValuationCap             func(childComplexity int) int
 	}
 
 	DilutionResult struct {
-		NewInvestor func(childComplexity int) int
-		PostRound   func(childComplexity int) int
-		PreRound    func(childComplexity int) int
-		RoundName   func(childComplexity int) int
+		AllocatedAmount       func(childComplexity int) int
+		AllocationMismatch    func(childComplexity int) int
+		ConversionDilutionPct func(childComplexity int) int
+		Conversions           func(childComplexity int) int
+		EffectivePps          func(childComplexity int) int
+		Investors             func(childComplexity int) int
+		NewInvestor           func(childComplexity int) int
+		NewMoneyDilutionPct   func(childComplexity int) int
+		PoolDilutionPct       func(childComplexity int) int
+		PoolIncrease          func(childComplexity int) int
+		PostRound             func(childComplexity int) int
+		PreRound              func(childComplexity int) int
+		RoundName             func(childComplexity int) int
 	}
 
 	FundingRound struct {
-		AmountRaised      func(childComplexity int) int
-		CompanyID         func(childComplexity int) int
-		CreatedAt         func(childComplexity int) int
-		ID                func(childComplexity int) int
-		Name              func(childComplexity int) int
-		PreMoneyValuation func(childComplexity int) int
-		PricePerShare     func(childComplexity int) int
-		RoundDate         func(childComplexity int) int
-		ShareClassID      func(childComplexity int) int
+		AmountRaised       func(childComplexity int) int
+		CompanyID          func(childComplexity int) int
+		CreatedAt          func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		Name               func(childComplexity int) int
+		OptionPoolIncrease func(childComplexity int) int
+		PreMoneyValuation  func(childComplexity int) int
+		PricePerShare      func(childComplexity int) int
+		PromisedOptions    func(childComplexity int) int
+		RoundDate          func(childComplexity int) int
+		ShareClassID       func(childComplexity int) int
 	}
 
 	Grant struct {
-		CompanyID         func(childComplexity int) int
-		CreatedAt         func(childComplexity int) int
-		ExercisePrice     func(childComplexity int) int
-		GrantDate         func(childComplexity int) int
-		ID                func(childComplexity int) int
-		IsExercised       func(childComplexity int) int
-		Notes             func(childComplexity int) int
-		Quantity          func(childComplexity int) int
-		ShareClassID      func(childComplexity int) int
-		StakeholderID     func(childComplexity int) int
-		VestingSchedule   func(childComplexity int) int
-		VestingScheduleID func(childComplexity int) int
+		CompanyID               func(childComplexity int) int
+		CreatedAt               func(childComplexity int) int
+		EarlyExerciseAllowed    func(childComplexity int) int
+		ExerciseDeadline        func(childComplexity int) int
+		ExercisePrice           func(childComplexity int) int
+		Exercises               func(childComplexity int) int
+		ExpiredQuantity         func(childComplexity int) int
+		ForfeitedQuantity       func(childComplexity int) int
+		GrantDate               func(childComplexity int) int
+		ID                      func(childComplexity int) int
+		IsExercised             func(childComplexity int) int
+		Leaves                  func(childComplexity int) int
+		Milestones              func(childComplexity int) int
+		Notes                   func(childComplexity int) int
+		OptionPoolID            func(childComplexity int) int
+		Quantity                func(childComplexity int) int
+		RepurchaseDate          func(childComplexity int) int
+		RepurchasePrice         func(childComplexity int) int
+		RepurchasedQuantity     func(childComplexity int) int
+		ShareClassID            func(childComplexity int) int
+		StakeholderID           func(childComplexity int) int
+		TerminationDate         func(childComplexity int) int
+		Type                    func(childComplexity int) int
+		VestingCommencementDate func(childComplexity int) int
+		VestingSchedule         func(childComplexity int) int
+		VestingScheduleID       func(childComplexity int) int
+	}
+
+	GrantExercise struct {
+		CreatedAt    func(childComplexity int) int
+		ExerciseDate func(childComplexity int) int
+		GrantID      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Quantity     func(childComplexity int) int
+	}
+
+	GrantMilestone struct {
+		AchievedDate func(childComplexity int) int
+		Condition    func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		GrantID      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Shares       func(childComplexity int) int
+	}
+
+	LeaveOfAbsence struct {
+		CreatedAt     func(childComplexity int) int
+		EndDate       func(childComplexity int) int
+		GrantID       func(childComplexity int) int
+		ID            func(childComplexity int) int
+		IsPaid        func(childComplexity int) int
+		StakeholderID func(childComplexity int) int
+		StartDate     func(childComplexity int) int
+	}
+
+	MFNNotice struct {
+		CreatedAt    func(childComplexity int) int
+		DiscountRate func(childComplexity int) int
+		ID           func(childComplexity int) int
+		SafeID       func(childComplexity int) int
+		SourceSafeID func(childComplexity int) int
+		ValuationCap func(childComplexity int) int
 	}
 
 	Mutation struct {
-		AddStakeholder        func(childComplexity int, input model.AddStakeholderInput) int
-		ConvertSafe           func(childComplexity int, safeID string, roundID string) int
-		CreateCompany         func(childComplexity int, input model.CreateCompanyInput) int
-		CreateShareClass      func(childComplexity int, input model.CreateShareClassInput) int
-		CreateVestingSchedule func(childComplexity int, input model.CreateVestingScheduleInput) int
-		IssueGrant            func(childComplexity int, input model.IssueGrantInput) int
-		IssueSafe             func(childComplexity int, input model.IssueSAFEInput) int
-		RecordFundingRound    func(childComplexity int, input model.RecordFundingRoundInput) int
+		AddStakeholder          func(childComplexity int, input model.AddStakeholderInput) int
+		ArchiveVestingSchedule  func(childComplexity int, id string) int
+		ConvertAllSAFEs         func(childComplexity int, roundID string) int
+		ConvertNote             func(childComplexity int, noteID string, roundID string) int
+		ConvertSafe             func(childComplexity int, safeID string, roundID string) int
+		CreateCompany           func(childComplexity int, input model.CreateCompanyInput) int
+		CreateOptionPool        func(childComplexity int, input model.CreateOptionPoolInput) int
+		CreateShareClass        func(childComplexity int, input model.CreateShareClassInput) int
+		CreateVestingSchedule   func(childComplexity int, input model.CreateVestingScheduleInput) int
+		ExerciseGrant           func(childComplexity int, input model.ExerciseGrantInput) int
+		IssueConvertibleNote    func(childComplexity int, input model.IssueConvertibleNoteInput) int
+		IssueGrant              func(childComplexity int, input model.IssueGrantInput) int
+		IssueSafe               func(childComplexity int, input model.IssueSAFEInput) int
+		RecordFundingRound      func(childComplexity int, input model.RecordFundingRoundInput) int
+		RecordLeave             func(childComplexity int, input model.RecordLeaveInput) int
+		RecordMilestoneAchieved func(childComplexity int, milestoneID string, achievedDate model.Date) int
+		RepurchaseShares        func(childComplexity int, input model.RepurchaseSharesInput) int
+		SettleNoteAtMaturity    func(childComplexity int, input model.SettleNoteAtMaturityInput) int
+		TerminateStakeholder    func(childComplexity int, input model.TerminateStakeholderInput) int
+		UpdateVestingSchedule   func(childComplexity int, input model.UpdateVestingScheduleInput) int
+	}
+
+	NoteAccrual struct {
+		AccruedInterest func(childComplexity int) int
+		AsOfDate        func(childComplexity int) int
+		Balance         func(childComplexity int) int
+		NoteID          func(childComplexity int) int
+		Principal       func(childComplexity int) int
+	}
+
+	NoteSettlement struct {
+		AccruedInterest func(childComplexity int) int
+		Date            func(childComplexity int) int
+		EffectivePps    func(childComplexity int) int
+		GrantID         func(childComplexity int) int
+		Method          func(childComplexity int) int
+		NoteID          func(childComplexity int) int
+		Principal       func(childComplexity int) int
+		RepaymentAmount func(childComplexity int) int
+		SharesIssued    func(childComplexity int) int
+	}
+
+	OptionPool struct {
+		AvailableShares func(childComplexity int) int
+		CompanyID       func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		GrantedShares   func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Name            func(childComplexity int) int
+		ReservedShares  func(childComplexity int) int
+		ShareClassID    func(childComplexity int) int
+	}
+
+	OwnershipTrajectory struct {
+		OwnershipPct    func(childComplexity int) int
+		StakeholderID   func(childComplexity int) int
+		StakeholderName func(childComplexity int) int
 	}
 
 	Query struct {
-		CapTable      func(childComplexity int, companyID string) int
-		Company       func(childComplexity int, id string) int
-		ModelDilution func(childComplexity int, input model.DilutionModelInput) int
-		Stakeholder   func(childComplexity int, id string) int
-		VestingStatus func(childComplexity int, grantID string, asOfDate model.Date) int
-		Waterfall     func(childComplexity int, companyID string, exitValuation model.Decimal) int
+		CapTable                func(childComplexity int, companyID string, asOfDate *model.Date, basis *model.CapTableBasis) int
+		Company                 func(childComplexity int, id string) int
+		ModelDilution           func(childComplexity int, input model.DilutionModelInput) int
+		ModelRoundSequence      func(childComplexity int, companyID string, rounds []*model.ModeledRoundInput) int
+		NoteAccrual             func(childComplexity int, noteID string, asOfDate model.Date) int
+		PreviewSAFEConversions  func(childComplexity int, companyID string, scenarios []*model.SAFEConversionScenarioInput) int
+		Stakeholder             func(childComplexity int, id string) int
+		VestingForecast         func(childComplexity int, companyID string, from model.Date, to model.Date, bucket *model.ForecastBucket) int
+		VestingSchedule         func(childComplexity int, grantID string) int
+		VestingStatus           func(childComplexity int, grantID string, asOfDate model.Date) int
+		VestingStatusWithEvents func(childComplexity int, grantID string, asOfDate model.Date, changeOfControlDate *model.Date, terminationDate *model.Date) int
+		Waterfall               func(childComplexity int, companyID string, exitValuation model.Decimal, asOfDate *model.Date) int
+	}
+
+	RoundSequenceResult struct {
+		Steps        func(childComplexity int) int
+		Trajectories func(childComplexity int) int
+	}
+
+	SAFEConversionPreview struct {
+		AmountRaised      func(childComplexity int) int
+		Conversions       func(childComplexity int) int
+		Holders           func(childComplexity int) int
+		PostMoneyShares   func(childComplexity int) int
+		PreMoneyValuation func(childComplexity int) int
+		PricePerShare     func(childComplexity int) int
+		ScenarioName      func(childComplexity int) int
 	}
 
 	SAFEConversionResult struct {
-		ConversionMethod func(childComplexity int) int
-		EffectivePps     func(childComplexity int) int
-		SafeID           func(childComplexity int) int
-		SharesIssued     func(childComplexity int) int
+		Capitalization     func(childComplexity int) int
+		ConversionMethod   func(childComplexity int) int
+		EffectivePps       func(childComplexity int) int
+		GrantID            func(childComplexity int) int
+		InheritedTermsFrom func(childComplexity int) int
+		SafeID             func(childComplexity int) int
+		SharesIssued       func(childComplexity int) int
+	}
+
+	SAFEHolderOwnership struct {
+		ConversionShares func(childComplexity int) int
+		ExistingShares   func(childComplexity int) int
+		OwnershipPct     func(childComplexity int) int
+		StakeholderID    func(childComplexity int) int
+		StakeholderName  func(childComplexity int) int
 	}
 
 	SAFENote struct {
-		CompanyID        func(childComplexity int) int
-		ConvertedInRound func(childComplexity int) int
-		CreatedAt        func(childComplexity int) int
-		DiscountRate     func(childComplexity int) int
-		ID               func(childComplexity int) int
-		InvestmentAmount func(childComplexity int) int
-		IsConverted      func(childComplexity int) int
-		IssueDate        func(childComplexity int) int
-		SafeType         func(childComplexity int) int
-		StakeholderID    func(childComplexity int) int
-		ValuationCap     func(childComplexity int) int
+		CapitalizationDefinition func(childComplexity int) int
+		CompanyID                func(childComplexity int) int
+		Conversion               func(childComplexity int) int
+		ConvertedInRound         func(childComplexity int) int
+		CreatedAt                func(childComplexity int) int
+		DiscountRate             func(childComplexity int) int
+		ID                       func(childComplexity int) int
+		InvestmentAmount         func(childComplexity int) int
+		IsConverted              func(childComplexity int) int
+		IsMfn                    func(childComplexity int) int
+		IssueDate                func(childComplexity int) int
+		SafeType                 func(childComplexity int) int
+		StakeholderID            func(childComplexity int) int
+		ValuationCap             func(childComplexity int) int
 	}
 
 	ShareClass struct {
@@ -156,42 +344,86 @@ type ComplexityRoot struct {
 		IsPreferred         func(childComplexity int) int
 		LiquidationMultiple func(childComplexity int) int
 		Name                func(childComplexity int) int
+		ParentShareClassID  func(childComplexity int) int
 		ParticipationCap    func(childComplexity int) int
 		PricePerShare       func(childComplexity int) int
 		Seniority           func(childComplexity int) int
 	}
 
 	Stakeholder struct {
-		CompanyID func(childComplexity int) int
-		CreatedAt func(childComplexity int) int
-		Email     func(childComplexity int) int
-		Grants    func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Name      func(childComplexity int) int
-		Role      func(childComplexity int) int
+		CompanyID         func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		Email             func(childComplexity int) int
+		Grants            func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Leaves            func(childComplexity int) int
+		MfnNotices        func(childComplexity int) int
+		Name              func(childComplexity int) int
+		Role              func(childComplexity int) int
+		TerminationDate   func(childComplexity int) int
+		TerminationReason func(childComplexity int) int
+	}
+
+	VestEvent struct {
+		CumulativeVested func(childComplexity int) int
+		Date             func(childComplexity int) int
+		Shares           func(childComplexity int) int
+	}
+
+	VestingForecastLine struct {
+		Role           func(childComplexity int) int
+		ShareClassID   func(childComplexity int) int
+		ShareClassName func(childComplexity int) int
+		VestedShares   func(childComplexity int) int
+	}
+
+	VestingForecastPeriod struct {
+		Breakdown    func(childComplexity int) int
+		PeriodEnd    func(childComplexity int) int
+		PeriodStart  func(childComplexity int) int
+		VestedShares func(childComplexity int) int
 	}
 
 	VestingSchedule struct {
-		AccelerationTrigger func(childComplexity int) int
-		CliffMonths         func(childComplexity int) int
-		Frequency           func(childComplexity int) int
-		ID                  func(childComplexity int) int
-		TotalMonths         func(childComplexity int) int
+		AccelerationMonths       func(childComplexity int) int
+		AccelerationPercent      func(childComplexity int) int
+		AccelerationTrigger      func(childComplexity int) int
+		AccelerationWindowMonths func(childComplexity int) int
+		ArchivedAt               func(childComplexity int) int
+		CliffMonths              func(childComplexity int) int
+		CompanyID                func(childComplexity int) int
+		Frequency                func(childComplexity int) int
+		ID                       func(childComplexity int) int
+		Name                     func(childComplexity int) int
+		Rounding                 func(childComplexity int) int
+		TotalMonths              func(childComplexity int) int
+		Tranches                 func(childComplexity int) int
 	}
 
 	VestingStatus struct {
-		AsOfDate       func(childComplexity int) int
-		CliffDate      func(childComplexity int) int
-		FullyVestedAt  func(childComplexity int) int
-		GrantID        func(childComplexity int) int
-		IsFullyVested  func(childComplexity int) int
-		PercentVested  func(childComplexity int) int
-		TotalShares    func(childComplexity int) int
-		UnvestedShares func(childComplexity int) int
-		VestedShares   func(childComplexity int) int
+		AcceleratedShares   func(childComplexity int) int
+		AsOfDate            func(childComplexity int) int
+		CliffDate           func(childComplexity int) int
+		ExercisedShares     func(childComplexity int) int
+		FullyVestedAt       func(childComplexity int) int
+		GrantID             func(childComplexity int) int
+		IsFullyVested       func(childComplexity int) int
+		PercentVested       func(childComplexity int) int
+		ReleasedShares      func(childComplexity int) int
+		RepurchasableShares func(childComplexity int) int
+		TotalShares         func(childComplexity int) int
+		UnvestedShares      func(childComplexity int) int
+		VestedShares        func(childComplexity int) int
+	}
+
+	VestingTranche struct {
+		OffsetMonths func(childComplexity int) int
+		Percent      func(childComplexity int) int
+		Shares       func(childComplexity int) int
 	}
 
 	WaterfallPayout struct {
+		ClaimElection   func(childComplexity int) int
 		Payout          func(childComplexity int) int
 		PayoutPerShare  func(childComplexity int) int
 		ShareClassName  func(childComplexity int) int
@@ -212,18 +444,36 @@ type MutationResolver interface {
 	AddStakeholder(ctx context.Context, input model.AddStakeholderInput) (*model.Stakeholder, error)
 	CreateShareClass(ctx context.Context, input model.CreateShareClassInput) (*model.ShareClass, error)
 	CreateVestingSchedule(ctx context.Context, input model.CreateVestingScheduleInput) (*model.VestingSchedule, error)
+	UpdateVestingSchedule(ctx context.Context, input model.UpdateVestingScheduleInput) (*model.VestingSchedule, error)
+	ArchiveVestingSchedule(ctx context.Context, id string) (*model.VestingSchedule, error)
 	IssueGrant(ctx context.Context, input model.IssueGrantInput) (*model.Grant, error)
+	CreateOptionPool(ctx context.Context, input model.CreateOptionPoolInput) (*model.OptionPool, error)
+	TerminateStakeholder(ctx context.Context, input model.TerminateStakeholderInput) (*model.Stakeholder, error)
+	RecordLeave(ctx context.Context, input model.RecordLeaveInput) (*model.LeaveOfAbsence, error)
+	ExerciseGrant(ctx context.Context, input model.ExerciseGrantInput) (*model.Grant, error)
+	RepurchaseShares(ctx context.Context, input model.RepurchaseSharesInput) (*model.Grant, error)
 	RecordFundingRound(ctx context.Context, input model.RecordFundingRoundInput) (*model.FundingRound, error)
 	IssueSafe(ctx context.Context, input model.IssueSAFEInput) (*model.SAFENote, error)
 	ConvertSafe(ctx context.Context, safeID string, roundID string) (*model.SAFEConversionResult, error)
+	ConvertAllSAFEs(ctx context.Context, roundID string) ([]*model.SAFEConversionResult, error)
+	IssueConvertibleNote(ctx context.Context, input model.IssueConvertibleNoteInput) (*model.ConvertibleNote, error)
+	ConvertNote(ctx context.Context, noteID string, roundID string) (*model.NoteSettlement, error)
+	SettleNoteAtMaturity(ctx context.Context, input model.SettleNoteAtMaturityInput) (*model.NoteSettlement, error)
+	RecordMilestoneAchieved(ctx context.Context, milestoneID string, achievedDate model.Date) (*model.GrantMilestone, error)
 }
 type QueryResolver interface {
 	Company(ctx context.Context, id string) (*model.Company, error)
 	Stakeholder(ctx context.Context, id string) (*model.Stakeholder, error)
 	VestingStatus(ctx context.Context, grantID string, asOfDate model.Date) (*model.VestingStatus, error)
-	CapTable(ctx context.Context, companyID string) (*model.CapTableSnapshot, error)
+	VestingStatusWithEvents(ctx context.Context, grantID string, asOfDate model.Date, changeOfControlDate *model.Date, terminationDate *model.Date) (*model.VestingStatus, error)
+	VestingSchedule(ctx context.Context, grantID string) ([]*model.VestEvent, error)
+	VestingForecast(ctx context.Context, companyID string, from model.Date, to model.Date, bucket *model.ForecastBucket) ([]*model.VestingForecastPeriod, error)
+	CapTable(ctx context.Context, companyID string, asOfDate *model.Date, basis *model.CapTableBasis) (*model.CapTableSnapshot, error)
 	ModelDilution(ctx context.Context, input model.DilutionModelInput) (*model.DilutionResult, error)
-	Waterfall(ctx context.Context, companyID string, exitValuation model.Decimal) (*model.WaterfallResult, error)
+	ModelRoundSequence(ctx context.Context, companyID string, rounds []*model.ModeledRoundInput) (*model.RoundSequenceResult, error)
+	PreviewSAFEConversions(ctx context.Context, companyID string, scenarios []*model.SAFEConversionScenarioInput) ([]*model.SAFEConversionPreview, error)
+	NoteAccrual(ctx context.Context, noteID string, asOfDate model.Date) (*model.NoteAccrual, error)
+	Waterfall(ctx context.Context, companyID string, exitValuation model.Decimal, asOfDate *model.Date) (*model.WaterfallResult, error)
 }
 
 type executableSchema struct {
@@ -245,12 +495,42 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CapTableEntry.convertibleShares":
+		if e.complexity.CapTableEntry.ConvertibleShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableEntry.ConvertibleShares(childComplexity), true
+	case "CapTableEntry.exercisedUnvestedShares":
+		if e.complexity.CapTableEntry.ExercisedUnvestedShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableEntry.ExercisedUnvestedShares(childComplexity), true
+	case "CapTableEntry.issuedShares":
+		if e.complexity.CapTableEntry.IssuedShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableEntry.IssuedShares(childComplexity), true
+	case "CapTableEntry.optionShares":
+		if e.complexity.CapTableEntry.OptionShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableEntry.OptionShares(childComplexity), true
 	case "CapTableEntry.ownershipPct":
 		if e.complexity.CapTableEntry.OwnershipPct == nil {
 			break
 		}
 
 		return e.complexity.CapTableEntry.OwnershipPct(childComplexity), true
+	case "CapTableEntry.poolShares":
+		if e.complexity.CapTableEntry.PoolShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableEntry.PoolShares(childComplexity), true
 	case "CapTableEntry.shareClassName":
 		if e.complexity.CapTableEntry.ShareClassName == nil {
 			break
@@ -276,18 +556,48 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CapTableEntry.StakeholderName(childComplexity), true
 
+	case "CapTableSnapshot.basis":
+		if e.complexity.CapTableSnapshot.Basis == nil {
+			break
+		}
+
+		return e.complexity.CapTableSnapshot.Basis(childComplexity), true
 	case "CapTableSnapshot.companyID":
 		if e.complexity.CapTableSnapshot.CompanyID == nil {
 			break
 		}
 
 		return e.complexity.CapTableSnapshot.CompanyID(childComplexity), true
+	case "CapTableSnapshot.convertibleShares":
+		if e.complexity.CapTableSnapshot.ConvertibleShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableSnapshot.ConvertibleShares(childComplexity), true
 	case "CapTableSnapshot.entries":
 		if e.complexity.CapTableSnapshot.Entries == nil {
 			break
 		}
 
 		return e.complexity.CapTableSnapshot.Entries(childComplexity), true
+	case "CapTableSnapshot.issuedShares":
+		if e.complexity.CapTableSnapshot.IssuedShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableSnapshot.IssuedShares(childComplexity), true
+	case "CapTableSnapshot.optionShares":
+		if e.complexity.CapTableSnapshot.OptionShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableSnapshot.OptionShares(childComplexity), true
+	case "CapTableSnapshot.poolShares":
+		if e.complexity.CapTableSnapshot.PoolShares == nil {
+			break
+		}
+
+		return e.complexity.CapTableSnapshot.PoolShares(childComplexity), true
 	case "CapTableSnapshot.totalShares":
 		if e.complexity.CapTableSnapshot.TotalShares == nil {
 			break
@@ -295,6 +605,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.CapTableSnapshot.TotalShares(childComplexity), true
 
+	case "Capitalization.definition":
+		if e.complexity.Capitalization.Definition == nil {
+			break
+		}
+
+		return e.complexity.Capitalization.Definition(childComplexity), true
+	case "Capitalization.issuedOptions":
+		if e.complexity.Capitalization.IssuedOptions == nil {
+			break
+		}
+
+		return e.complexity.Capitalization.IssuedOptions(childComplexity), true
+	case "Capitalization.issuedShares":
+		if e.complexity.Capitalization.IssuedShares == nil {
+			break
+		}
+
+		return e.complexity.Capitalization.IssuedShares(childComplexity), true
+	case "Capitalization.poolIncrease":
+		if e.complexity.Capitalization.PoolIncrease == nil {
+			break
+		}
+
+		return e.complexity.Capitalization.PoolIncrease(childComplexity), true
+	case "Capitalization.promisedOptions":
+		if e.complexity.Capitalization.PromisedOptions == nil {
+			break
+		}
+
+		return e.complexity.Capitalization.PromisedOptions(childComplexity), true
+	case "Capitalization.total":
+		if e.complexity.Capitalization.Total == nil {
+			break
+		}
+
+		return e.complexity.Capitalization.Total(childComplexity), true
+	case "Capitalization.unissuedPool":
+		if e.complexity.Capitalization.UnissuedPool == nil {
+			break
+		}
+
+		return e.complexity.Capitalization.UnissuedPool(childComplexity), true
+
+	case "Company.convertibleNotes":
+		if e.complexity.Company.ConvertibleNotes == nil {
+			break
+		}
+
+		return e.complexity.Company.ConvertibleNotes(childComplexity), true
 	case "Company.createdAt":
 		if e.complexity.Company.CreatedAt == nil {
 			break
@@ -325,6 +684,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Company.Name(childComplexity), true
+	case "Company.optionPools":
+		if e.complexity.Company.OptionPools == nil {
+			break
+		}
+
+		return e.complexity.Company.OptionPools(childComplexity), true
 	case "Company.safeNotes":
 		if e.complexity.Company.SafeNotes == nil {
 			break
@@ -343,13 +708,170 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Company.Stakeholders(childComplexity), true
+	case "Company.vestingSchedules":
+		if e.complexity.Company.VestingSchedules == nil {
+			break
+		}
+
+		return e.complexity.Company.VestingSchedules(childComplexity), true
+
+	case "ConvertibleNote.companyID":
+		if e.complexity.ConvertibleNote.CompanyID == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.CompanyID(childComplexity), true
+	case "ConvertibleNote.createdAt":
+		if e.complexity.ConvertibleNote.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.CreatedAt(childComplexity), true
+	case "ConvertibleNote.dayCount":
+		if e.complexity.ConvertibleNote.DayCount == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.DayCount(childComplexity), true
+	case "ConvertibleNote.discountRate":
+		if e.complexity.ConvertibleNote.DiscountRate == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.DiscountRate(childComplexity), true
+	case "ConvertibleNote.id":
+		if e.complexity.ConvertibleNote.ID == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.ID(childComplexity), true
+	case "ConvertibleNote.interestRate":
+		if e.complexity.ConvertibleNote.InterestRate == nil {
+			break
+		}
 
+		return e.complexity.ConvertibleNote.InterestRate(childComplexity), true
+	case "ConvertibleNote.interestType":
+		if e.complexity.ConvertibleNote.InterestType == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.InterestType(childComplexity), true
+	case "ConvertibleNote.issueDate":
+		if e.complexity.ConvertibleNote.IssueDate == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.IssueDate(childComplexity), true
+	case "ConvertibleNote.maturityDate":
+		if e.complexity.ConvertibleNote.MaturityDate == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.MaturityDate(childComplexity), true
+	case "ConvertibleNote.maturityValuationCap":
+		if e.complexity.ConvertibleNote.MaturityValuationCap == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.MaturityValuationCap(childComplexity), true
+	case "ConvertibleNote.principal":
+		if e.complexity.ConvertibleNote.Principal == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.Principal(childComplexity), true
+	case "ConvertibleNote.qualifiedFinancingAmount":
+		if e.complexity.ConvertibleNote.QualifiedFinancingAmount == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.QualifiedFinancingAmount(childComplexity), true
+	case "ConvertibleNote.settledInRound":
+		if e.complexity.ConvertibleNote.SettledInRound == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.SettledInRound(childComplexity), true
+	case "ConvertibleNote.settlement":
+		if e.complexity.ConvertibleNote.Settlement == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.Settlement(childComplexity), true
+	case "ConvertibleNote.stakeholderID":
+		if e.complexity.ConvertibleNote.StakeholderID == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.StakeholderID(childComplexity), true
+	case "ConvertibleNote.valuationCap":
+		if e.complexity.ConvertibleNote.ValuationCap == nil {
+			break
+		}
+
+		return e.complexity.ConvertibleNote.ValuationCap(childComplexity), true
+
+	case "DilutionResult.allocatedAmount":
+		if e.complexity.DilutionResult.AllocatedAmount == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.AllocatedAmount(childComplexity), true
+	case "DilutionResult.allocationMismatch":
+		if e.complexity.DilutionResult.AllocationMismatch == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.AllocationMismatch(childComplexity), true
+	case "DilutionResult.conversionDilutionPct":
+		if e.complexity.DilutionResult.ConversionDilutionPct == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.ConversionDilutionPct(childComplexity), true
+	case "DilutionResult.conversions":
+		if e.complexity.DilutionResult.Conversions == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.Conversions(childComplexity), true
+	case "DilutionResult.effectivePPS":
+		if e.complexity.DilutionResult.EffectivePps == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.EffectivePps(childComplexity), true
+	case "DilutionResult.investors":
+		if e.complexity.DilutionResult.Investors == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.Investors(childComplexity), true
 	case "DilutionResult.newInvestor":
 		if e.complexity.DilutionResult.NewInvestor == nil {
 			break
 		}
 
 		return e.complexity.DilutionResult.NewInvestor(childComplexity), true
+	case "DilutionResult.newMoneyDilutionPct":
+		if e.complexity.DilutionResult.NewMoneyDilutionPct == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.NewMoneyDilutionPct(childComplexity), true
+	case "DilutionResult.poolDilutionPct":
+		if e.complexity.DilutionResult.PoolDilutionPct == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.PoolDilutionPct(childComplexity), true
+	case "DilutionResult.poolIncrease":
+		if e.complexity.DilutionResult.PoolIncrease == nil {
+			break
+		}
+
+		return e.complexity.DilutionResult.PoolIncrease(childComplexity), true
 	case "DilutionResult.postRound":
 		if e.complexity.DilutionResult.PostRound == nil {
 			break
@@ -399,6 +921,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.FundingRound.Name(childComplexity), true
+	case "FundingRound.optionPoolIncrease":
+		if e.complexity.FundingRound.OptionPoolIncrease == nil {
+			break
+		}
+
+		return e.complexity.FundingRound.OptionPoolIncrease(childComplexity), true
 	case "FundingRound.preMoneyValuation":
 		if e.complexity.FundingRound.PreMoneyValuation == nil {
 			break
@@ -411,6 +939,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.FundingRound.PricePerShare(childComplexity), true
+	case "FundingRound.promisedOptions":
+		if e.complexity.FundingRound.PromisedOptions == nil {
+			break
+		}
+
+		return e.complexity.FundingRound.PromisedOptions(childComplexity), true
 	case "FundingRound.roundDate":
 		if e.complexity.FundingRound.RoundDate == nil {
 			break
@@ -436,12 +970,42 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Grant.CreatedAt(childComplexity), true
+	case "Grant.earlyExerciseAllowed":
+		if e.complexity.Grant.EarlyExerciseAllowed == nil {
+			break
+		}
+
+		return e.complexity.Grant.EarlyExerciseAllowed(childComplexity), true
+	case "Grant.exerciseDeadline":
+		if e.complexity.Grant.ExerciseDeadline == nil {
+			break
+		}
+
+		return e.complexity.Grant.ExerciseDeadline(childComplexity), true
 	case "Grant.exercisePrice":
 		if e.complexity.Grant.ExercisePrice == nil {
 			break
 		}
 
 		return e.complexity.Grant.ExercisePrice(childComplexity), true
+	case "Grant.exercises":
+		if e.complexity.Grant.Exercises == nil {
+			break
+		}
+
+		return e.complexity.Grant.Exercises(childComplexity), true
+	case "Grant.expiredQuantity":
+		if e.complexity.Grant.ExpiredQuantity == nil {
+			break
+		}
+
+		return e.complexity.Grant.ExpiredQuantity(childComplexity), true
+	case "Grant.forfeitedQuantity":
+		if e.complexity.Grant.ForfeitedQuantity == nil {
+			break
+		}
+
+		return e.complexity.Grant.ForfeitedQuantity(childComplexity), true
 	case "Grant.grantDate":
 		if e.complexity.Grant.GrantDate == nil {
 			break
@@ -460,18 +1024,54 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Grant.IsExercised(childComplexity), true
+	case "Grant.leaves":
+		if e.complexity.Grant.Leaves == nil {
+			break
+		}
+
+		return e.complexity.Grant.Leaves(childComplexity), true
+	case "Grant.milestones":
+		if e.complexity.Grant.Milestones == nil {
+			break
+		}
+
+		return e.complexity.Grant.Milestones(childComplexity), true
 	case "Grant.notes":
 		if e.complexity.Grant.Notes == nil {
 			break
 		}
 
 		return e.complexity.Grant.Notes(childComplexity), true
+	case "Grant.optionPoolID":
+		if e.complexity.Grant.OptionPoolID == nil {
+			break
+		}
+
+		return e.complexity.Grant.OptionPoolID(childComplexity), true
 	case "Grant.quantity":
 		if e.complexity.Grant.Quantity == nil {
 			break
 		}
 
 		return e.complexity.Grant.Quantity(childComplexity), true
+	case "Grant.repurchaseDate":
+		if e.complexity.Grant.RepurchaseDate == nil {
+			break
+		}
+
+		return e.complexity.Grant.RepurchaseDate(childComplexity), true
+	case "Grant.repurchasePrice":
+		if e.complexity.Grant.RepurchasePrice == nil {
+			break
+		}
+
+		return e.complexity.Grant.RepurchasePrice(childComplexity), true
+	case "Grant.repurchasedQuantity":
+		if e.complexity.Grant.RepurchasedQuantity == nil {
+			break
+		}
+
+		return e.complexity.Grant.RepurchasedQuantity(childComplexity), true
 	case "Grant.shareClassID":
 		if e.complexity.Grant.ShareClassID == nil {
 			break
@@ -484,6 +1084,24 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Grant.StakeholderID(childComplexity), true
+	case "Grant.terminationDate":
+		if e.complexity.Grant.TerminationDate == nil {
+			break
+		}
+
+		return e.complexity.Grant.TerminationDate(childComplexity), true
+	case "Grant.type":
+		if e.complexity.Grant.Type == nil {
+			break
+		}
+
+		return e.complexity.Grant.Type(childComplexity), true
+	case "Grant.vestingCommencementDate":
+		if e.complexity.Grant.VestingCommencementDate == nil {
+			break
+		}
+
+		return e.complexity.Grant.VestingCommencementDate(childComplexity), true
 	case "Grant.vestingSchedule":
 		if e.complexity.Grant.VestingSchedule == nil {
 			break
@@ -497,39 +1115,237 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Grant.VestingScheduleID(childComplexity), true
 
-	case "Mutation.addStakeholder":
-		if e.complexity.Mutation.AddStakeholder == nil {
+	case "GrantExercise.createdAt":
+		if e.complexity.GrantExercise.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addStakeholder_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GrantExercise.CreatedAt(childComplexity), true
+	case "GrantExercise.exerciseDate":
+		if e.complexity.GrantExercise.ExerciseDate == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddStakeholder(childComplexity, args["input"].(model.AddStakeholderInput)), true
-	case "Mutation.convertSAFE":
-		if e.complexity.Mutation.ConvertSafe == nil {
+		return e.complexity.GrantExercise.ExerciseDate(childComplexity), true
+	case "GrantExercise.grantID":
+		if e.complexity.GrantExercise.GrantID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_convertSAFE_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GrantExercise.GrantID(childComplexity), true
+	case "GrantExercise.id":
+		if e.complexity.GrantExercise.ID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ConvertSafe(childComplexity, args["safeID"].(string), args["roundID"].(string)), true
-	case "Mutation.createCompany":
-		if e.complexity.Mutation.CreateCompany == nil {
+		return e.complexity.GrantExercise.ID(childComplexity), true
+	case "GrantExercise.quantity":
+		if e.complexity.GrantExercise.Quantity == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createCompany_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.GrantExercise.Quantity(childComplexity), true
+
+	case "GrantMilestone.achievedDate":
+		if e.complexity.GrantMilestone.AchievedDate == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateCompany(childComplexity, args["input"].(model.CreateCompanyInput)), true
+		return e.complexity.GrantMilestone.AchievedDate(childComplexity), true
+	case "GrantMilestone.condition":
+		if e.complexity.GrantMilestone.Condition == nil {
+			break
+		}
+
+		return e.complexity.GrantMilestone.Condition(childComplexity), true
+	case "GrantMilestone.createdAt":
+		if e.complexity.GrantMilestone.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.GrantMilestone.CreatedAt(childComplexity), true
+	case "GrantMilestone.grantID":
+		if e.complexity.GrantMilestone.GrantID == nil {
+			break
+		}
+
+		return e.complexity.GrantMilestone.GrantID(childComplexity), true
+	case "GrantMilestone.id":
+		if e.complexity.GrantMilestone.ID == nil {
+			break
+		}
+
+		return e.complexity.GrantMilestone.ID(childComplexity), true
+	case "GrantMilestone.name":
+		if e.complexity.GrantMilestone.Name == nil {
+			break
+		}
+
+		return e.complexity.GrantMilestone.Name(childComplexity), true
+	case "GrantMilestone.shares":
+		if e.complexity.GrantMilestone.Shares == nil {
+			break
+		}
+
+		return e.complexity.GrantMilestone.Shares(childComplexity), true
+
+	case "LeaveOfAbsence.createdAt":
+		if e.complexity.LeaveOfAbsence.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.LeaveOfAbsence.CreatedAt(childComplexity), true
+	case "LeaveOfAbsence.endDate":
+		if e.complexity.LeaveOfAbsence.EndDate == nil {
+			break
+		}
+
+		return e.complexity.LeaveOfAbsence.EndDate(childComplexity), true
+	case "LeaveOfAbsence.grantID":
+		if e.complexity.LeaveOfAbsence.GrantID == nil {
+			break
+		}
+
+		return e.complexity.LeaveOfAbsence.GrantID(childComplexity), true
+	case "LeaveOfAbsence.id":
+		if e.complexity.LeaveOfAbsence.ID == nil {
+			break
+		}
+
+		return e.complexity.LeaveOfAbsence.ID(childComplexity), true
+	case "LeaveOfAbsence.isPaid":
+		if e.complexity.LeaveOfAbsence.IsPaid == nil {
+			break
+		}
+
+		return e.complexity.LeaveOfAbsence.IsPaid(childComplexity), true
+	case "LeaveOfAbsence.stakeholderID":
+		if e.complexity.LeaveOfAbsence.StakeholderID == nil {
+			break
+		}
+
+		return e.complexity.LeaveOfAbsence.StakeholderID(childComplexity), true
+	case "LeaveOfAbsence.startDate":
+		if e.complexity.LeaveOfAbsence.StartDate == nil {
+			break
+		}
+
+		return e.complexity.LeaveOfAbsence.StartDate(childComplexity), true
+
+	case "MFNNotice.createdAt":
+		if e.complexity.MFNNotice.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.MFNNotice.CreatedAt(childComplexity), true
+	case "MFNNotice.discountRate":
+		if e.complexity.MFNNotice.DiscountRate == nil {
+			break
+		}
+
+		return e.complexity.MFNNotice.DiscountRate(childComplexity), true
+	case "MFNNotice.id":
+		if e.complexity.MFNNotice.ID == nil {
+			break
+		}
+
+		return e.complexity.MFNNotice.ID(childComplexity), true
+	case "MFNNotice.safeID":
+		if e.complexity.MFNNotice.SafeID == nil {
+			break
+		}
+
+		return e.complexity.MFNNotice.SafeID(childComplexity), true
+	case "MFNNotice.sourceSafeID":
+		if e.complexity.MFNNotice.SourceSafeID == nil {
+			break
+		}
+
+		return e.complexity.MFNNotice.SourceSafeID(childComplexity), true
+	case "MFNNotice.valuationCap":
+		if e.complexity.MFNNotice.ValuationCap == nil {
+			break
+		}
+
+		return e.complexity.MFNNotice.ValuationCap(childComplexity), true
+
+	case "Mutation.addStakeholder":
+		if e.complexity.Mutation.AddStakeholder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addStakeholder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddStakeholder(childComplexity, args["input"].(model.AddStakeholderInput)), true
+	case "Mutation.archiveVestingSchedule":
+		if e.complexity.Mutation.ArchiveVestingSchedule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_archiveVestingSchedule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ArchiveVestingSchedule(childComplexity, args["id"].(string)), true
+	case "Mutation.convertAllSAFEs":
+		if e.complexity.Mutation.ConvertAllSAFEs == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_convertAllSAFEs_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConvertAllSAFEs(childComplexity, args["roundID"].(string)), true
+	case "Mutation.convertNote":
+		if e.complexity.Mutation.ConvertNote == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_convertNote_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConvertNote(childComplexity, args["noteID"].(string), args["roundID"].(string)), true
+	case "Mutation.convertSAFE":
+		if e.complexity.Mutation.ConvertSafe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_convertSAFE_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConvertSafe(childComplexity, args["safeID"].(string), args["roundID"].(string)), true
+	case "Mutation.createCompany":
+		if e.complexity.Mutation.CreateCompany == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createCompany_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateCompany(childComplexity, args["input"].(model.CreateCompanyInput)), true
+	case "Mutation.createOptionPool":
+		if e.complexity.Mutation.CreateOptionPool == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createOptionPool_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateOptionPool(childComplexity, args["input"].(model.CreateOptionPoolInput)), true
 	case "Mutation.createShareClass":
 		if e.complexity.Mutation.CreateShareClass == nil {
 			break
@@ -552,6 +1368,28 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.CreateVestingSchedule(childComplexity, args["input"].(model.CreateVestingScheduleInput)), true
+	case "Mutation.exerciseGrant":
+		if e.complexity.Mutation.ExerciseGrant == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exerciseGrant_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExerciseGrant(childComplexity, args["input"].(model.ExerciseGrantInput)), true
+	case "Mutation.issueConvertibleNote":
+		if e.complexity.Mutation.IssueConvertibleNote == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_issueConvertibleNote_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IssueConvertibleNote(childComplexity, args["input"].(model.IssueConvertibleNoteInput)), true
 	case "Mutation.issueGrant":
 		if e.complexity.Mutation.IssueGrant == nil {
 			break
@@ -585,2042 +1423,12688 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.RecordFundingRound(childComplexity, args["input"].(model.RecordFundingRoundInput)), true
-
-	case "Query.capTable":
-		if e.complexity.Query.CapTable == nil {
+	case "Mutation.recordLeave":
+		if e.complexity.Mutation.RecordLeave == nil {
 			break
 		}
 
-		args, err := ec.field_Query_capTable_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_recordLeave_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.CapTable(childComplexity, args["companyID"].(string)), true
-	case "Query.company":
-		if e.complexity.Query.Company == nil {
+		return e.complexity.Mutation.RecordLeave(childComplexity, args["input"].(model.RecordLeaveInput)), true
+	case "Mutation.recordMilestoneAchieved":
+		if e.complexity.Mutation.RecordMilestoneAchieved == nil {
 			break
 		}
 
-		args, err := ec.field_Query_company_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_recordMilestoneAchieved_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Company(childComplexity, args["id"].(string)), true
-	case "Query.modelDilution":
-		if e.complexity.Query.ModelDilution == nil {
+		return e.complexity.Mutation.RecordMilestoneAchieved(childComplexity, args["milestoneID"].(string), args["achievedDate"].(model.Date)), true
+	case "Mutation.repurchaseShares":
+		if e.complexity.Mutation.RepurchaseShares == nil {
 			break
 		}
 
-		args, err := ec.field_Query_modelDilution_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_repurchaseShares_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.ModelDilution(childComplexity, args["input"].(model.DilutionModelInput)), true
-	case "Query.stakeholder":
-		if e.complexity.Query.Stakeholder == nil {
+		return e.complexity.Mutation.RepurchaseShares(childComplexity, args["input"].(model.RepurchaseSharesInput)), true
+	case "Mutation.settleNoteAtMaturity":
+		if e.complexity.Mutation.SettleNoteAtMaturity == nil {
 			break
 		}
 
-		args, err := ec.field_Query_stakeholder_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_settleNoteAtMaturity_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Stakeholder(childComplexity, args["id"].(string)), true
-	case "Query.vestingStatus":
-		if e.complexity.Query.VestingStatus == nil {
+		return e.complexity.Mutation.SettleNoteAtMaturity(childComplexity, args["input"].(model.SettleNoteAtMaturityInput)), true
+	case "Mutation.terminateStakeholder":
+		if e.complexity.Mutation.TerminateStakeholder == nil {
 			break
 		}
 
-		args, err := ec.field_Query_vestingStatus_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_terminateStakeholder_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.VestingStatus(childComplexity, args["grantID"].(string), args["asOfDate"].(model.Date)), true
-	case "Query.waterfall":
-		if e.complexity.Query.Waterfall == nil {
+		return e.complexity.Mutation.TerminateStakeholder(childComplexity, args["input"].(model.TerminateStakeholderInput)), true
+	case "Mutation.updateVestingSchedule":
+		if e.complexity.Mutation.UpdateVestingSchedule == nil {
 			break
 		}
 
-		args, err := ec.field_Query_waterfall_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_updateVestingSchedule_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Waterfall(childComplexity, args["companyID"].(string), args["exitValuation"].(model.Decimal)), true
+		return e.complexity.Mutation.UpdateVestingSchedule(childComplexity, args["input"].(model.UpdateVestingScheduleInput)), true
 
-	case "SAFEConversionResult.conversionMethod":
-		if e.complexity.SAFEConversionResult.ConversionMethod == nil {
+	case "NoteAccrual.accruedInterest":
+		if e.complexity.NoteAccrual.AccruedInterest == nil {
 			break
 		}
 
-		return e.complexity.SAFEConversionResult.ConversionMethod(childComplexity), true
-	case "SAFEConversionResult.effectivePPS":
-		if e.complexity.SAFEConversionResult.EffectivePps == nil {
+		return e.complexity.NoteAccrual.AccruedInterest(childComplexity), true
+	case "NoteAccrual.asOfDate":
+		if e.complexity.NoteAccrual.AsOfDate == nil {
 			break
 		}
 
-		return e.complexity.SAFEConversionResult.EffectivePps(childComplexity), true
-	case "SAFEConversionResult.safeID":
-		if e.complexity.SAFEConversionResult.SafeID == nil {
+		return e.complexity.NoteAccrual.AsOfDate(childComplexity), true
+	case "NoteAccrual.balance":
+		if e.complexity.NoteAccrual.Balance == nil {
 			break
 		}
 
-		return e.complexity.SAFEConversionResult.SafeID(childComplexity), true
-	case "SAFEConversionResult.sharesIssued":
-		if e.complexity.SAFEConversionResult.SharesIssued == nil {
+		return e.complexity.NoteAccrual.Balance(childComplexity), true
+	case "NoteAccrual.noteID":
+		if e.complexity.NoteAccrual.NoteID == nil {
 			break
 		}
 
-		return e.complexity.SAFEConversionResult.SharesIssued(childComplexity), true
-
-	case "SAFENote.companyID":
-		if e.complexity.SAFENote.CompanyID == nil {
+		return e.complexity.NoteAccrual.NoteID(childComplexity), true
+	case "NoteAccrual.principal":
+		if e.complexity.NoteAccrual.Principal == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.CompanyID(childComplexity), true
-	case "SAFENote.convertedInRound":
-		if e.complexity.SAFENote.ConvertedInRound == nil {
-			break
-		}
+		return e.complexity.NoteAccrual.Principal(childComplexity), true
 
-		return e.complexity.SAFENote.ConvertedInRound(childComplexity), true
-	case "SAFENote.createdAt":
-		if e.complexity.SAFENote.CreatedAt == nil {
+	case "NoteSettlement.accruedInterest":
+		if e.complexity.NoteSettlement.AccruedInterest == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.CreatedAt(childComplexity), true
-	case "SAFENote.discountRate":
-		if e.complexity.SAFENote.DiscountRate == nil {
+		return e.complexity.NoteSettlement.AccruedInterest(childComplexity), true
+	case "NoteSettlement.date":
+		if e.complexity.NoteSettlement.Date == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.DiscountRate(childComplexity), true
-	case "SAFENote.id":
-		if e.complexity.SAFENote.ID == nil {
+		return e.complexity.NoteSettlement.Date(childComplexity), true
+	case "NoteSettlement.effectivePPS":
+		if e.complexity.NoteSettlement.EffectivePps == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.ID(childComplexity), true
-	case "SAFENote.investmentAmount":
-		if e.complexity.SAFENote.InvestmentAmount == nil {
+		return e.complexity.NoteSettlement.EffectivePps(childComplexity), true
+	case "NoteSettlement.grantID":
+		if e.complexity.NoteSettlement.GrantID == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.InvestmentAmount(childComplexity), true
-	case "SAFENote.isConverted":
-		if e.complexity.SAFENote.IsConverted == nil {
+		return e.complexity.NoteSettlement.GrantID(childComplexity), true
+	case "NoteSettlement.method":
+		if e.complexity.NoteSettlement.Method == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.IsConverted(childComplexity), true
-	case "SAFENote.issueDate":
-		if e.complexity.SAFENote.IssueDate == nil {
+		return e.complexity.NoteSettlement.Method(childComplexity), true
+	case "NoteSettlement.noteID":
+		if e.complexity.NoteSettlement.NoteID == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.IssueDate(childComplexity), true
-	case "SAFENote.safeType":
-		if e.complexity.SAFENote.SafeType == nil {
+		return e.complexity.NoteSettlement.NoteID(childComplexity), true
+	case "NoteSettlement.principal":
+		if e.complexity.NoteSettlement.Principal == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.SafeType(childComplexity), true
-	case "SAFENote.stakeholderID":
-		if e.complexity.SAFENote.StakeholderID == nil {
+		return e.complexity.NoteSettlement.Principal(childComplexity), true
+	case "NoteSettlement.repaymentAmount":
+		if e.complexity.NoteSettlement.RepaymentAmount == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.StakeholderID(childComplexity), true
-	case "SAFENote.valuationCap":
-		if e.complexity.SAFENote.ValuationCap == nil {
+		return e.complexity.NoteSettlement.RepaymentAmount(childComplexity), true
+	case "NoteSettlement.sharesIssued":
+		if e.complexity.NoteSettlement.SharesIssued == nil {
 			break
 		}
 
-		return e.complexity.SAFENote.ValuationCap(childComplexity), true
+		return e.complexity.NoteSettlement.SharesIssued(childComplexity), true
 
-	case "ShareClass.authorizedShares":
-		if e.complexity.ShareClass.AuthorizedShares == nil {
+	case "OptionPool.availableShares":
+		if e.complexity.OptionPool.AvailableShares == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.AuthorizedShares(childComplexity), true
-	case "ShareClass.companyID":
-		if e.complexity.ShareClass.CompanyID == nil {
+		return e.complexity.OptionPool.AvailableShares(childComplexity), true
+	case "OptionPool.companyID":
+		if e.complexity.OptionPool.CompanyID == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.CompanyID(childComplexity), true
-	case "ShareClass.createdAt":
-		if e.complexity.ShareClass.CreatedAt == nil {
+		return e.complexity.OptionPool.CompanyID(childComplexity), true
+	case "OptionPool.createdAt":
+		if e.complexity.OptionPool.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.CreatedAt(childComplexity), true
-	case "ShareClass.id":
-		if e.complexity.ShareClass.ID == nil {
+		return e.complexity.OptionPool.CreatedAt(childComplexity), true
+	case "OptionPool.grantedShares":
+		if e.complexity.OptionPool.GrantedShares == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.ID(childComplexity), true
-	case "ShareClass.isParticipating":
-		if e.complexity.ShareClass.IsParticipating == nil {
+		return e.complexity.OptionPool.GrantedShares(childComplexity), true
+	case "OptionPool.id":
+		if e.complexity.OptionPool.ID == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.IsParticipating(childComplexity), true
-	case "ShareClass.isPreferred":
-		if e.complexity.ShareClass.IsPreferred == nil {
+		return e.complexity.OptionPool.ID(childComplexity), true
+	case "OptionPool.name":
+		if e.complexity.OptionPool.Name == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.IsPreferred(childComplexity), true
-	case "ShareClass.liquidationMultiple":
-		if e.complexity.ShareClass.LiquidationMultiple == nil {
+		return e.complexity.OptionPool.Name(childComplexity), true
+	case "OptionPool.reservedShares":
+		if e.complexity.OptionPool.ReservedShares == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.LiquidationMultiple(childComplexity), true
-	case "ShareClass.name":
-		if e.complexity.ShareClass.Name == nil {
+		return e.complexity.OptionPool.ReservedShares(childComplexity), true
+	case "OptionPool.shareClassID":
+		if e.complexity.OptionPool.ShareClassID == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.Name(childComplexity), true
-	case "ShareClass.participationCap":
-		if e.complexity.ShareClass.ParticipationCap == nil {
+		return e.complexity.OptionPool.ShareClassID(childComplexity), true
+
+	case "OwnershipTrajectory.ownershipPct":
+		if e.complexity.OwnershipTrajectory.OwnershipPct == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.ParticipationCap(childComplexity), true
-	case "ShareClass.pricePerShare":
-		if e.complexity.ShareClass.PricePerShare == nil {
+		return e.complexity.OwnershipTrajectory.OwnershipPct(childComplexity), true
+	case "OwnershipTrajectory.stakeholderID":
+		if e.complexity.OwnershipTrajectory.StakeholderID == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.PricePerShare(childComplexity), true
-	case "ShareClass.seniority":
-		if e.complexity.ShareClass.Seniority == nil {
+		return e.complexity.OwnershipTrajectory.StakeholderID(childComplexity), true
+	case "OwnershipTrajectory.stakeholderName":
+		if e.complexity.OwnershipTrajectory.StakeholderName == nil {
 			break
 		}
 
-		return e.complexity.ShareClass.Seniority(childComplexity), true
+		return e.complexity.OwnershipTrajectory.StakeholderName(childComplexity), true
 
-	case "Stakeholder.companyID":
-		if e.complexity.Stakeholder.CompanyID == nil {
+	case "Query.capTable":
+		if e.complexity.Query.CapTable == nil {
 			break
 		}
 
-		return e.complexity.Stakeholder.CompanyID(childComplexity), true
-	case "Stakeholder.createdAt":
-		if e.complexity.Stakeholder.CreatedAt == nil {
-			break
+		args, err := ec.field_Query_capTable_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Stakeholder.CreatedAt(childComplexity), true
-	case "Stakeholder.email":
-		if e.complexity.Stakeholder.Email == nil {
+		return e.complexity.Query.CapTable(childComplexity, args["companyID"].(string), args["asOfDate"].(*model.Date), args["basis"].(*model.CapTableBasis)), true
+	case "Query.company":
+		if e.complexity.Query.Company == nil {
 			break
 		}
 
-		return e.complexity.Stakeholder.Email(childComplexity), true
-	case "Stakeholder.grants":
-		if e.complexity.Stakeholder.Grants == nil {
+		args, err := ec.field_Query_company_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Company(childComplexity, args["id"].(string)), true
+	case "Query.modelDilution":
+		if e.complexity.Query.ModelDilution == nil {
 			break
 		}
 
-		return e.complexity.Stakeholder.Grants(childComplexity), true
-	case "Stakeholder.id":
-		if e.complexity.Stakeholder.ID == nil {
+		args, err := ec.field_Query_modelDilution_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ModelDilution(childComplexity, args["input"].(model.DilutionModelInput)), true
+	case "Query.modelRoundSequence":
+		if e.complexity.Query.ModelRoundSequence == nil {
 			break
 		}
 
-		return e.complexity.Stakeholder.ID(childComplexity), true
-	case "Stakeholder.name":
-		if e.complexity.Stakeholder.Name == nil {
+		args, err := ec.field_Query_modelRoundSequence_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ModelRoundSequence(childComplexity, args["companyID"].(string), args["rounds"].([]*model.ModeledRoundInput)), true
+	case "Query.noteAccrual":
+		if e.complexity.Query.NoteAccrual == nil {
 			break
 		}
 
-		return e.complexity.Stakeholder.Name(childComplexity), true
-	case "Stakeholder.role":
-		if e.complexity.Stakeholder.Role == nil {
+		args, err := ec.field_Query_noteAccrual_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.NoteAccrual(childComplexity, args["noteID"].(string), args["asOfDate"].(model.Date)), true
+	case "Query.previewSAFEConversions":
+		if e.complexity.Query.PreviewSAFEConversions == nil {
 			break
 		}
 
-		return e.complexity.Stakeholder.Role(childComplexity), true
+		args, err := ec.field_Query_previewSAFEConversions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "VestingSchedule.accelerationTrigger":
-		if e.complexity.VestingSchedule.AccelerationTrigger == nil {
+		return e.complexity.Query.PreviewSAFEConversions(childComplexity, args["companyID"].(string), args["scenarios"].([]*model.SAFEConversionScenarioInput)), true
+	case "Query.stakeholder":
+		if e.complexity.Query.Stakeholder == nil {
 			break
 		}
 
-		return e.complexity.VestingSchedule.AccelerationTrigger(childComplexity), true
-	case "VestingSchedule.cliffMonths":
-		if e.complexity.VestingSchedule.CliffMonths == nil {
+		args, err := ec.field_Query_stakeholder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Stakeholder(childComplexity, args["id"].(string)), true
+	case "Query.vestingForecast":
+		if e.complexity.Query.VestingForecast == nil {
 			break
 		}
 
-		return e.complexity.VestingSchedule.CliffMonths(childComplexity), true
-	case "VestingSchedule.frequency":
-		if e.complexity.VestingSchedule.Frequency == nil {
+		args, err := ec.field_Query_vestingForecast_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VestingForecast(childComplexity, args["companyID"].(string), args["from"].(model.Date), args["to"].(model.Date), args["bucket"].(*model.ForecastBucket)), true
+	case "Query.vestingSchedule":
+		if e.complexity.Query.VestingSchedule == nil {
 			break
 		}
 
-		return e.complexity.VestingSchedule.Frequency(childComplexity), true
-	case "VestingSchedule.id":
-		if e.complexity.VestingSchedule.ID == nil {
+		args, err := ec.field_Query_vestingSchedule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VestingSchedule(childComplexity, args["grantID"].(string)), true
+	case "Query.vestingStatus":
+		if e.complexity.Query.VestingStatus == nil {
 			break
 		}
 
-		return e.complexity.VestingSchedule.ID(childComplexity), true
-	case "VestingSchedule.totalMonths":
-		if e.complexity.VestingSchedule.TotalMonths == nil {
+		args, err := ec.field_Query_vestingStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VestingStatus(childComplexity, args["grantID"].(string), args["asOfDate"].(model.Date)), true
+	case "Query.vestingStatusWithEvents":
+		if e.complexity.Query.VestingStatusWithEvents == nil {
 			break
 		}
 
-		return e.complexity.VestingSchedule.TotalMonths(childComplexity), true
+		args, err := ec.field_Query_vestingStatusWithEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "VestingStatus.asOfDate":
-		if e.complexity.VestingStatus.AsOfDate == nil {
+		return e.complexity.Query.VestingStatusWithEvents(childComplexity, args["grantID"].(string), args["asOfDate"].(model.Date), args["changeOfControlDate"].(*model.Date), args["terminationDate"].(*model.Date)), true
+	case "Query.waterfall":
+		if e.complexity.Query.Waterfall == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.AsOfDate(childComplexity), true
-	case "VestingStatus.cliffDate":
-		if e.complexity.VestingStatus.CliffDate == nil {
+		args, err := ec.field_Query_waterfall_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Waterfall(childComplexity, args["companyID"].(string), args["exitValuation"].(model.Decimal), args["asOfDate"].(*model.Date)), true
+
+	case "RoundSequenceResult.steps":
+		if e.complexity.RoundSequenceResult.Steps == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.CliffDate(childComplexity), true
-	case "VestingStatus.fullyVestedAt":
-		if e.complexity.VestingStatus.FullyVestedAt == nil {
+		return e.complexity.RoundSequenceResult.Steps(childComplexity), true
+	case "RoundSequenceResult.trajectories":
+		if e.complexity.RoundSequenceResult.Trajectories == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.FullyVestedAt(childComplexity), true
-	case "VestingStatus.grantID":
-		if e.complexity.VestingStatus.GrantID == nil {
+		return e.complexity.RoundSequenceResult.Trajectories(childComplexity), true
+
+	case "SAFEConversionPreview.amountRaised":
+		if e.complexity.SAFEConversionPreview.AmountRaised == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.GrantID(childComplexity), true
-	case "VestingStatus.isFullyVested":
-		if e.complexity.VestingStatus.IsFullyVested == nil {
+		return e.complexity.SAFEConversionPreview.AmountRaised(childComplexity), true
+	case "SAFEConversionPreview.conversions":
+		if e.complexity.SAFEConversionPreview.Conversions == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.IsFullyVested(childComplexity), true
-	case "VestingStatus.percentVested":
-		if e.complexity.VestingStatus.PercentVested == nil {
+		return e.complexity.SAFEConversionPreview.Conversions(childComplexity), true
+	case "SAFEConversionPreview.holders":
+		if e.complexity.SAFEConversionPreview.Holders == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.PercentVested(childComplexity), true
-	case "VestingStatus.totalShares":
-		if e.complexity.VestingStatus.TotalShares == nil {
+		return e.complexity.SAFEConversionPreview.Holders(childComplexity), true
+	case "SAFEConversionPreview.postMoneyShares":
+		if e.complexity.SAFEConversionPreview.PostMoneyShares == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.TotalShares(childComplexity), true
-	case "VestingStatus.unvestedShares":
-		if e.complexity.VestingStatus.UnvestedShares == nil {
+		return e.complexity.SAFEConversionPreview.PostMoneyShares(childComplexity), true
+	case "SAFEConversionPreview.preMoneyValuation":
+		if e.complexity.SAFEConversionPreview.PreMoneyValuation == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.UnvestedShares(childComplexity), true
-	case "VestingStatus.vestedShares":
-		if e.complexity.VestingStatus.VestedShares == nil {
+		return e.complexity.SAFEConversionPreview.PreMoneyValuation(childComplexity), true
+	case "SAFEConversionPreview.pricePerShare":
+		if e.complexity.SAFEConversionPreview.PricePerShare == nil {
 			break
 		}
 
-		return e.complexity.VestingStatus.VestedShares(childComplexity), true
+		return e.complexity.SAFEConversionPreview.PricePerShare(childComplexity), true
+	case "SAFEConversionPreview.scenarioName":
+		if e.complexity.SAFEConversionPreview.ScenarioName == nil {
+			break
+		}
 
-	case "WaterfallPayout.payout":
-		if e.complexity.WaterfallPayout.Payout == nil {
+		return e.complexity.SAFEConversionPreview.ScenarioName(childComplexity), true
+
+	case "SAFEConversionResult.capitalization":
+		if e.complexity.SAFEConversionResult.Capitalization == nil {
 			break
 		}
 
-		return e.complexity.WaterfallPayout.Payout(childComplexity), true
-	case "WaterfallPayout.payoutPerShare":
-		if e.complexity.WaterfallPayout.PayoutPerShare == nil {
+		return e.complexity.SAFEConversionResult.Capitalization(childComplexity), true
+	case "SAFEConversionResult.conversionMethod":
+		if e.complexity.SAFEConversionResult.ConversionMethod == nil {
 			break
 		}
 
-		return e.complexity.WaterfallPayout.PayoutPerShare(childComplexity), true
-	case "WaterfallPayout.shareClassName":
-		if e.complexity.WaterfallPayout.ShareClassName == nil {
+		return e.complexity.SAFEConversionResult.ConversionMethod(childComplexity), true
+	case "SAFEConversionResult.effectivePPS":
+		if e.complexity.SAFEConversionResult.EffectivePps == nil {
 			break
 		}
 
-		return e.complexity.WaterfallPayout.ShareClassName(childComplexity), true
-	case "WaterfallPayout.shares":
-		if e.complexity.WaterfallPayout.Shares == nil {
+		return e.complexity.SAFEConversionResult.EffectivePps(childComplexity), true
+	case "SAFEConversionResult.grantID":
+		if e.complexity.SAFEConversionResult.GrantID == nil {
 			break
 		}
 
-		return e.complexity.WaterfallPayout.Shares(childComplexity), true
-	case "WaterfallPayout.stakeholderID":
-		if e.complexity.WaterfallPayout.StakeholderID == nil {
+		return e.complexity.SAFEConversionResult.GrantID(childComplexity), true
+	case "SAFEConversionResult.inheritedTermsFrom":
+		if e.complexity.SAFEConversionResult.InheritedTermsFrom == nil {
 			break
 		}
 
-		return e.complexity.WaterfallPayout.StakeholderID(childComplexity), true
-	case "WaterfallPayout.stakeholderName":
-		if e.complexity.WaterfallPayout.StakeholderName == nil {
+		return e.complexity.SAFEConversionResult.InheritedTermsFrom(childComplexity), true
+	case "SAFEConversionResult.safeID":
+		if e.complexity.SAFEConversionResult.SafeID == nil {
 			break
 		}
 
-		return e.complexity.WaterfallPayout.StakeholderName(childComplexity), true
+		return e.complexity.SAFEConversionResult.SafeID(childComplexity), true
+	case "SAFEConversionResult.sharesIssued":
+		if e.complexity.SAFEConversionResult.SharesIssued == nil {
+			break
+		}
 
-	case "WaterfallResult.exitValuation":
-		if e.complexity.WaterfallResult.ExitValuation == nil {
+		return e.complexity.SAFEConversionResult.SharesIssued(childComplexity), true
+
+	case "SAFEHolderOwnership.conversionShares":
+		if e.complexity.SAFEHolderOwnership.ConversionShares == nil {
 			break
 		}
 
-		return e.complexity.WaterfallResult.ExitValuation(childComplexity), true
-	case "WaterfallResult.payouts":
-		if e.complexity.WaterfallResult.Payouts == nil {
+		return e.complexity.SAFEHolderOwnership.ConversionShares(childComplexity), true
+	case "SAFEHolderOwnership.existingShares":
+		if e.complexity.SAFEHolderOwnership.ExistingShares == nil {
 			break
 		}
 
-		return e.complexity.WaterfallResult.Payouts(childComplexity), true
-	case "WaterfallResult.totalPayout":
-		if e.complexity.WaterfallResult.TotalPayout == nil {
+		return e.complexity.SAFEHolderOwnership.ExistingShares(childComplexity), true
+	case "SAFEHolderOwnership.ownershipPct":
+		if e.complexity.SAFEHolderOwnership.OwnershipPct == nil {
 			break
 		}
 
-		return e.complexity.WaterfallResult.TotalPayout(childComplexity), true
+		return e.complexity.SAFEHolderOwnership.OwnershipPct(childComplexity), true
+	case "SAFEHolderOwnership.stakeholderID":
+		if e.complexity.SAFEHolderOwnership.StakeholderID == nil {
+			break
+		}
 
-	}
-	return 0, false
-}
+		return e.complexity.SAFEHolderOwnership.StakeholderID(childComplexity), true
+	case "SAFEHolderOwnership.stakeholderName":
+		if e.complexity.SAFEHolderOwnership.StakeholderName == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputAddStakeholderInput,
-		ec.unmarshalInputCreateCompanyInput,
-		ec.unmarshalInputCreateShareClassInput,
-		ec.unmarshalInputCreateVestingScheduleInput,
-		ec.unmarshalInputDilutionModelInput,
-		ec.unmarshalInputIssueGrantInput,
-		ec.unmarshalInputIssueSAFEInput,
-		ec.unmarshalInputRecordFundingRoundInput,
-	)
-	first := true
+		return e.complexity.SAFEHolderOwnership.StakeholderName(childComplexity), true
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+	case "SAFENote.capitalizationDefinition":
+		if e.complexity.SAFENote.CapitalizationDefinition == nil {
+			break
+		}
 
-			return &response
+		return e.complexity.SAFENote.CapitalizationDefinition(childComplexity), true
+	case "SAFENote.companyID":
+		if e.complexity.SAFENote.CompanyID == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.SAFENote.CompanyID(childComplexity), true
+	case "SAFENote.conversion":
+		if e.complexity.SAFENote.Conversion == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.SAFENote.Conversion(childComplexity), true
+	case "SAFENote.convertedInRound":
+		if e.complexity.SAFENote.ConvertedInRound == nil {
+			break
+		}
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+		return e.complexity.SAFENote.ConvertedInRound(childComplexity), true
+	case "SAFENote.createdAt":
+		if e.complexity.SAFENote.CreatedAt == nil {
+			break
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		return e.complexity.SAFENote.CreatedAt(childComplexity), true
+	case "SAFENote.discountRate":
+		if e.complexity.SAFENote.DiscountRate == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		return e.complexity.SAFENote.DiscountRate(childComplexity), true
+	case "SAFENote.id":
+		if e.complexity.SAFENote.ID == nil {
+			break
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.SAFENote.ID(childComplexity), true
+	case "SAFENote.investmentAmount":
+		if e.complexity.SAFENote.InvestmentAmount == nil {
+			break
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+		return e.complexity.SAFENote.InvestmentAmount(childComplexity), true
+	case "SAFENote.isConverted":
+		if e.complexity.SAFENote.IsConverted == nil {
+			break
+		}
 
-//go:embed "schema.graphqls"
-var sourcesFS embed.FS
+		return e.complexity.SAFENote.IsConverted(childComplexity), true
+	case "SAFENote.isMFN":
+		if e.complexity.SAFENote.IsMfn == nil {
+			break
+		}
 
-func sourceData(filename string) string {
-	data, err := sourcesFS.ReadFile(filename)
-	if err != nil {
-		panic(fmt.Sprintf("codegen problem: %s not available", filename))
-	}
-	return string(data)
-}
+		return e.complexity.SAFENote.IsMfn(childComplexity), true
+	case "SAFENote.issueDate":
+		if e.complexity.SAFENote.IssueDate == nil {
+			break
+		}
 
-var sources = []*ast.Source{
-	{Name: "schema.graphqls", Input: sourceData("schema.graphqls"), BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.complexity.SAFENote.IssueDate(childComplexity), true
+	case "SAFENote.safeType":
+		if e.complexity.SAFENote.SafeType == nil {
+			break
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		return e.complexity.SAFENote.SafeType(childComplexity), true
+	case "SAFENote.stakeholderID":
+		if e.complexity.SAFENote.StakeholderID == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.complexity.SAFENote.StakeholderID(childComplexity), true
+	case "SAFENote.valuationCap":
+		if e.complexity.SAFENote.ValuationCap == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_addStakeholder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddStakeholderInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAddStakeholderInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.SAFENote.ValuationCap(childComplexity), true
 
-func (ec *executionContext) field_Mutation_convertSAFE_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "safeID", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["safeID"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "roundID", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["roundID"] = arg1
-	return args, nil
-}
+	case "ShareClass.authorizedShares":
+		if e.complexity.ShareClass.AuthorizedShares == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_createCompany_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateCompanyInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateCompanyInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.AuthorizedShares(childComplexity), true
+	case "ShareClass.companyID":
+		if e.complexity.ShareClass.CompanyID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_createShareClass_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateShareClassInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateShareClassInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.CompanyID(childComplexity), true
+	case "ShareClass.createdAt":
+		if e.complexity.ShareClass.CreatedAt == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_createVestingSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateVestingScheduleInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateVestingScheduleInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.CreatedAt(childComplexity), true
+	case "ShareClass.id":
+		if e.complexity.ShareClass.ID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_issueGrant_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNIssueGrantInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueGrantInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.ID(childComplexity), true
+	case "ShareClass.isParticipating":
+		if e.complexity.ShareClass.IsParticipating == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_issueSAFE_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNIssueSAFEInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueSAFEInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.IsParticipating(childComplexity), true
+	case "ShareClass.isPreferred":
+		if e.complexity.ShareClass.IsPreferred == nil {
+			break
+		}
 
-func (ec *executionContext) field_Mutation_recordFundingRound_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRecordFundingRoundInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐRecordFundingRoundInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.IsPreferred(childComplexity), true
+	case "ShareClass.liquidationMultiple":
+		if e.complexity.ShareClass.LiquidationMultiple == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.LiquidationMultiple(childComplexity), true
+	case "ShareClass.name":
+		if e.complexity.ShareClass.Name == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_capTable_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "companyID", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["companyID"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.Name(childComplexity), true
+	case "ShareClass.parentShareClassID":
+		if e.complexity.ShareClass.ParentShareClassID == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_company_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.ParentShareClassID(childComplexity), true
+	case "ShareClass.participationCap":
+		if e.complexity.ShareClass.ParticipationCap == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_modelDilution_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNDilutionModelInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionModelInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.ParticipationCap(childComplexity), true
+	case "ShareClass.pricePerShare":
+		if e.complexity.ShareClass.PricePerShare == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_stakeholder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.ShareClass.PricePerShare(childComplexity), true
+	case "ShareClass.seniority":
+		if e.complexity.ShareClass.Seniority == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_vestingStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "grantID", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["grantID"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "asOfDate", ec.unmarshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
-	if err != nil {
-		return nil, err
-	}
-	args["asOfDate"] = arg1
-	return args, nil
-}
+		return e.complexity.ShareClass.Seniority(childComplexity), true
 
-func (ec *executionContext) field_Query_waterfall_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "companyID", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["companyID"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "exitValuation", ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal)
-	if err != nil {
-		return nil, err
-	}
-	args["exitValuation"] = arg1
-	return args, nil
-}
+	case "Stakeholder.companyID":
+		if e.complexity.Stakeholder.CompanyID == nil {
+			break
+		}
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Stakeholder.CompanyID(childComplexity), true
+	case "Stakeholder.createdAt":
+		if e.complexity.Stakeholder.CreatedAt == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Stakeholder.CreatedAt(childComplexity), true
+	case "Stakeholder.email":
+		if e.complexity.Stakeholder.Email == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Stakeholder.Email(childComplexity), true
+	case "Stakeholder.grants":
+		if e.complexity.Stakeholder.Grants == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Stakeholder.Grants(childComplexity), true
+	case "Stakeholder.id":
+		if e.complexity.Stakeholder.ID == nil {
+			break
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.Stakeholder.ID(childComplexity), true
+	case "Stakeholder.leaves":
+		if e.complexity.Stakeholder.Leaves == nil {
+			break
+		}
 
-// region    ************************** directives.gotpl **************************
+		return e.complexity.Stakeholder.Leaves(childComplexity), true
+	case "Stakeholder.mfnNotices":
+		if e.complexity.Stakeholder.MfnNotices == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.Stakeholder.MfnNotices(childComplexity), true
+	case "Stakeholder.name":
+		if e.complexity.Stakeholder.Name == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.complexity.Stakeholder.Name(childComplexity), true
+	case "Stakeholder.role":
+		if e.complexity.Stakeholder.Role == nil {
+			break
+		}
 
-func (ec *executionContext) _CapTableEntry_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableEntry_stakeholderID,
-		func(ctx context.Context) (any, error) {
-			return obj.StakeholderID, nil
-		},
-		nil,
-		ec.marshalOID2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.Stakeholder.Role(childComplexity), true
+	case "Stakeholder.terminationDate":
+		if e.complexity.Stakeholder.TerminationDate == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_CapTableEntry_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableEntry",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Stakeholder.TerminationDate(childComplexity), true
+	case "Stakeholder.terminationReason":
+		if e.complexity.Stakeholder.TerminationReason == nil {
+			break
+		}
 
-func (ec *executionContext) _CapTableEntry_stakeholderName(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableEntry_stakeholderName,
-		func(ctx context.Context) (any, error) {
-			return obj.StakeholderName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Stakeholder.TerminationReason(childComplexity), true
 
-func (ec *executionContext) fieldContext_CapTableEntry_stakeholderName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableEntry",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "VestEvent.cumulativeVested":
+		if e.complexity.VestEvent.CumulativeVested == nil {
+			break
+		}
 
-func (ec *executionContext) _CapTableEntry_shareClassName(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableEntry_shareClassName,
-		func(ctx context.Context) (any, error) {
-			return obj.ShareClassName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestEvent.CumulativeVested(childComplexity), true
+	case "VestEvent.date":
+		if e.complexity.VestEvent.Date == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_CapTableEntry_shareClassName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableEntry",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VestEvent.Date(childComplexity), true
+	case "VestEvent.shares":
+		if e.complexity.VestEvent.Shares == nil {
+			break
+		}
 
-func (ec *executionContext) _CapTableEntry_shares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableEntry_shares,
-		func(ctx context.Context) (any, error) {
-			return obj.Shares, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestEvent.Shares(childComplexity), true
 
-func (ec *executionContext) fieldContext_CapTableEntry_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableEntry",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "VestingForecastLine.role":
+		if e.complexity.VestingForecastLine.Role == nil {
+			break
+		}
 
-func (ec *executionContext) _CapTableEntry_ownershipPct(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableEntry_ownershipPct,
-		func(ctx context.Context) (any, error) {
-			return obj.OwnershipPct, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestingForecastLine.Role(childComplexity), true
+	case "VestingForecastLine.shareClassID":
+		if e.complexity.VestingForecastLine.ShareClassID == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_CapTableEntry_ownershipPct(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableEntry",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VestingForecastLine.ShareClassID(childComplexity), true
+	case "VestingForecastLine.shareClassName":
+		if e.complexity.VestingForecastLine.ShareClassName == nil {
+			break
+		}
 
-func (ec *executionContext) _CapTableSnapshot_companyID(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableSnapshot_companyID,
-		func(ctx context.Context) (any, error) {
-			return obj.CompanyID, nil
-		},
-		nil,
-		ec.marshalOID2ᚖstring,
-		true,
-		false,
-	)
-}
+		return e.complexity.VestingForecastLine.ShareClassName(childComplexity), true
+	case "VestingForecastLine.vestedShares":
+		if e.complexity.VestingForecastLine.VestedShares == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_CapTableSnapshot_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VestingForecastLine.VestedShares(childComplexity), true
 
-func (ec *executionContext) _CapTableSnapshot_totalShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableSnapshot_totalShares,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalShares, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+	case "VestingForecastPeriod.breakdown":
+		if e.complexity.VestingForecastPeriod.Breakdown == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_CapTableSnapshot_totalShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VestingForecastPeriod.Breakdown(childComplexity), true
+	case "VestingForecastPeriod.periodEnd":
+		if e.complexity.VestingForecastPeriod.PeriodEnd == nil {
+			break
+		}
 
-func (ec *executionContext) _CapTableSnapshot_entries(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CapTableSnapshot_entries,
-		func(ctx context.Context) (any, error) {
-			return obj.Entries, nil
-		},
-		nil,
-		ec.marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntryᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestingForecastPeriod.PeriodEnd(childComplexity), true
+	case "VestingForecastPeriod.periodStart":
+		if e.complexity.VestingForecastPeriod.PeriodStart == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_CapTableSnapshot_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CapTableSnapshot",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "stakeholderID":
-				return ec.fieldContext_CapTableEntry_stakeholderID(ctx, field)
-			case "stakeholderName":
-				return ec.fieldContext_CapTableEntry_stakeholderName(ctx, field)
-			case "shareClassName":
-				return ec.fieldContext_CapTableEntry_shareClassName(ctx, field)
-			case "shares":
-				return ec.fieldContext_CapTableEntry_shares(ctx, field)
-			case "ownershipPct":
-				return ec.fieldContext_CapTableEntry_ownershipPct(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CapTableEntry", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VestingForecastPeriod.PeriodStart(childComplexity), true
+	case "VestingForecastPeriod.vestedShares":
+		if e.complexity.VestingForecastPeriod.VestedShares == nil {
+			break
+		}
 
-func (ec *executionContext) _Company_id(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Company_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestingForecastPeriod.VestedShares(childComplexity), true
 
-func (ec *executionContext) fieldContext_Company_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Company",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "VestingSchedule.accelerationMonths":
+		if e.complexity.VestingSchedule.AccelerationMonths == nil {
+			break
+		}
 
-func (ec *executionContext) _Company_name(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Company_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestingSchedule.AccelerationMonths(childComplexity), true
+	case "VestingSchedule.accelerationPercent":
+		if e.complexity.VestingSchedule.AccelerationPercent == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Company_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Company",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VestingSchedule.AccelerationPercent(childComplexity), true
+	case "VestingSchedule.accelerationTrigger":
+		if e.complexity.VestingSchedule.AccelerationTrigger == nil {
+			break
+		}
 
-func (ec *executionContext) _Company_stakeholders(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Company_stakeholders,
-		func(ctx context.Context) (any, error) {
-			return obj.Stakeholders, nil
-		},
-		nil,
-		ec.marshalNStakeholder2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestingSchedule.AccelerationTrigger(childComplexity), true
+	case "VestingSchedule.accelerationWindowMonths":
+		if e.complexity.VestingSchedule.AccelerationWindowMonths == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Company_stakeholders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Company",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stakeholder_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_Stakeholder_companyID(ctx, field)
-			case "name":
-				return ec.fieldContext_Stakeholder_name(ctx, field)
-			case "email":
-				return ec.fieldContext_Stakeholder_email(ctx, field)
-			case "role":
-				return ec.fieldContext_Stakeholder_role(ctx, field)
-			case "grants":
-				return ec.fieldContext_Stakeholder_grants(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Stakeholder_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Stakeholder", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.VestingSchedule.AccelerationWindowMonths(childComplexity), true
+	case "VestingSchedule.archivedAt":
+		if e.complexity.VestingSchedule.ArchivedAt == nil {
+			break
+		}
 
-func (ec *executionContext) _Company_shareClasses(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Company_shareClasses,
-		func(ctx context.Context) (any, error) {
-			return obj.ShareClasses, nil
-		},
-		nil,
-		ec.marshalNShareClass2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClassᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.VestingSchedule.ArchivedAt(childComplexity), true
+	case "VestingSchedule.cliffMonths":
+		if e.complexity.VestingSchedule.CliffMonths == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Company_shareClasses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Company",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ShareClass_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_ShareClass_companyID(ctx, field)
-			case "name":
-				return ec.fieldContext_ShareClass_name(ctx, field)
-			case "isPreferred":
-				return ec.fieldContext_ShareClass_isPreferred(ctx, field)
-			case "liquidationMultiple":
-				return ec.fieldContext_ShareClass_liquidationMultiple(ctx, field)
-			case "isParticipating":
-				return ec.fieldContext_ShareClass_isParticipating(ctx, field)
-			case "participationCap":
-				return ec.fieldContext_ShareClass_participationCap(ctx, field)
-			case "pricePerShare":
-				return ec.fieldContext_ShareClass_pricePerShare(ctx, field)
-			case "seniority":
-				return ec.fieldContext_ShareClass_seniority(ctx, field)
-			case "authorizedShares":
-				return ec.fieldContext_ShareClass_authorizedShares(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ShareClass_createdAt(ctx, field)
+		return e.complexity.VestingSchedule.CliffMonths(childComplexity), true
+	case "VestingSchedule.companyID":
+		if e.complexity.VestingSchedule.CompanyID == nil {
+			break
+		}
+
+		return e.complexity.VestingSchedule.CompanyID(childComplexity), true
+	case "VestingSchedule.frequency":
+		if e.complexity.VestingSchedule.Frequency == nil {
+			break
+		}
+
+		return e.complexity.VestingSchedule.Frequency(childComplexity), true
+	case "VestingSchedule.id":
+		if e.complexity.VestingSchedule.ID == nil {
+			break
+		}
+
+		return e.complexity.VestingSchedule.ID(childComplexity), true
+	case "VestingSchedule.name":
+		if e.complexity.VestingSchedule.Name == nil {
+			break
+		}
+
+		return e.complexity.VestingSchedule.Name(childComplexity), true
+	case "VestingSchedule.rounding":
+		if e.complexity.VestingSchedule.Rounding == nil {
+			break
+		}
+
+		return e.complexity.VestingSchedule.Rounding(childComplexity), true
+	case "VestingSchedule.totalMonths":
+		if e.complexity.VestingSchedule.TotalMonths == nil {
+			break
+		}
+
+		return e.complexity.VestingSchedule.TotalMonths(childComplexity), true
+	case "VestingSchedule.tranches":
+		if e.complexity.VestingSchedule.Tranches == nil {
+			break
+		}
+
+		return e.complexity.VestingSchedule.Tranches(childComplexity), true
+
+	case "VestingStatus.acceleratedShares":
+		if e.complexity.VestingStatus.AcceleratedShares == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.AcceleratedShares(childComplexity), true
+	case "VestingStatus.asOfDate":
+		if e.complexity.VestingStatus.AsOfDate == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.AsOfDate(childComplexity), true
+	case "VestingStatus.cliffDate":
+		if e.complexity.VestingStatus.CliffDate == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.CliffDate(childComplexity), true
+	case "VestingStatus.exercisedShares":
+		if e.complexity.VestingStatus.ExercisedShares == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.ExercisedShares(childComplexity), true
+	case "VestingStatus.fullyVestedAt":
+		if e.complexity.VestingStatus.FullyVestedAt == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.FullyVestedAt(childComplexity), true
+	case "VestingStatus.grantID":
+		if e.complexity.VestingStatus.GrantID == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.GrantID(childComplexity), true
+	case "VestingStatus.isFullyVested":
+		if e.complexity.VestingStatus.IsFullyVested == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.IsFullyVested(childComplexity), true
+	case "VestingStatus.percentVested":
+		if e.complexity.VestingStatus.PercentVested == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.PercentVested(childComplexity), true
+	case "VestingStatus.releasedShares":
+		if e.complexity.VestingStatus.ReleasedShares == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.ReleasedShares(childComplexity), true
+	case "VestingStatus.repurchasableShares":
+		if e.complexity.VestingStatus.RepurchasableShares == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.RepurchasableShares(childComplexity), true
+	case "VestingStatus.totalShares":
+		if e.complexity.VestingStatus.TotalShares == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.TotalShares(childComplexity), true
+	case "VestingStatus.unvestedShares":
+		if e.complexity.VestingStatus.UnvestedShares == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.UnvestedShares(childComplexity), true
+	case "VestingStatus.vestedShares":
+		if e.complexity.VestingStatus.VestedShares == nil {
+			break
+		}
+
+		return e.complexity.VestingStatus.VestedShares(childComplexity), true
+
+	case "VestingTranche.offsetMonths":
+		if e.complexity.VestingTranche.OffsetMonths == nil {
+			break
+		}
+
+		return e.complexity.VestingTranche.OffsetMonths(childComplexity), true
+	case "VestingTranche.percent":
+		if e.complexity.VestingTranche.Percent == nil {
+			break
+		}
+
+		return e.complexity.VestingTranche.Percent(childComplexity), true
+	case "VestingTranche.shares":
+		if e.complexity.VestingTranche.Shares == nil {
+			break
+		}
+
+		return e.complexity.VestingTranche.Shares(childComplexity), true
+
+	case "WaterfallPayout.claimElection":
+		if e.complexity.WaterfallPayout.ClaimElection == nil {
+			break
+		}
+
+		return e.complexity.WaterfallPayout.ClaimElection(childComplexity), true
+	case "WaterfallPayout.payout":
+		if e.complexity.WaterfallPayout.Payout == nil {
+			break
+		}
+
+		return e.complexity.WaterfallPayout.Payout(childComplexity), true
+	case "WaterfallPayout.payoutPerShare":
+		if e.complexity.WaterfallPayout.PayoutPerShare == nil {
+			break
+		}
+
+		return e.complexity.WaterfallPayout.PayoutPerShare(childComplexity), true
+	case "WaterfallPayout.shareClassName":
+		if e.complexity.WaterfallPayout.ShareClassName == nil {
+			break
+		}
+
+		return e.complexity.WaterfallPayout.ShareClassName(childComplexity), true
+	case "WaterfallPayout.shares":
+		if e.complexity.WaterfallPayout.Shares == nil {
+			break
+		}
+
+		return e.complexity.WaterfallPayout.Shares(childComplexity), true
+	case "WaterfallPayout.stakeholderID":
+		if e.complexity.WaterfallPayout.StakeholderID == nil {
+			break
+		}
+
+		return e.complexity.WaterfallPayout.StakeholderID(childComplexity), true
+	case "WaterfallPayout.stakeholderName":
+		if e.complexity.WaterfallPayout.StakeholderName == nil {
+			break
+		}
+
+		return e.complexity.WaterfallPayout.StakeholderName(childComplexity), true
+
+	case "WaterfallResult.exitValuation":
+		if e.complexity.WaterfallResult.ExitValuation == nil {
+			break
+		}
+
+		return e.complexity.WaterfallResult.ExitValuation(childComplexity), true
+	case "WaterfallResult.payouts":
+		if e.complexity.WaterfallResult.Payouts == nil {
+			break
+		}
+
+		return e.complexity.WaterfallResult.Payouts(childComplexity), true
+	case "WaterfallResult.totalPayout":
+		if e.complexity.WaterfallResult.TotalPayout == nil {
+			break
+		}
+
+		return e.complexity.WaterfallResult.TotalPayout(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAddStakeholderInput,
+		ec.unmarshalInputCreateCompanyInput,
+		ec.unmarshalInputCreateOptionPoolInput,
+		ec.unmarshalInputCreateShareClassInput,
+		ec.unmarshalInputCreateVestingScheduleInput,
+		ec.unmarshalInputDilutionModelInput,
+		ec.unmarshalInputExerciseGrantInput,
+		ec.unmarshalInputGrantMilestoneInput,
+		ec.unmarshalInputIssueConvertibleNoteInput,
+		ec.unmarshalInputIssueGrantInput,
+		ec.unmarshalInputIssueSAFEInput,
+		ec.unmarshalInputModeledRoundInput,
+		ec.unmarshalInputRecordFundingRoundInput,
+		ec.unmarshalInputRecordLeaveInput,
+		ec.unmarshalInputRepurchaseSharesInput,
+		ec.unmarshalInputRoundAllocationInput,
+		ec.unmarshalInputSAFEConversionScenarioInput,
+		ec.unmarshalInputSettleNoteAtMaturityInput,
+		ec.unmarshalInputTerminateStakeholderInput,
+		ec.unmarshalInputUpdateVestingScheduleInput,
+		ec.unmarshalInputVestingTrancheInput,
+	)
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ShareClass", field.Name)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+//go:embed "schema.graphqls"
+var sourcesFS embed.FS
+
+func sourceData(filename string) string {
+	data, err := sourcesFS.ReadFile(filename)
+	if err != nil {
+		panic(fmt.Sprintf("codegen problem: %s not available", filename))
+	}
+	return string(data)
+}
+
+var sources = []*ast.Source{
+	{Name: "schema.graphqls", Input: sourceData("schema.graphqls"), BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Mutation_addStakeholder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAddStakeholderInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAddStakeholderInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_archiveVestingSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_convertAllSAFEs_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "roundID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["roundID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_convertNote_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "noteID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["noteID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "roundID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["roundID"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_convertSAFE_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "safeID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["safeID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "roundID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["roundID"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createCompany_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateCompanyInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateCompanyInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createOptionPool_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateOptionPoolInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateOptionPoolInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createShareClass_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateShareClassInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateShareClassInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createVestingSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateVestingScheduleInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateVestingScheduleInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_exerciseGrant_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNExerciseGrantInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐExerciseGrantInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_issueConvertibleNote_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNIssueConvertibleNoteInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueConvertibleNoteInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_issueGrant_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNIssueGrantInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueGrantInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_issueSAFE_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNIssueSAFEInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueSAFEInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordFundingRound_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRecordFundingRoundInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRecordFundingRoundInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordLeave_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRecordLeaveInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRecordLeaveInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordMilestoneAchieved_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "milestoneID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["milestoneID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "achievedDate", ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["achievedDate"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_repurchaseShares_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNRepurchaseSharesInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRepurchaseSharesInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_settleNoteAtMaturity_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSettleNoteAtMaturityInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSettleNoteAtMaturityInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_terminateStakeholder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNTerminateStakeholderInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminateStakeholderInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateVestingSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateVestingScheduleInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐUpdateVestingScheduleInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_capTable_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "companyID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["companyID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "asOfDate", ec.unmarshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["asOfDate"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "basis", ec.unmarshalOCapTableBasis2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableBasis)
+	if err != nil {
+		return nil, err
+	}
+	args["basis"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_company_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_modelDilution_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNDilutionModelInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionModelInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_modelRoundSequence_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "companyID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["companyID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "rounds", ec.unmarshalNModeledRoundInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐModeledRoundInputᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["rounds"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_noteAccrual_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "noteID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["noteID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "asOfDate", ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["asOfDate"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_previewSAFEConversions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "companyID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["companyID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "scenarios", ec.unmarshalNSAFEConversionScenarioInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionScenarioInputᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["scenarios"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_stakeholder_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_vestingForecast_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "companyID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["companyID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "bucket", ec.unmarshalOForecastBucket2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐForecastBucket)
+	if err != nil {
+		return nil, err
+	}
+	args["bucket"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_vestingSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "grantID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["grantID"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_vestingStatusWithEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "grantID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["grantID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "asOfDate", ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["asOfDate"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "changeOfControlDate", ec.unmarshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["changeOfControlDate"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "terminationDate", ec.unmarshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["terminationDate"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_vestingStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "grantID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["grantID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "asOfDate", ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["asOfDate"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_waterfall_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "companyID", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["companyID"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "exitValuation", ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal)
+	if err != nil {
+		return nil, err
+	}
+	args["exitValuation"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "asOfDate", ec.unmarshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate)
+	if err != nil {
+		return nil, err
+	}
+	args["asOfDate"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _CapTableEntry_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_stakeholderName(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_stakeholderName,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_stakeholderName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_shareClassName(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_shareClassName,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClassName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_shareClassName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_shares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_shares,
+		func(ctx context.Context) (any, error) {
+			return obj.Shares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_ownershipPct(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_ownershipPct,
+		func(ctx context.Context) (any, error) {
+			return obj.OwnershipPct, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_ownershipPct(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_exercisedUnvestedShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_exercisedUnvestedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ExercisedUnvestedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_exercisedUnvestedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_issuedShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_issuedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.IssuedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_issuedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_optionShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_optionShares,
+		func(ctx context.Context) (any, error) {
+			return obj.OptionShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_optionShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_convertibleShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_convertibleShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ConvertibleShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_convertibleShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableEntry_poolShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableEntry_poolShares,
+		func(ctx context.Context) (any, error) {
+			return obj.PoolShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableEntry_poolShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_companyID(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_totalShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_totalShares,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_totalShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_entries(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_entries,
+		func(ctx context.Context) (any, error) {
+			return obj.Entries, nil
+		},
+		nil,
+		ec.marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stakeholderID":
+				return ec.fieldContext_CapTableEntry_stakeholderID(ctx, field)
+			case "stakeholderName":
+				return ec.fieldContext_CapTableEntry_stakeholderName(ctx, field)
+			case "shareClassName":
+				return ec.fieldContext_CapTableEntry_shareClassName(ctx, field)
+			case "shares":
+				return ec.fieldContext_CapTableEntry_shares(ctx, field)
+			case "ownershipPct":
+				return ec.fieldContext_CapTableEntry_ownershipPct(ctx, field)
+			case "exercisedUnvestedShares":
+				return ec.fieldContext_CapTableEntry_exercisedUnvestedShares(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_CapTableEntry_issuedShares(ctx, field)
+			case "optionShares":
+				return ec.fieldContext_CapTableEntry_optionShares(ctx, field)
+			case "convertibleShares":
+				return ec.fieldContext_CapTableEntry_convertibleShares(ctx, field)
+			case "poolShares":
+				return ec.fieldContext_CapTableEntry_poolShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapTableEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_basis(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_basis,
+		func(ctx context.Context) (any, error) {
+			return obj.Basis, nil
+		},
+		nil,
+		ec.marshalOCapTableBasis2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableBasis,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_basis(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type CapTableBasis does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_issuedShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_issuedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.IssuedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_issuedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_optionShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_optionShares,
+		func(ctx context.Context) (any, error) {
+			return obj.OptionShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_optionShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_convertibleShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_convertibleShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ConvertibleShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_convertibleShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CapTableSnapshot_poolShares(ctx context.Context, field graphql.CollectedField, obj *model.CapTableSnapshot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CapTableSnapshot_poolShares,
+		func(ctx context.Context) (any, error) {
+			return obj.PoolShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CapTableSnapshot_poolShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CapTableSnapshot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Capitalization_definition(ctx context.Context, field graphql.CollectedField, obj *model.Capitalization) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Capitalization_definition,
+		func(ctx context.Context) (any, error) {
+			return obj.Definition, nil
+		},
+		nil,
+		ec.marshalNCapitalizationDefinition2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalizationDefinition,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Capitalization_definition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Capitalization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type CapitalizationDefinition does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Capitalization_issuedShares(ctx context.Context, field graphql.CollectedField, obj *model.Capitalization) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Capitalization_issuedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.IssuedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Capitalization_issuedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Capitalization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Capitalization_issuedOptions(ctx context.Context, field graphql.CollectedField, obj *model.Capitalization) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Capitalization_issuedOptions,
+		func(ctx context.Context) (any, error) {
+			return obj.IssuedOptions, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Capitalization_issuedOptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Capitalization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Capitalization_promisedOptions(ctx context.Context, field graphql.CollectedField, obj *model.Capitalization) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Capitalization_promisedOptions,
+		func(ctx context.Context) (any, error) {
+			return obj.PromisedOptions, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Capitalization_promisedOptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Capitalization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Capitalization_unissuedPool(ctx context.Context, field graphql.CollectedField, obj *model.Capitalization) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Capitalization_unissuedPool,
+		func(ctx context.Context) (any, error) {
+			return obj.UnissuedPool, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Capitalization_unissuedPool(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Capitalization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Capitalization_poolIncrease(ctx context.Context, field graphql.CollectedField, obj *model.Capitalization) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Capitalization_poolIncrease,
+		func(ctx context.Context) (any, error) {
+			return obj.PoolIncrease, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Capitalization_poolIncrease(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Capitalization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Capitalization_total(ctx context.Context, field graphql.CollectedField, obj *model.Capitalization) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Capitalization_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Capitalization_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Capitalization",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_id(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_name(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_stakeholders(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_stakeholders,
+		func(ctx context.Context) (any, error) {
+			return obj.Stakeholders, nil
+		},
+		nil,
+		ec.marshalNStakeholder2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_stakeholders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stakeholder_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Stakeholder_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_Stakeholder_name(ctx, field)
+			case "email":
+				return ec.fieldContext_Stakeholder_email(ctx, field)
+			case "role":
+				return ec.fieldContext_Stakeholder_role(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Stakeholder_terminationDate(ctx, field)
+			case "terminationReason":
+				return ec.fieldContext_Stakeholder_terminationReason(ctx, field)
+			case "grants":
+				return ec.fieldContext_Stakeholder_grants(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Stakeholder_leaves(ctx, field)
+			case "mfnNotices":
+				return ec.fieldContext_Stakeholder_mfnNotices(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Stakeholder_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stakeholder", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_shareClasses(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_shareClasses,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClasses, nil
+		},
+		nil,
+		ec.marshalNShareClass2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClassᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_shareClasses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ShareClass_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_ShareClass_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_ShareClass_name(ctx, field)
+			case "isPreferred":
+				return ec.fieldContext_ShareClass_isPreferred(ctx, field)
+			case "liquidationMultiple":
+				return ec.fieldContext_ShareClass_liquidationMultiple(ctx, field)
+			case "isParticipating":
+				return ec.fieldContext_ShareClass_isParticipating(ctx, field)
+			case "participationCap":
+				return ec.fieldContext_ShareClass_participationCap(ctx, field)
+			case "pricePerShare":
+				return ec.fieldContext_ShareClass_pricePerShare(ctx, field)
+			case "seniority":
+				return ec.fieldContext_ShareClass_seniority(ctx, field)
+			case "authorizedShares":
+				return ec.fieldContext_ShareClass_authorizedShares(ctx, field)
+			case "parentShareClassID":
+				return ec.fieldContext_ShareClass_parentShareClassID(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ShareClass_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShareClass", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_grants(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_grants,
+		func(ctx context.Context) (any, error) {
+			return obj.Grants, nil
+		},
+		nil,
+		ec.marshalNGrant2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_grants(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Grant_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Grant_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_Grant_stakeholderID(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_Grant_shareClassID(ctx, field)
+			case "vestingScheduleID":
+				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
+			case "optionPoolID":
+				return ec.fieldContext_Grant_optionPoolID(ctx, field)
+			case "type":
+				return ec.fieldContext_Grant_type(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Grant_quantity(ctx, field)
+			case "grantDate":
+				return ec.fieldContext_Grant_grantDate(ctx, field)
+			case "vestingCommencementDate":
+				return ec.fieldContext_Grant_vestingCommencementDate(ctx, field)
+			case "exercisePrice":
+				return ec.fieldContext_Grant_exercisePrice(ctx, field)
+			case "isExercised":
+				return ec.fieldContext_Grant_isExercised(ctx, field)
+			case "earlyExerciseAllowed":
+				return ec.fieldContext_Grant_earlyExerciseAllowed(ctx, field)
+			case "notes":
+				return ec.fieldContext_Grant_notes(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Grant_terminationDate(ctx, field)
+			case "forfeitedQuantity":
+				return ec.fieldContext_Grant_forfeitedQuantity(ctx, field)
+			case "exerciseDeadline":
+				return ec.fieldContext_Grant_exerciseDeadline(ctx, field)
+			case "expiredQuantity":
+				return ec.fieldContext_Grant_expiredQuantity(ctx, field)
+			case "repurchasePrice":
+				return ec.fieldContext_Grant_repurchasePrice(ctx, field)
+			case "repurchasedQuantity":
+				return ec.fieldContext_Grant_repurchasedQuantity(ctx, field)
+			case "repurchaseDate":
+				return ec.fieldContext_Grant_repurchaseDate(ctx, field)
+			case "vestingSchedule":
+				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
+			case "milestones":
+				return ec.fieldContext_Grant_milestones(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Grant_leaves(ctx, field)
+			case "exercises":
+				return ec.fieldContext_Grant_exercises(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Grant_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_fundingRounds(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_fundingRounds,
+		func(ctx context.Context) (any, error) {
+			return obj.FundingRounds, nil
+		},
+		nil,
+		ec.marshalNFundingRound2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRoundᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_fundingRounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FundingRound_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_FundingRound_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_FundingRound_name(ctx, field)
+			case "preMoneyValuation":
+				return ec.fieldContext_FundingRound_preMoneyValuation(ctx, field)
+			case "amountRaised":
+				return ec.fieldContext_FundingRound_amountRaised(ctx, field)
+			case "pricePerShare":
+				return ec.fieldContext_FundingRound_pricePerShare(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_FundingRound_shareClassID(ctx, field)
+			case "roundDate":
+				return ec.fieldContext_FundingRound_roundDate(ctx, field)
+			case "optionPoolIncrease":
+				return ec.fieldContext_FundingRound_optionPoolIncrease(ctx, field)
+			case "promisedOptions":
+				return ec.fieldContext_FundingRound_promisedOptions(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FundingRound_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FundingRound", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_safeNotes(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_safeNotes,
+		func(ctx context.Context) (any, error) {
+			return obj.SafeNotes, nil
+		},
+		nil,
+		ec.marshalNSAFENote2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENoteᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_safeNotes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SAFENote_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_SAFENote_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_SAFENote_stakeholderID(ctx, field)
+			case "investmentAmount":
+				return ec.fieldContext_SAFENote_investmentAmount(ctx, field)
+			case "valuationCap":
+				return ec.fieldContext_SAFENote_valuationCap(ctx, field)
+			case "discountRate":
+				return ec.fieldContext_SAFENote_discountRate(ctx, field)
+			case "safeType":
+				return ec.fieldContext_SAFENote_safeType(ctx, field)
+			case "isMFN":
+				return ec.fieldContext_SAFENote_isMFN(ctx, field)
+			case "capitalizationDefinition":
+				return ec.fieldContext_SAFENote_capitalizationDefinition(ctx, field)
+			case "isConverted":
+				return ec.fieldContext_SAFENote_isConverted(ctx, field)
+			case "convertedInRound":
+				return ec.fieldContext_SAFENote_convertedInRound(ctx, field)
+			case "conversion":
+				return ec.fieldContext_SAFENote_conversion(ctx, field)
+			case "issueDate":
+				return ec.fieldContext_SAFENote_issueDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SAFENote_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFENote", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_convertibleNotes(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_convertibleNotes,
+		func(ctx context.Context) (any, error) {
+			return obj.ConvertibleNotes, nil
+		},
+		nil,
+		ec.marshalNConvertibleNote2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐConvertibleNoteᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_convertibleNotes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ConvertibleNote_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_ConvertibleNote_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_ConvertibleNote_stakeholderID(ctx, field)
+			case "principal":
+				return ec.fieldContext_ConvertibleNote_principal(ctx, field)
+			case "interestRate":
+				return ec.fieldContext_ConvertibleNote_interestRate(ctx, field)
+			case "interestType":
+				return ec.fieldContext_ConvertibleNote_interestType(ctx, field)
+			case "dayCount":
+				return ec.fieldContext_ConvertibleNote_dayCount(ctx, field)
+			case "issueDate":
+				return ec.fieldContext_ConvertibleNote_issueDate(ctx, field)
+			case "maturityDate":
+				return ec.fieldContext_ConvertibleNote_maturityDate(ctx, field)
+			case "valuationCap":
+				return ec.fieldContext_ConvertibleNote_valuationCap(ctx, field)
+			case "discountRate":
+				return ec.fieldContext_ConvertibleNote_discountRate(ctx, field)
+			case "qualifiedFinancingAmount":
+				return ec.fieldContext_ConvertibleNote_qualifiedFinancingAmount(ctx, field)
+			case "maturityValuationCap":
+				return ec.fieldContext_ConvertibleNote_maturityValuationCap(ctx, field)
+			case "settlement":
+				return ec.fieldContext_ConvertibleNote_settlement(ctx, field)
+			case "settledInRound":
+				return ec.fieldContext_ConvertibleNote_settledInRound(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ConvertibleNote_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConvertibleNote", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_optionPools(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_optionPools,
+		func(ctx context.Context) (any, error) {
+			return obj.OptionPools, nil
+		},
+		nil,
+		ec.marshalNOptionPool2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOptionPoolᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_optionPools(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OptionPool_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_OptionPool_companyID(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_OptionPool_shareClassID(ctx, field)
+			case "name":
+				return ec.fieldContext_OptionPool_name(ctx, field)
+			case "reservedShares":
+				return ec.fieldContext_OptionPool_reservedShares(ctx, field)
+			case "grantedShares":
+				return ec.fieldContext_OptionPool_grantedShares(ctx, field)
+			case "availableShares":
+				return ec.fieldContext_OptionPool_availableShares(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_OptionPool_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OptionPool", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_vestingSchedules(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_vestingSchedules,
+		func(ctx context.Context) (any, error) {
+			return obj.VestingSchedules, nil
+		},
+		nil,
+		ec.marshalNVestingSchedule2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingScheduleᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_vestingSchedules(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VestingSchedule_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_VestingSchedule_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_VestingSchedule_name(ctx, field)
+			case "cliffMonths":
+				return ec.fieldContext_VestingSchedule_cliffMonths(ctx, field)
+			case "totalMonths":
+				return ec.fieldContext_VestingSchedule_totalMonths(ctx, field)
+			case "frequency":
+				return ec.fieldContext_VestingSchedule_frequency(ctx, field)
+			case "accelerationTrigger":
+				return ec.fieldContext_VestingSchedule_accelerationTrigger(ctx, field)
+			case "accelerationPercent":
+				return ec.fieldContext_VestingSchedule_accelerationPercent(ctx, field)
+			case "accelerationMonths":
+				return ec.fieldContext_VestingSchedule_accelerationMonths(ctx, field)
+			case "accelerationWindowMonths":
+				return ec.fieldContext_VestingSchedule_accelerationWindowMonths(ctx, field)
+			case "tranches":
+				return ec.fieldContext_VestingSchedule_tranches(ctx, field)
+			case "rounding":
+				return ec.fieldContext_VestingSchedule_rounding(ctx, field)
+			case "archivedAt":
+				return ec.fieldContext_VestingSchedule_archivedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingSchedule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Company_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Company_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Company_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Company",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_id(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_companyID(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_principal(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_principal,
+		func(ctx context.Context) (any, error) {
+			return obj.Principal, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_principal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_interestRate(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_interestRate,
+		func(ctx context.Context) (any, error) {
+			return obj.InterestRate, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_interestRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_interestType(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_interestType,
+		func(ctx context.Context) (any, error) {
+			return obj.InterestType, nil
+		},
+		nil,
+		ec.marshalNInterestType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐInterestType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_interestType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type InterestType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_dayCount(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_dayCount,
+		func(ctx context.Context) (any, error) {
+			return obj.DayCount, nil
+		},
+		nil,
+		ec.marshalNDayCount2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDayCount,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_dayCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DayCount does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_issueDate(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_issueDate,
+		func(ctx context.Context) (any, error) {
+			return obj.IssueDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_issueDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_maturityDate(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_maturityDate,
+		func(ctx context.Context) (any, error) {
+			return obj.MaturityDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_maturityDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_valuationCap(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_valuationCap,
+		func(ctx context.Context) (any, error) {
+			return obj.ValuationCap, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_valuationCap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_discountRate(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_discountRate,
+		func(ctx context.Context) (any, error) {
+			return obj.DiscountRate, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_discountRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_qualifiedFinancingAmount(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_qualifiedFinancingAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.QualifiedFinancingAmount, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_qualifiedFinancingAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_maturityValuationCap(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_maturityValuationCap,
+		func(ctx context.Context) (any, error) {
+			return obj.MaturityValuationCap, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_maturityValuationCap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_settlement(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_settlement,
+		func(ctx context.Context) (any, error) {
+			return obj.Settlement, nil
+		},
+		nil,
+		ec.marshalONoteSettlement2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteSettlement,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_settlement(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "noteID":
+				return ec.fieldContext_NoteSettlement_noteID(ctx, field)
+			case "date":
+				return ec.fieldContext_NoteSettlement_date(ctx, field)
+			case "principal":
+				return ec.fieldContext_NoteSettlement_principal(ctx, field)
+			case "accruedInterest":
+				return ec.fieldContext_NoteSettlement_accruedInterest(ctx, field)
+			case "sharesIssued":
+				return ec.fieldContext_NoteSettlement_sharesIssued(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_NoteSettlement_effectivePPS(ctx, field)
+			case "repaymentAmount":
+				return ec.fieldContext_NoteSettlement_repaymentAmount(ctx, field)
+			case "method":
+				return ec.fieldContext_NoteSettlement_method(ctx, field)
+			case "grantID":
+				return ec.fieldContext_NoteSettlement_grantID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NoteSettlement", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_settledInRound(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_settledInRound,
+		func(ctx context.Context) (any, error) {
+			return obj.SettledInRound, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_settledInRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConvertibleNote_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ConvertibleNote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ConvertibleNote_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ConvertibleNote_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConvertibleNote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_preRound(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_preRound,
+		func(ctx context.Context) (any, error) {
+			return obj.PreRound, nil
+		},
+		nil,
+		ec.marshalNCapTableSnapshot2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_preRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "companyID":
+				return ec.fieldContext_CapTableSnapshot_companyID(ctx, field)
+			case "totalShares":
+				return ec.fieldContext_CapTableSnapshot_totalShares(ctx, field)
+			case "entries":
+				return ec.fieldContext_CapTableSnapshot_entries(ctx, field)
+			case "basis":
+				return ec.fieldContext_CapTableSnapshot_basis(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_CapTableSnapshot_issuedShares(ctx, field)
+			case "optionShares":
+				return ec.fieldContext_CapTableSnapshot_optionShares(ctx, field)
+			case "convertibleShares":
+				return ec.fieldContext_CapTableSnapshot_convertibleShares(ctx, field)
+			case "poolShares":
+				return ec.fieldContext_CapTableSnapshot_poolShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapTableSnapshot", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_postRound(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_postRound,
+		func(ctx context.Context) (any, error) {
+			return obj.PostRound, nil
+		},
+		nil,
+		ec.marshalNCapTableSnapshot2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_postRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "companyID":
+				return ec.fieldContext_CapTableSnapshot_companyID(ctx, field)
+			case "totalShares":
+				return ec.fieldContext_CapTableSnapshot_totalShares(ctx, field)
+			case "entries":
+				return ec.fieldContext_CapTableSnapshot_entries(ctx, field)
+			case "basis":
+				return ec.fieldContext_CapTableSnapshot_basis(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_CapTableSnapshot_issuedShares(ctx, field)
+			case "optionShares":
+				return ec.fieldContext_CapTableSnapshot_optionShares(ctx, field)
+			case "convertibleShares":
+				return ec.fieldContext_CapTableSnapshot_convertibleShares(ctx, field)
+			case "poolShares":
+				return ec.fieldContext_CapTableSnapshot_poolShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapTableSnapshot", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_newInvestor(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_newInvestor,
+		func(ctx context.Context) (any, error) {
+			return obj.NewInvestor, nil
+		},
+		nil,
+		ec.marshalNCapTableEntry2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntry,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_newInvestor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stakeholderID":
+				return ec.fieldContext_CapTableEntry_stakeholderID(ctx, field)
+			case "stakeholderName":
+				return ec.fieldContext_CapTableEntry_stakeholderName(ctx, field)
+			case "shareClassName":
+				return ec.fieldContext_CapTableEntry_shareClassName(ctx, field)
+			case "shares":
+				return ec.fieldContext_CapTableEntry_shares(ctx, field)
+			case "ownershipPct":
+				return ec.fieldContext_CapTableEntry_ownershipPct(ctx, field)
+			case "exercisedUnvestedShares":
+				return ec.fieldContext_CapTableEntry_exercisedUnvestedShares(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_CapTableEntry_issuedShares(ctx, field)
+			case "optionShares":
+				return ec.fieldContext_CapTableEntry_optionShares(ctx, field)
+			case "convertibleShares":
+				return ec.fieldContext_CapTableEntry_convertibleShares(ctx, field)
+			case "poolShares":
+				return ec.fieldContext_CapTableEntry_poolShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapTableEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_roundName(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_roundName,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_roundName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_investors(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_investors,
+		func(ctx context.Context) (any, error) {
+			return obj.Investors, nil
+		},
+		nil,
+		ec.marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_investors(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stakeholderID":
+				return ec.fieldContext_CapTableEntry_stakeholderID(ctx, field)
+			case "stakeholderName":
+				return ec.fieldContext_CapTableEntry_stakeholderName(ctx, field)
+			case "shareClassName":
+				return ec.fieldContext_CapTableEntry_shareClassName(ctx, field)
+			case "shares":
+				return ec.fieldContext_CapTableEntry_shares(ctx, field)
+			case "ownershipPct":
+				return ec.fieldContext_CapTableEntry_ownershipPct(ctx, field)
+			case "exercisedUnvestedShares":
+				return ec.fieldContext_CapTableEntry_exercisedUnvestedShares(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_CapTableEntry_issuedShares(ctx, field)
+			case "optionShares":
+				return ec.fieldContext_CapTableEntry_optionShares(ctx, field)
+			case "convertibleShares":
+				return ec.fieldContext_CapTableEntry_convertibleShares(ctx, field)
+			case "poolShares":
+				return ec.fieldContext_CapTableEntry_poolShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapTableEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_allocatedAmount(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_allocatedAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.AllocatedAmount, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_allocatedAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_allocationMismatch(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_allocationMismatch,
+		func(ctx context.Context) (any, error) {
+			return obj.AllocationMismatch, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_allocationMismatch(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_effectivePPS(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_effectivePPS,
+		func(ctx context.Context) (any, error) {
+			return obj.EffectivePps, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_effectivePPS(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_poolIncrease(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_poolIncrease,
+		func(ctx context.Context) (any, error) {
+			return obj.PoolIncrease, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_poolIncrease(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_poolDilutionPct(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_poolDilutionPct,
+		func(ctx context.Context) (any, error) {
+			return obj.PoolDilutionPct, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_poolDilutionPct(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_newMoneyDilutionPct(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_newMoneyDilutionPct,
+		func(ctx context.Context) (any, error) {
+			return obj.NewMoneyDilutionPct, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_newMoneyDilutionPct(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_conversions(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_conversions,
+		func(ctx context.Context) (any, error) {
+			return obj.Conversions, nil
+		},
+		nil,
+		ec.marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_conversions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stakeholderID":
+				return ec.fieldContext_CapTableEntry_stakeholderID(ctx, field)
+			case "stakeholderName":
+				return ec.fieldContext_CapTableEntry_stakeholderName(ctx, field)
+			case "shareClassName":
+				return ec.fieldContext_CapTableEntry_shareClassName(ctx, field)
+			case "shares":
+				return ec.fieldContext_CapTableEntry_shares(ctx, field)
+			case "ownershipPct":
+				return ec.fieldContext_CapTableEntry_ownershipPct(ctx, field)
+			case "exercisedUnvestedShares":
+				return ec.fieldContext_CapTableEntry_exercisedUnvestedShares(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_CapTableEntry_issuedShares(ctx, field)
+			case "optionShares":
+				return ec.fieldContext_CapTableEntry_optionShares(ctx, field)
+			case "convertibleShares":
+				return ec.fieldContext_CapTableEntry_convertibleShares(ctx, field)
+			case "poolShares":
+				return ec.fieldContext_CapTableEntry_poolShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapTableEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DilutionResult_conversionDilutionPct(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DilutionResult_conversionDilutionPct,
+		func(ctx context.Context) (any, error) {
+			return obj.ConversionDilutionPct, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DilutionResult_conversionDilutionPct(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DilutionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_id(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_companyID(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_name(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_preMoneyValuation(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_preMoneyValuation,
+		func(ctx context.Context) (any, error) {
+			return obj.PreMoneyValuation, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_preMoneyValuation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_amountRaised(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_amountRaised,
+		func(ctx context.Context) (any, error) {
+			return obj.AmountRaised, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_amountRaised(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_pricePerShare(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_pricePerShare,
+		func(ctx context.Context) (any, error) {
+			return obj.PricePerShare, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_pricePerShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_shareClassID(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_shareClassID,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClassID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_shareClassID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_roundDate(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_roundDate,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_roundDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_optionPoolIncrease(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_optionPoolIncrease,
+		func(ctx context.Context) (any, error) {
+			return obj.OptionPoolIncrease, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_optionPoolIncrease(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_promisedOptions(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_promisedOptions,
+		func(ctx context.Context) (any, error) {
+			return obj.PromisedOptions, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_promisedOptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FundingRound_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FundingRound_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FundingRound_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FundingRound",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_id(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_companyID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_shareClassID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_shareClassID,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClassID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_shareClassID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_vestingScheduleID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_vestingScheduleID,
+		func(ctx context.Context) (any, error) {
+			return obj.VestingScheduleID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_vestingScheduleID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_optionPoolID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_optionPoolID,
+		func(ctx context.Context) (any, error) {
+			return obj.OptionPoolID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_optionPoolID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_type(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNGrantType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type GrantType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_quantity(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_grantDate(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_grantDate,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_grantDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_vestingCommencementDate(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_vestingCommencementDate,
+		func(ctx context.Context) (any, error) {
+			return obj.VestingCommencementDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_vestingCommencementDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_exercisePrice(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_exercisePrice,
+		func(ctx context.Context) (any, error) {
+			return obj.ExercisePrice, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_exercisePrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_isExercised(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_isExercised,
+		func(ctx context.Context) (any, error) {
+			return obj.IsExercised, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_isExercised(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_earlyExerciseAllowed(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_earlyExerciseAllowed,
+		func(ctx context.Context) (any, error) {
+			return obj.EarlyExerciseAllowed, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_earlyExerciseAllowed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_notes(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_notes,
+		func(ctx context.Context) (any, error) {
+			return obj.Notes, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_notes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_terminationDate(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_terminationDate,
+		func(ctx context.Context) (any, error) {
+			return obj.TerminationDate, nil
+		},
+		nil,
+		ec.marshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_terminationDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_forfeitedQuantity(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_forfeitedQuantity,
+		func(ctx context.Context) (any, error) {
+			return obj.ForfeitedQuantity, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_forfeitedQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_exerciseDeadline(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_exerciseDeadline,
+		func(ctx context.Context) (any, error) {
+			return obj.ExerciseDeadline, nil
+		},
+		nil,
+		ec.marshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_exerciseDeadline(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_expiredQuantity(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_expiredQuantity,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiredQuantity, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_expiredQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_repurchasePrice(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_repurchasePrice,
+		func(ctx context.Context) (any, error) {
+			return obj.RepurchasePrice, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_repurchasePrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_repurchasedQuantity(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_repurchasedQuantity,
+		func(ctx context.Context) (any, error) {
+			return obj.RepurchasedQuantity, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_repurchasedQuantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_repurchaseDate(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_repurchaseDate,
+		func(ctx context.Context) (any, error) {
+			return obj.RepurchaseDate, nil
+		},
+		nil,
+		ec.marshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_repurchaseDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_vestingSchedule(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_vestingSchedule,
+		func(ctx context.Context) (any, error) {
+			return obj.VestingSchedule, nil
+		},
+		nil,
+		ec.marshalOVestingSchedule2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_vestingSchedule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VestingSchedule_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_VestingSchedule_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_VestingSchedule_name(ctx, field)
+			case "cliffMonths":
+				return ec.fieldContext_VestingSchedule_cliffMonths(ctx, field)
+			case "totalMonths":
+				return ec.fieldContext_VestingSchedule_totalMonths(ctx, field)
+			case "frequency":
+				return ec.fieldContext_VestingSchedule_frequency(ctx, field)
+			case "accelerationTrigger":
+				return ec.fieldContext_VestingSchedule_accelerationTrigger(ctx, field)
+			case "accelerationPercent":
+				return ec.fieldContext_VestingSchedule_accelerationPercent(ctx, field)
+			case "accelerationMonths":
+				return ec.fieldContext_VestingSchedule_accelerationMonths(ctx, field)
+			case "accelerationWindowMonths":
+				return ec.fieldContext_VestingSchedule_accelerationWindowMonths(ctx, field)
+			case "tranches":
+				return ec.fieldContext_VestingSchedule_tranches(ctx, field)
+			case "rounding":
+				return ec.fieldContext_VestingSchedule_rounding(ctx, field)
+			case "archivedAt":
+				return ec.fieldContext_VestingSchedule_archivedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingSchedule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_milestones(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_milestones,
+		func(ctx context.Context) (any, error) {
+			return obj.Milestones, nil
+		},
+		nil,
+		ec.marshalNGrantMilestone2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestoneᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_milestones(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GrantMilestone_id(ctx, field)
+			case "grantID":
+				return ec.fieldContext_GrantMilestone_grantID(ctx, field)
+			case "name":
+				return ec.fieldContext_GrantMilestone_name(ctx, field)
+			case "shares":
+				return ec.fieldContext_GrantMilestone_shares(ctx, field)
+			case "condition":
+				return ec.fieldContext_GrantMilestone_condition(ctx, field)
+			case "achievedDate":
+				return ec.fieldContext_GrantMilestone_achievedDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GrantMilestone_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GrantMilestone", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_leaves(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_leaves,
+		func(ctx context.Context) (any, error) {
+			return obj.Leaves, nil
+		},
+		nil,
+		ec.marshalNLeaveOfAbsence2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐLeaveOfAbsenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_leaves(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LeaveOfAbsence_id(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_LeaveOfAbsence_stakeholderID(ctx, field)
+			case "grantID":
+				return ec.fieldContext_LeaveOfAbsence_grantID(ctx, field)
+			case "startDate":
+				return ec.fieldContext_LeaveOfAbsence_startDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_LeaveOfAbsence_endDate(ctx, field)
+			case "isPaid":
+				return ec.fieldContext_LeaveOfAbsence_isPaid(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LeaveOfAbsence_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LeaveOfAbsence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_exercises(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_exercises,
+		func(ctx context.Context) (any, error) {
+			return obj.Exercises, nil
+		},
+		nil,
+		ec.marshalNGrantExercise2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantExerciseᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_exercises(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GrantExercise_id(ctx, field)
+			case "grantID":
+				return ec.fieldContext_GrantExercise_grantID(ctx, field)
+			case "quantity":
+				return ec.fieldContext_GrantExercise_quantity(ctx, field)
+			case "exerciseDate":
+				return ec.fieldContext_GrantExercise_exerciseDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GrantExercise_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GrantExercise", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Grant_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Grant_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Grant_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Grant",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantExercise_id(ctx context.Context, field graphql.CollectedField, obj *model.GrantExercise) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantExercise_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantExercise_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantExercise",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantExercise_grantID(ctx context.Context, field graphql.CollectedField, obj *model.GrantExercise) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantExercise_grantID,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantExercise_grantID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantExercise",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantExercise_quantity(ctx context.Context, field graphql.CollectedField, obj *model.GrantExercise) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantExercise_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantExercise_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantExercise",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantExercise_exerciseDate(ctx context.Context, field graphql.CollectedField, obj *model.GrantExercise) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantExercise_exerciseDate,
+		func(ctx context.Context) (any, error) {
+			return obj.ExerciseDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantExercise_exerciseDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantExercise",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantExercise_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.GrantExercise) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantExercise_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantExercise_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantExercise",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantMilestone_id(ctx context.Context, field graphql.CollectedField, obj *model.GrantMilestone) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantMilestone_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantMilestone_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantMilestone",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantMilestone_grantID(ctx context.Context, field graphql.CollectedField, obj *model.GrantMilestone) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantMilestone_grantID,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantMilestone_grantID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantMilestone",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantMilestone_name(ctx context.Context, field graphql.CollectedField, obj *model.GrantMilestone) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantMilestone_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantMilestone_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantMilestone",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantMilestone_shares(ctx context.Context, field graphql.CollectedField, obj *model.GrantMilestone) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantMilestone_shares,
+		func(ctx context.Context) (any, error) {
+			return obj.Shares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantMilestone_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantMilestone",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantMilestone_condition(ctx context.Context, field graphql.CollectedField, obj *model.GrantMilestone) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantMilestone_condition,
+		func(ctx context.Context) (any, error) {
+			return obj.Condition, nil
+		},
+		nil,
+		ec.marshalNMilestoneCondition2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMilestoneCondition,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantMilestone_condition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantMilestone",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MilestoneCondition does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantMilestone_achievedDate(ctx context.Context, field graphql.CollectedField, obj *model.GrantMilestone) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantMilestone_achievedDate,
+		func(ctx context.Context) (any, error) {
+			return obj.AchievedDate, nil
+		},
+		nil,
+		ec.marshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantMilestone_achievedDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantMilestone",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GrantMilestone_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.GrantMilestone) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GrantMilestone_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GrantMilestone_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GrantMilestone",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveOfAbsence_id(ctx context.Context, field graphql.CollectedField, obj *model.LeaveOfAbsence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeaveOfAbsence_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeaveOfAbsence_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveOfAbsence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveOfAbsence_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.LeaveOfAbsence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeaveOfAbsence_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeaveOfAbsence_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveOfAbsence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveOfAbsence_grantID(ctx context.Context, field graphql.CollectedField, obj *model.LeaveOfAbsence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeaveOfAbsence_grantID,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeaveOfAbsence_grantID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveOfAbsence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveOfAbsence_startDate(ctx context.Context, field graphql.CollectedField, obj *model.LeaveOfAbsence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeaveOfAbsence_startDate,
+		func(ctx context.Context) (any, error) {
+			return obj.StartDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeaveOfAbsence_startDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveOfAbsence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveOfAbsence_endDate(ctx context.Context, field graphql.CollectedField, obj *model.LeaveOfAbsence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeaveOfAbsence_endDate,
+		func(ctx context.Context) (any, error) {
+			return obj.EndDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeaveOfAbsence_endDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveOfAbsence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveOfAbsence_isPaid(ctx context.Context, field graphql.CollectedField, obj *model.LeaveOfAbsence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeaveOfAbsence_isPaid,
+		func(ctx context.Context) (any, error) {
+			return obj.IsPaid, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeaveOfAbsence_isPaid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveOfAbsence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LeaveOfAbsence_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.LeaveOfAbsence) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LeaveOfAbsence_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LeaveOfAbsence_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LeaveOfAbsence",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MFNNotice_id(ctx context.Context, field graphql.CollectedField, obj *model.MFNNotice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MFNNotice_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MFNNotice_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MFNNotice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MFNNotice_safeID(ctx context.Context, field graphql.CollectedField, obj *model.MFNNotice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MFNNotice_safeID,
+		func(ctx context.Context) (any, error) {
+			return obj.SafeID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MFNNotice_safeID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MFNNotice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MFNNotice_sourceSafeID(ctx context.Context, field graphql.CollectedField, obj *model.MFNNotice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MFNNotice_sourceSafeID,
+		func(ctx context.Context) (any, error) {
+			return obj.SourceSafeID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MFNNotice_sourceSafeID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MFNNotice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MFNNotice_valuationCap(ctx context.Context, field graphql.CollectedField, obj *model.MFNNotice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MFNNotice_valuationCap,
+		func(ctx context.Context) (any, error) {
+			return obj.ValuationCap, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_MFNNotice_valuationCap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MFNNotice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MFNNotice_discountRate(ctx context.Context, field graphql.CollectedField, obj *model.MFNNotice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MFNNotice_discountRate,
+		func(ctx context.Context) (any, error) {
+			return obj.DiscountRate, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_MFNNotice_discountRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MFNNotice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MFNNotice_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.MFNNotice) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MFNNotice_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MFNNotice_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MFNNotice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createCompany(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createCompany,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateCompany(ctx, fc.Args["input"].(model.CreateCompanyInput))
+		},
+		nil,
+		ec.marshalNCompany2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createCompany(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Company_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Company_name(ctx, field)
+			case "stakeholders":
+				return ec.fieldContext_Company_stakeholders(ctx, field)
+			case "shareClasses":
+				return ec.fieldContext_Company_shareClasses(ctx, field)
+			case "grants":
+				return ec.fieldContext_Company_grants(ctx, field)
+			case "fundingRounds":
+				return ec.fieldContext_Company_fundingRounds(ctx, field)
+			case "safeNotes":
+				return ec.fieldContext_Company_safeNotes(ctx, field)
+			case "convertibleNotes":
+				return ec.fieldContext_Company_convertibleNotes(ctx, field)
+			case "optionPools":
+				return ec.fieldContext_Company_optionPools(ctx, field)
+			case "vestingSchedules":
+				return ec.fieldContext_Company_vestingSchedules(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Company_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Company", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createCompany_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addStakeholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addStakeholder,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddStakeholder(ctx, fc.Args["input"].(model.AddStakeholderInput))
+		},
+		nil,
+		ec.marshalNStakeholder2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addStakeholder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stakeholder_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Stakeholder_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_Stakeholder_name(ctx, field)
+			case "email":
+				return ec.fieldContext_Stakeholder_email(ctx, field)
+			case "role":
+				return ec.fieldContext_Stakeholder_role(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Stakeholder_terminationDate(ctx, field)
+			case "terminationReason":
+				return ec.fieldContext_Stakeholder_terminationReason(ctx, field)
+			case "grants":
+				return ec.fieldContext_Stakeholder_grants(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Stakeholder_leaves(ctx, field)
+			case "mfnNotices":
+				return ec.fieldContext_Stakeholder_mfnNotices(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Stakeholder_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stakeholder", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addStakeholder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createShareClass(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createShareClass,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateShareClass(ctx, fc.Args["input"].(model.CreateShareClassInput))
+		},
+		nil,
+		ec.marshalNShareClass2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createShareClass(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ShareClass_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_ShareClass_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_ShareClass_name(ctx, field)
+			case "isPreferred":
+				return ec.fieldContext_ShareClass_isPreferred(ctx, field)
+			case "liquidationMultiple":
+				return ec.fieldContext_ShareClass_liquidationMultiple(ctx, field)
+			case "isParticipating":
+				return ec.fieldContext_ShareClass_isParticipating(ctx, field)
+			case "participationCap":
+				return ec.fieldContext_ShareClass_participationCap(ctx, field)
+			case "pricePerShare":
+				return ec.fieldContext_ShareClass_pricePerShare(ctx, field)
+			case "seniority":
+				return ec.fieldContext_ShareClass_seniority(ctx, field)
+			case "authorizedShares":
+				return ec.fieldContext_ShareClass_authorizedShares(ctx, field)
+			case "parentShareClassID":
+				return ec.fieldContext_ShareClass_parentShareClassID(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ShareClass_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShareClass", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createShareClass_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createVestingSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createVestingSchedule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateVestingSchedule(ctx, fc.Args["input"].(model.CreateVestingScheduleInput))
+		},
+		nil,
+		ec.marshalNVestingSchedule2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createVestingSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VestingSchedule_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_VestingSchedule_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_VestingSchedule_name(ctx, field)
+			case "cliffMonths":
+				return ec.fieldContext_VestingSchedule_cliffMonths(ctx, field)
+			case "totalMonths":
+				return ec.fieldContext_VestingSchedule_totalMonths(ctx, field)
+			case "frequency":
+				return ec.fieldContext_VestingSchedule_frequency(ctx, field)
+			case "accelerationTrigger":
+				return ec.fieldContext_VestingSchedule_accelerationTrigger(ctx, field)
+			case "accelerationPercent":
+				return ec.fieldContext_VestingSchedule_accelerationPercent(ctx, field)
+			case "accelerationMonths":
+				return ec.fieldContext_VestingSchedule_accelerationMonths(ctx, field)
+			case "accelerationWindowMonths":
+				return ec.fieldContext_VestingSchedule_accelerationWindowMonths(ctx, field)
+			case "tranches":
+				return ec.fieldContext_VestingSchedule_tranches(ctx, field)
+			case "rounding":
+				return ec.fieldContext_VestingSchedule_rounding(ctx, field)
+			case "archivedAt":
+				return ec.fieldContext_VestingSchedule_archivedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingSchedule", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createVestingSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateVestingSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateVestingSchedule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateVestingSchedule(ctx, fc.Args["input"].(model.UpdateVestingScheduleInput))
+		},
+		nil,
+		ec.marshalNVestingSchedule2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateVestingSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VestingSchedule_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_VestingSchedule_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_VestingSchedule_name(ctx, field)
+			case "cliffMonths":
+				return ec.fieldContext_VestingSchedule_cliffMonths(ctx, field)
+			case "totalMonths":
+				return ec.fieldContext_VestingSchedule_totalMonths(ctx, field)
+			case "frequency":
+				return ec.fieldContext_VestingSchedule_frequency(ctx, field)
+			case "accelerationTrigger":
+				return ec.fieldContext_VestingSchedule_accelerationTrigger(ctx, field)
+			case "accelerationPercent":
+				return ec.fieldContext_VestingSchedule_accelerationPercent(ctx, field)
+			case "accelerationMonths":
+				return ec.fieldContext_VestingSchedule_accelerationMonths(ctx, field)
+			case "accelerationWindowMonths":
+				return ec.fieldContext_VestingSchedule_accelerationWindowMonths(ctx, field)
+			case "tranches":
+				return ec.fieldContext_VestingSchedule_tranches(ctx, field)
+			case "rounding":
+				return ec.fieldContext_VestingSchedule_rounding(ctx, field)
+			case "archivedAt":
+				return ec.fieldContext_VestingSchedule_archivedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingSchedule", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateVestingSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_archiveVestingSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_archiveVestingSchedule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ArchiveVestingSchedule(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalNVestingSchedule2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_archiveVestingSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VestingSchedule_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_VestingSchedule_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_VestingSchedule_name(ctx, field)
+			case "cliffMonths":
+				return ec.fieldContext_VestingSchedule_cliffMonths(ctx, field)
+			case "totalMonths":
+				return ec.fieldContext_VestingSchedule_totalMonths(ctx, field)
+			case "frequency":
+				return ec.fieldContext_VestingSchedule_frequency(ctx, field)
+			case "accelerationTrigger":
+				return ec.fieldContext_VestingSchedule_accelerationTrigger(ctx, field)
+			case "accelerationPercent":
+				return ec.fieldContext_VestingSchedule_accelerationPercent(ctx, field)
+			case "accelerationMonths":
+				return ec.fieldContext_VestingSchedule_accelerationMonths(ctx, field)
+			case "accelerationWindowMonths":
+				return ec.fieldContext_VestingSchedule_accelerationWindowMonths(ctx, field)
+			case "tranches":
+				return ec.fieldContext_VestingSchedule_tranches(ctx, field)
+			case "rounding":
+				return ec.fieldContext_VestingSchedule_rounding(ctx, field)
+			case "archivedAt":
+				return ec.fieldContext_VestingSchedule_archivedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingSchedule", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_archiveVestingSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_issueGrant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_issueGrant,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().IssueGrant(ctx, fc.Args["input"].(model.IssueGrantInput))
+		},
+		nil,
+		ec.marshalNGrant2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_issueGrant(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Grant_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Grant_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_Grant_stakeholderID(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_Grant_shareClassID(ctx, field)
+			case "vestingScheduleID":
+				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
+			case "optionPoolID":
+				return ec.fieldContext_Grant_optionPoolID(ctx, field)
+			case "type":
+				return ec.fieldContext_Grant_type(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Grant_quantity(ctx, field)
+			case "grantDate":
+				return ec.fieldContext_Grant_grantDate(ctx, field)
+			case "vestingCommencementDate":
+				return ec.fieldContext_Grant_vestingCommencementDate(ctx, field)
+			case "exercisePrice":
+				return ec.fieldContext_Grant_exercisePrice(ctx, field)
+			case "isExercised":
+				return ec.fieldContext_Grant_isExercised(ctx, field)
+			case "earlyExerciseAllowed":
+				return ec.fieldContext_Grant_earlyExerciseAllowed(ctx, field)
+			case "notes":
+				return ec.fieldContext_Grant_notes(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Grant_terminationDate(ctx, field)
+			case "forfeitedQuantity":
+				return ec.fieldContext_Grant_forfeitedQuantity(ctx, field)
+			case "exerciseDeadline":
+				return ec.fieldContext_Grant_exerciseDeadline(ctx, field)
+			case "expiredQuantity":
+				return ec.fieldContext_Grant_expiredQuantity(ctx, field)
+			case "repurchasePrice":
+				return ec.fieldContext_Grant_repurchasePrice(ctx, field)
+			case "repurchasedQuantity":
+				return ec.fieldContext_Grant_repurchasedQuantity(ctx, field)
+			case "repurchaseDate":
+				return ec.fieldContext_Grant_repurchaseDate(ctx, field)
+			case "vestingSchedule":
+				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
+			case "milestones":
+				return ec.fieldContext_Grant_milestones(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Grant_leaves(ctx, field)
+			case "exercises":
+				return ec.fieldContext_Grant_exercises(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Grant_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_issueGrant_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createOptionPool(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createOptionPool,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateOptionPool(ctx, fc.Args["input"].(model.CreateOptionPoolInput))
+		},
+		nil,
+		ec.marshalNOptionPool2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOptionPool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createOptionPool(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OptionPool_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_OptionPool_companyID(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_OptionPool_shareClassID(ctx, field)
+			case "name":
+				return ec.fieldContext_OptionPool_name(ctx, field)
+			case "reservedShares":
+				return ec.fieldContext_OptionPool_reservedShares(ctx, field)
+			case "grantedShares":
+				return ec.fieldContext_OptionPool_grantedShares(ctx, field)
+			case "availableShares":
+				return ec.fieldContext_OptionPool_availableShares(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_OptionPool_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OptionPool", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createOptionPool_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_terminateStakeholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_terminateStakeholder,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().TerminateStakeholder(ctx, fc.Args["input"].(model.TerminateStakeholderInput))
+		},
+		nil,
+		ec.marshalNStakeholder2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_terminateStakeholder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stakeholder_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Stakeholder_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_Stakeholder_name(ctx, field)
+			case "email":
+				return ec.fieldContext_Stakeholder_email(ctx, field)
+			case "role":
+				return ec.fieldContext_Stakeholder_role(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Stakeholder_terminationDate(ctx, field)
+			case "terminationReason":
+				return ec.fieldContext_Stakeholder_terminationReason(ctx, field)
+			case "grants":
+				return ec.fieldContext_Stakeholder_grants(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Stakeholder_leaves(ctx, field)
+			case "mfnNotices":
+				return ec.fieldContext_Stakeholder_mfnNotices(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Stakeholder_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stakeholder", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_terminateStakeholder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recordLeave(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_recordLeave,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RecordLeave(ctx, fc.Args["input"].(model.RecordLeaveInput))
+		},
+		nil,
+		ec.marshalNLeaveOfAbsence2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐLeaveOfAbsence,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recordLeave(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LeaveOfAbsence_id(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_LeaveOfAbsence_stakeholderID(ctx, field)
+			case "grantID":
+				return ec.fieldContext_LeaveOfAbsence_grantID(ctx, field)
+			case "startDate":
+				return ec.fieldContext_LeaveOfAbsence_startDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_LeaveOfAbsence_endDate(ctx, field)
+			case "isPaid":
+				return ec.fieldContext_LeaveOfAbsence_isPaid(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LeaveOfAbsence_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LeaveOfAbsence", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordLeave_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_exerciseGrant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_exerciseGrant,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ExerciseGrant(ctx, fc.Args["input"].(model.ExerciseGrantInput))
+		},
+		nil,
+		ec.marshalNGrant2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_exerciseGrant(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Grant_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Grant_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_Grant_stakeholderID(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_Grant_shareClassID(ctx, field)
+			case "vestingScheduleID":
+				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
+			case "optionPoolID":
+				return ec.fieldContext_Grant_optionPoolID(ctx, field)
+			case "type":
+				return ec.fieldContext_Grant_type(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Grant_quantity(ctx, field)
+			case "grantDate":
+				return ec.fieldContext_Grant_grantDate(ctx, field)
+			case "vestingCommencementDate":
+				return ec.fieldContext_Grant_vestingCommencementDate(ctx, field)
+			case "exercisePrice":
+				return ec.fieldContext_Grant_exercisePrice(ctx, field)
+			case "isExercised":
+				return ec.fieldContext_Grant_isExercised(ctx, field)
+			case "earlyExerciseAllowed":
+				return ec.fieldContext_Grant_earlyExerciseAllowed(ctx, field)
+			case "notes":
+				return ec.fieldContext_Grant_notes(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Grant_terminationDate(ctx, field)
+			case "forfeitedQuantity":
+				return ec.fieldContext_Grant_forfeitedQuantity(ctx, field)
+			case "exerciseDeadline":
+				return ec.fieldContext_Grant_exerciseDeadline(ctx, field)
+			case "expiredQuantity":
+				return ec.fieldContext_Grant_expiredQuantity(ctx, field)
+			case "repurchasePrice":
+				return ec.fieldContext_Grant_repurchasePrice(ctx, field)
+			case "repurchasedQuantity":
+				return ec.fieldContext_Grant_repurchasedQuantity(ctx, field)
+			case "repurchaseDate":
+				return ec.fieldContext_Grant_repurchaseDate(ctx, field)
+			case "vestingSchedule":
+				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
+			case "milestones":
+				return ec.fieldContext_Grant_milestones(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Grant_leaves(ctx, field)
+			case "exercises":
+				return ec.fieldContext_Grant_exercises(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Grant_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_exerciseGrant_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_repurchaseShares(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_repurchaseShares,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RepurchaseShares(ctx, fc.Args["input"].(model.RepurchaseSharesInput))
+		},
+		nil,
+		ec.marshalNGrant2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_repurchaseShares(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Grant_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Grant_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_Grant_stakeholderID(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_Grant_shareClassID(ctx, field)
+			case "vestingScheduleID":
+				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
+			case "optionPoolID":
+				return ec.fieldContext_Grant_optionPoolID(ctx, field)
+			case "type":
+				return ec.fieldContext_Grant_type(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Grant_quantity(ctx, field)
+			case "grantDate":
+				return ec.fieldContext_Grant_grantDate(ctx, field)
+			case "vestingCommencementDate":
+				return ec.fieldContext_Grant_vestingCommencementDate(ctx, field)
+			case "exercisePrice":
+				return ec.fieldContext_Grant_exercisePrice(ctx, field)
+			case "isExercised":
+				return ec.fieldContext_Grant_isExercised(ctx, field)
+			case "earlyExerciseAllowed":
+				return ec.fieldContext_Grant_earlyExerciseAllowed(ctx, field)
+			case "notes":
+				return ec.fieldContext_Grant_notes(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Grant_terminationDate(ctx, field)
+			case "forfeitedQuantity":
+				return ec.fieldContext_Grant_forfeitedQuantity(ctx, field)
+			case "exerciseDeadline":
+				return ec.fieldContext_Grant_exerciseDeadline(ctx, field)
+			case "expiredQuantity":
+				return ec.fieldContext_Grant_expiredQuantity(ctx, field)
+			case "repurchasePrice":
+				return ec.fieldContext_Grant_repurchasePrice(ctx, field)
+			case "repurchasedQuantity":
+				return ec.fieldContext_Grant_repurchasedQuantity(ctx, field)
+			case "repurchaseDate":
+				return ec.fieldContext_Grant_repurchaseDate(ctx, field)
+			case "vestingSchedule":
+				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
+			case "milestones":
+				return ec.fieldContext_Grant_milestones(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Grant_leaves(ctx, field)
+			case "exercises":
+				return ec.fieldContext_Grant_exercises(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Grant_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_repurchaseShares_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recordFundingRound(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_recordFundingRound,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RecordFundingRound(ctx, fc.Args["input"].(model.RecordFundingRoundInput))
+		},
+		nil,
+		ec.marshalNFundingRound2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recordFundingRound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FundingRound_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_FundingRound_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_FundingRound_name(ctx, field)
+			case "preMoneyValuation":
+				return ec.fieldContext_FundingRound_preMoneyValuation(ctx, field)
+			case "amountRaised":
+				return ec.fieldContext_FundingRound_amountRaised(ctx, field)
+			case "pricePerShare":
+				return ec.fieldContext_FundingRound_pricePerShare(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_FundingRound_shareClassID(ctx, field)
+			case "roundDate":
+				return ec.fieldContext_FundingRound_roundDate(ctx, field)
+			case "optionPoolIncrease":
+				return ec.fieldContext_FundingRound_optionPoolIncrease(ctx, field)
+			case "promisedOptions":
+				return ec.fieldContext_FundingRound_promisedOptions(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FundingRound_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FundingRound", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordFundingRound_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_issueSAFE(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_issueSAFE,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().IssueSafe(ctx, fc.Args["input"].(model.IssueSAFEInput))
+		},
+		nil,
+		ec.marshalNSAFENote2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_issueSAFE(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SAFENote_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_SAFENote_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_SAFENote_stakeholderID(ctx, field)
+			case "investmentAmount":
+				return ec.fieldContext_SAFENote_investmentAmount(ctx, field)
+			case "valuationCap":
+				return ec.fieldContext_SAFENote_valuationCap(ctx, field)
+			case "discountRate":
+				return ec.fieldContext_SAFENote_discountRate(ctx, field)
+			case "safeType":
+				return ec.fieldContext_SAFENote_safeType(ctx, field)
+			case "isMFN":
+				return ec.fieldContext_SAFENote_isMFN(ctx, field)
+			case "capitalizationDefinition":
+				return ec.fieldContext_SAFENote_capitalizationDefinition(ctx, field)
+			case "isConverted":
+				return ec.fieldContext_SAFENote_isConverted(ctx, field)
+			case "convertedInRound":
+				return ec.fieldContext_SAFENote_convertedInRound(ctx, field)
+			case "conversion":
+				return ec.fieldContext_SAFENote_conversion(ctx, field)
+			case "issueDate":
+				return ec.fieldContext_SAFENote_issueDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SAFENote_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFENote", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_issueSAFE_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_convertSAFE(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_convertSAFE,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ConvertSafe(ctx, fc.Args["safeID"].(string), fc.Args["roundID"].(string))
+		},
+		nil,
+		ec.marshalNSAFEConversionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_convertSAFE(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "safeID":
+				return ec.fieldContext_SAFEConversionResult_safeID(ctx, field)
+			case "sharesIssued":
+				return ec.fieldContext_SAFEConversionResult_sharesIssued(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_SAFEConversionResult_effectivePPS(ctx, field)
+			case "conversionMethod":
+				return ec.fieldContext_SAFEConversionResult_conversionMethod(ctx, field)
+			case "grantID":
+				return ec.fieldContext_SAFEConversionResult_grantID(ctx, field)
+			case "inheritedTermsFrom":
+				return ec.fieldContext_SAFEConversionResult_inheritedTermsFrom(ctx, field)
+			case "capitalization":
+				return ec.fieldContext_SAFEConversionResult_capitalization(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFEConversionResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_convertSAFE_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_convertAllSAFEs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_convertAllSAFEs,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ConvertAllSAFEs(ctx, fc.Args["roundID"].(string))
+		},
+		nil,
+		ec.marshalNSAFEConversionResult2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResultᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_convertAllSAFEs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "safeID":
+				return ec.fieldContext_SAFEConversionResult_safeID(ctx, field)
+			case "sharesIssued":
+				return ec.fieldContext_SAFEConversionResult_sharesIssued(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_SAFEConversionResult_effectivePPS(ctx, field)
+			case "conversionMethod":
+				return ec.fieldContext_SAFEConversionResult_conversionMethod(ctx, field)
+			case "grantID":
+				return ec.fieldContext_SAFEConversionResult_grantID(ctx, field)
+			case "inheritedTermsFrom":
+				return ec.fieldContext_SAFEConversionResult_inheritedTermsFrom(ctx, field)
+			case "capitalization":
+				return ec.fieldContext_SAFEConversionResult_capitalization(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFEConversionResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_convertAllSAFEs_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_issueConvertibleNote(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_issueConvertibleNote,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().IssueConvertibleNote(ctx, fc.Args["input"].(model.IssueConvertibleNoteInput))
+		},
+		nil,
+		ec.marshalNConvertibleNote2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐConvertibleNote,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_issueConvertibleNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ConvertibleNote_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_ConvertibleNote_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_ConvertibleNote_stakeholderID(ctx, field)
+			case "principal":
+				return ec.fieldContext_ConvertibleNote_principal(ctx, field)
+			case "interestRate":
+				return ec.fieldContext_ConvertibleNote_interestRate(ctx, field)
+			case "interestType":
+				return ec.fieldContext_ConvertibleNote_interestType(ctx, field)
+			case "dayCount":
+				return ec.fieldContext_ConvertibleNote_dayCount(ctx, field)
+			case "issueDate":
+				return ec.fieldContext_ConvertibleNote_issueDate(ctx, field)
+			case "maturityDate":
+				return ec.fieldContext_ConvertibleNote_maturityDate(ctx, field)
+			case "valuationCap":
+				return ec.fieldContext_ConvertibleNote_valuationCap(ctx, field)
+			case "discountRate":
+				return ec.fieldContext_ConvertibleNote_discountRate(ctx, field)
+			case "qualifiedFinancingAmount":
+				return ec.fieldContext_ConvertibleNote_qualifiedFinancingAmount(ctx, field)
+			case "maturityValuationCap":
+				return ec.fieldContext_ConvertibleNote_maturityValuationCap(ctx, field)
+			case "settlement":
+				return ec.fieldContext_ConvertibleNote_settlement(ctx, field)
+			case "settledInRound":
+				return ec.fieldContext_ConvertibleNote_settledInRound(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_ConvertibleNote_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConvertibleNote", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_issueConvertibleNote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_convertNote(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_convertNote,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ConvertNote(ctx, fc.Args["noteID"].(string), fc.Args["roundID"].(string))
+		},
+		nil,
+		ec.marshalNNoteSettlement2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteSettlement,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_convertNote(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "noteID":
+				return ec.fieldContext_NoteSettlement_noteID(ctx, field)
+			case "date":
+				return ec.fieldContext_NoteSettlement_date(ctx, field)
+			case "principal":
+				return ec.fieldContext_NoteSettlement_principal(ctx, field)
+			case "accruedInterest":
+				return ec.fieldContext_NoteSettlement_accruedInterest(ctx, field)
+			case "sharesIssued":
+				return ec.fieldContext_NoteSettlement_sharesIssued(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_NoteSettlement_effectivePPS(ctx, field)
+			case "repaymentAmount":
+				return ec.fieldContext_NoteSettlement_repaymentAmount(ctx, field)
+			case "method":
+				return ec.fieldContext_NoteSettlement_method(ctx, field)
+			case "grantID":
+				return ec.fieldContext_NoteSettlement_grantID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NoteSettlement", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_convertNote_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_settleNoteAtMaturity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_settleNoteAtMaturity,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SettleNoteAtMaturity(ctx, fc.Args["input"].(model.SettleNoteAtMaturityInput))
+		},
+		nil,
+		ec.marshalNNoteSettlement2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteSettlement,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_settleNoteAtMaturity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "noteID":
+				return ec.fieldContext_NoteSettlement_noteID(ctx, field)
+			case "date":
+				return ec.fieldContext_NoteSettlement_date(ctx, field)
+			case "principal":
+				return ec.fieldContext_NoteSettlement_principal(ctx, field)
+			case "accruedInterest":
+				return ec.fieldContext_NoteSettlement_accruedInterest(ctx, field)
+			case "sharesIssued":
+				return ec.fieldContext_NoteSettlement_sharesIssued(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_NoteSettlement_effectivePPS(ctx, field)
+			case "repaymentAmount":
+				return ec.fieldContext_NoteSettlement_repaymentAmount(ctx, field)
+			case "method":
+				return ec.fieldContext_NoteSettlement_method(ctx, field)
+			case "grantID":
+				return ec.fieldContext_NoteSettlement_grantID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NoteSettlement", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_settleNoteAtMaturity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_recordMilestoneAchieved(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_recordMilestoneAchieved,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RecordMilestoneAchieved(ctx, fc.Args["milestoneID"].(string), fc.Args["achievedDate"].(model.Date))
+		},
+		nil,
+		ec.marshalNGrantMilestone2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestone,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_recordMilestoneAchieved(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_GrantMilestone_id(ctx, field)
+			case "grantID":
+				return ec.fieldContext_GrantMilestone_grantID(ctx, field)
+			case "name":
+				return ec.fieldContext_GrantMilestone_name(ctx, field)
+			case "shares":
+				return ec.fieldContext_GrantMilestone_shares(ctx, field)
+			case "condition":
+				return ec.fieldContext_GrantMilestone_condition(ctx, field)
+			case "achievedDate":
+				return ec.fieldContext_GrantMilestone_achievedDate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_GrantMilestone_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type GrantMilestone", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_recordMilestoneAchieved_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteAccrual_noteID(ctx context.Context, field graphql.CollectedField, obj *model.NoteAccrual) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteAccrual_noteID,
+		func(ctx context.Context) (any, error) {
+			return obj.NoteID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteAccrual_noteID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteAccrual",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteAccrual_asOfDate(ctx context.Context, field graphql.CollectedField, obj *model.NoteAccrual) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteAccrual_asOfDate,
+		func(ctx context.Context) (any, error) {
+			return obj.AsOfDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteAccrual_asOfDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteAccrual",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteAccrual_principal(ctx context.Context, field graphql.CollectedField, obj *model.NoteAccrual) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteAccrual_principal,
+		func(ctx context.Context) (any, error) {
+			return obj.Principal, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteAccrual_principal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteAccrual",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteAccrual_accruedInterest(ctx context.Context, field graphql.CollectedField, obj *model.NoteAccrual) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteAccrual_accruedInterest,
+		func(ctx context.Context) (any, error) {
+			return obj.AccruedInterest, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteAccrual_accruedInterest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteAccrual",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteAccrual_balance(ctx context.Context, field graphql.CollectedField, obj *model.NoteAccrual) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteAccrual_balance,
+		func(ctx context.Context) (any, error) {
+			return obj.Balance, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteAccrual_balance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteAccrual",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_noteID(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_noteID,
+		func(ctx context.Context) (any, error) {
+			return obj.NoteID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_noteID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_date(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_date,
+		func(ctx context.Context) (any, error) {
+			return obj.Date, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_principal(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_principal,
+		func(ctx context.Context) (any, error) {
+			return obj.Principal, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_principal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_accruedInterest(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_accruedInterest,
+		func(ctx context.Context) (any, error) {
+			return obj.AccruedInterest, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_accruedInterest(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_sharesIssued(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_sharesIssued,
+		func(ctx context.Context) (any, error) {
+			return obj.SharesIssued, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_sharesIssued(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_effectivePPS(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_effectivePPS,
+		func(ctx context.Context) (any, error) {
+			return obj.EffectivePps, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_effectivePPS(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_repaymentAmount(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_repaymentAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.RepaymentAmount, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_repaymentAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_method(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_method,
+		func(ctx context.Context) (any, error) {
+			return obj.Method, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_method(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NoteSettlement_grantID(ctx context.Context, field graphql.CollectedField, obj *model.NoteSettlement) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_NoteSettlement_grantID,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_NoteSettlement_grantID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NoteSettlement",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_id(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_companyID(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_shareClassID(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_shareClassID,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClassID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_shareClassID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_name(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_reservedShares(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_reservedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ReservedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_reservedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_grantedShares(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_grantedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_grantedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_availableShares(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_availableShares,
+		func(ctx context.Context) (any, error) {
+			return obj.AvailableShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_availableShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OptionPool_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.OptionPool) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OptionPool_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OptionPool_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OptionPool",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OwnershipTrajectory_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.OwnershipTrajectory) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OwnershipTrajectory_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OwnershipTrajectory_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OwnershipTrajectory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OwnershipTrajectory_stakeholderName(ctx context.Context, field graphql.CollectedField, obj *model.OwnershipTrajectory) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OwnershipTrajectory_stakeholderName,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OwnershipTrajectory_stakeholderName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OwnershipTrajectory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OwnershipTrajectory_ownershipPct(ctx context.Context, field graphql.CollectedField, obj *model.OwnershipTrajectory) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OwnershipTrajectory_ownershipPct,
+		func(ctx context.Context) (any, error) {
+			return obj.OwnershipPct, nil
+		},
+		nil,
+		ec.marshalNDecimal2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimalᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OwnershipTrajectory_ownershipPct(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OwnershipTrajectory",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_company(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_company,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Company(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOCompany2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_company(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Company_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Company_name(ctx, field)
+			case "stakeholders":
+				return ec.fieldContext_Company_stakeholders(ctx, field)
+			case "shareClasses":
+				return ec.fieldContext_Company_shareClasses(ctx, field)
+			case "grants":
+				return ec.fieldContext_Company_grants(ctx, field)
+			case "fundingRounds":
+				return ec.fieldContext_Company_fundingRounds(ctx, field)
+			case "safeNotes":
+				return ec.fieldContext_Company_safeNotes(ctx, field)
+			case "convertibleNotes":
+				return ec.fieldContext_Company_convertibleNotes(ctx, field)
+			case "optionPools":
+				return ec.fieldContext_Company_optionPools(ctx, field)
+			case "vestingSchedules":
+				return ec.fieldContext_Company_vestingSchedules(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Company_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Company", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_company_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_stakeholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_stakeholder,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Stakeholder(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOStakeholder2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_stakeholder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Stakeholder_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Stakeholder_companyID(ctx, field)
+			case "name":
+				return ec.fieldContext_Stakeholder_name(ctx, field)
+			case "email":
+				return ec.fieldContext_Stakeholder_email(ctx, field)
+			case "role":
+				return ec.fieldContext_Stakeholder_role(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Stakeholder_terminationDate(ctx, field)
+			case "terminationReason":
+				return ec.fieldContext_Stakeholder_terminationReason(ctx, field)
+			case "grants":
+				return ec.fieldContext_Stakeholder_grants(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Stakeholder_leaves(ctx, field)
+			case "mfnNotices":
+				return ec.fieldContext_Stakeholder_mfnNotices(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Stakeholder_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stakeholder", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_stakeholder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_vestingStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_vestingStatus,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().VestingStatus(ctx, fc.Args["grantID"].(string), fc.Args["asOfDate"].(model.Date))
+		},
+		nil,
+		ec.marshalNVestingStatus2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_vestingStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "grantID":
+				return ec.fieldContext_VestingStatus_grantID(ctx, field)
+			case "asOfDate":
+				return ec.fieldContext_VestingStatus_asOfDate(ctx, field)
+			case "totalShares":
+				return ec.fieldContext_VestingStatus_totalShares(ctx, field)
+			case "vestedShares":
+				return ec.fieldContext_VestingStatus_vestedShares(ctx, field)
+			case "unvestedShares":
+				return ec.fieldContext_VestingStatus_unvestedShares(ctx, field)
+			case "percentVested":
+				return ec.fieldContext_VestingStatus_percentVested(ctx, field)
+			case "cliffDate":
+				return ec.fieldContext_VestingStatus_cliffDate(ctx, field)
+			case "fullyVestedAt":
+				return ec.fieldContext_VestingStatus_fullyVestedAt(ctx, field)
+			case "isFullyVested":
+				return ec.fieldContext_VestingStatus_isFullyVested(ctx, field)
+			case "acceleratedShares":
+				return ec.fieldContext_VestingStatus_acceleratedShares(ctx, field)
+			case "exercisedShares":
+				return ec.fieldContext_VestingStatus_exercisedShares(ctx, field)
+			case "releasedShares":
+				return ec.fieldContext_VestingStatus_releasedShares(ctx, field)
+			case "repurchasableShares":
+				return ec.fieldContext_VestingStatus_repurchasableShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_vestingStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_vestingStatusWithEvents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_vestingStatusWithEvents,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().VestingStatusWithEvents(ctx, fc.Args["grantID"].(string), fc.Args["asOfDate"].(model.Date), fc.Args["changeOfControlDate"].(*model.Date), fc.Args["terminationDate"].(*model.Date))
+		},
+		nil,
+		ec.marshalNVestingStatus2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_vestingStatusWithEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "grantID":
+				return ec.fieldContext_VestingStatus_grantID(ctx, field)
+			case "asOfDate":
+				return ec.fieldContext_VestingStatus_asOfDate(ctx, field)
+			case "totalShares":
+				return ec.fieldContext_VestingStatus_totalShares(ctx, field)
+			case "vestedShares":
+				return ec.fieldContext_VestingStatus_vestedShares(ctx, field)
+			case "unvestedShares":
+				return ec.fieldContext_VestingStatus_unvestedShares(ctx, field)
+			case "percentVested":
+				return ec.fieldContext_VestingStatus_percentVested(ctx, field)
+			case "cliffDate":
+				return ec.fieldContext_VestingStatus_cliffDate(ctx, field)
+			case "fullyVestedAt":
+				return ec.fieldContext_VestingStatus_fullyVestedAt(ctx, field)
+			case "isFullyVested":
+				return ec.fieldContext_VestingStatus_isFullyVested(ctx, field)
+			case "acceleratedShares":
+				return ec.fieldContext_VestingStatus_acceleratedShares(ctx, field)
+			case "exercisedShares":
+				return ec.fieldContext_VestingStatus_exercisedShares(ctx, field)
+			case "releasedShares":
+				return ec.fieldContext_VestingStatus_releasedShares(ctx, field)
+			case "repurchasableShares":
+				return ec.fieldContext_VestingStatus_repurchasableShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_vestingStatusWithEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_vestingSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_vestingSchedule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().VestingSchedule(ctx, fc.Args["grantID"].(string))
+		},
+		nil,
+		ec.marshalNVestEvent2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestEventᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_vestingSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "date":
+				return ec.fieldContext_VestEvent_date(ctx, field)
+			case "shares":
+				return ec.fieldContext_VestEvent_shares(ctx, field)
+			case "cumulativeVested":
+				return ec.fieldContext_VestEvent_cumulativeVested(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestEvent", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_vestingSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_vestingForecast(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_vestingForecast,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().VestingForecast(ctx, fc.Args["companyID"].(string), fc.Args["from"].(model.Date), fc.Args["to"].(model.Date), fc.Args["bucket"].(*model.ForecastBucket))
+		},
+		nil,
+		ec.marshalNVestingForecastPeriod2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastPeriodᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_vestingForecast(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "periodStart":
+				return ec.fieldContext_VestingForecastPeriod_periodStart(ctx, field)
+			case "periodEnd":
+				return ec.fieldContext_VestingForecastPeriod_periodEnd(ctx, field)
+			case "vestedShares":
+				return ec.fieldContext_VestingForecastPeriod_vestedShares(ctx, field)
+			case "breakdown":
+				return ec.fieldContext_VestingForecastPeriod_breakdown(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingForecastPeriod", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_vestingForecast_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_capTable(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_capTable,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().CapTable(ctx, fc.Args["companyID"].(string), fc.Args["asOfDate"].(*model.Date), fc.Args["basis"].(*model.CapTableBasis))
+		},
+		nil,
+		ec.marshalNCapTableSnapshot2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_capTable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "companyID":
+				return ec.fieldContext_CapTableSnapshot_companyID(ctx, field)
+			case "totalShares":
+				return ec.fieldContext_CapTableSnapshot_totalShares(ctx, field)
+			case "entries":
+				return ec.fieldContext_CapTableSnapshot_entries(ctx, field)
+			case "basis":
+				return ec.fieldContext_CapTableSnapshot_basis(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_CapTableSnapshot_issuedShares(ctx, field)
+			case "optionShares":
+				return ec.fieldContext_CapTableSnapshot_optionShares(ctx, field)
+			case "convertibleShares":
+				return ec.fieldContext_CapTableSnapshot_convertibleShares(ctx, field)
+			case "poolShares":
+				return ec.fieldContext_CapTableSnapshot_poolShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CapTableSnapshot", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_capTable_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_modelDilution(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_modelDilution,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ModelDilution(ctx, fc.Args["input"].(model.DilutionModelInput))
+		},
+		nil,
+		ec.marshalNDilutionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_modelDilution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "preRound":
+				return ec.fieldContext_DilutionResult_preRound(ctx, field)
+			case "postRound":
+				return ec.fieldContext_DilutionResult_postRound(ctx, field)
+			case "newInvestor":
+				return ec.fieldContext_DilutionResult_newInvestor(ctx, field)
+			case "roundName":
+				return ec.fieldContext_DilutionResult_roundName(ctx, field)
+			case "investors":
+				return ec.fieldContext_DilutionResult_investors(ctx, field)
+			case "allocatedAmount":
+				return ec.fieldContext_DilutionResult_allocatedAmount(ctx, field)
+			case "allocationMismatch":
+				return ec.fieldContext_DilutionResult_allocationMismatch(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_DilutionResult_effectivePPS(ctx, field)
+			case "poolIncrease":
+				return ec.fieldContext_DilutionResult_poolIncrease(ctx, field)
+			case "poolDilutionPct":
+				return ec.fieldContext_DilutionResult_poolDilutionPct(ctx, field)
+			case "newMoneyDilutionPct":
+				return ec.fieldContext_DilutionResult_newMoneyDilutionPct(ctx, field)
+			case "conversions":
+				return ec.fieldContext_DilutionResult_conversions(ctx, field)
+			case "conversionDilutionPct":
+				return ec.fieldContext_DilutionResult_conversionDilutionPct(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DilutionResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_modelDilution_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_modelRoundSequence(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_modelRoundSequence,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ModelRoundSequence(ctx, fc.Args["companyID"].(string), fc.Args["rounds"].([]*model.ModeledRoundInput))
+		},
+		nil,
+		ec.marshalNRoundSequenceResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundSequenceResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_modelRoundSequence(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "steps":
+				return ec.fieldContext_RoundSequenceResult_steps(ctx, field)
+			case "trajectories":
+				return ec.fieldContext_RoundSequenceResult_trajectories(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RoundSequenceResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_modelRoundSequence_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_previewSAFEConversions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_previewSAFEConversions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PreviewSAFEConversions(ctx, fc.Args["companyID"].(string), fc.Args["scenarios"].([]*model.SAFEConversionScenarioInput))
+		},
+		nil,
+		ec.marshalNSAFEConversionPreview2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionPreviewᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_previewSAFEConversions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "scenarioName":
+				return ec.fieldContext_SAFEConversionPreview_scenarioName(ctx, field)
+			case "preMoneyValuation":
+				return ec.fieldContext_SAFEConversionPreview_preMoneyValuation(ctx, field)
+			case "amountRaised":
+				return ec.fieldContext_SAFEConversionPreview_amountRaised(ctx, field)
+			case "pricePerShare":
+				return ec.fieldContext_SAFEConversionPreview_pricePerShare(ctx, field)
+			case "postMoneyShares":
+				return ec.fieldContext_SAFEConversionPreview_postMoneyShares(ctx, field)
+			case "conversions":
+				return ec.fieldContext_SAFEConversionPreview_conversions(ctx, field)
+			case "holders":
+				return ec.fieldContext_SAFEConversionPreview_holders(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFEConversionPreview", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_previewSAFEConversions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_noteAccrual(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_noteAccrual,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().NoteAccrual(ctx, fc.Args["noteID"].(string), fc.Args["asOfDate"].(model.Date))
+		},
+		nil,
+		ec.marshalNNoteAccrual2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteAccrual,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_noteAccrual(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "noteID":
+				return ec.fieldContext_NoteAccrual_noteID(ctx, field)
+			case "asOfDate":
+				return ec.fieldContext_NoteAccrual_asOfDate(ctx, field)
+			case "principal":
+				return ec.fieldContext_NoteAccrual_principal(ctx, field)
+			case "accruedInterest":
+				return ec.fieldContext_NoteAccrual_accruedInterest(ctx, field)
+			case "balance":
+				return ec.fieldContext_NoteAccrual_balance(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NoteAccrual", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_noteAccrual_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_waterfall(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_waterfall,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Waterfall(ctx, fc.Args["companyID"].(string), fc.Args["exitValuation"].(model.Decimal), fc.Args["asOfDate"].(*model.Date))
+		},
+		nil,
+		ec.marshalNWaterfallResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallResult,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_waterfall(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "exitValuation":
+				return ec.fieldContext_WaterfallResult_exitValuation(ctx, field)
+			case "totalPayout":
+				return ec.fieldContext_WaterfallResult_totalPayout(ctx, field)
+			case "payouts":
+				return ec.fieldContext_WaterfallResult_payouts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaterfallResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_waterfall_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundSequenceResult_steps(ctx context.Context, field graphql.CollectedField, obj *model.RoundSequenceResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundSequenceResult_steps,
+		func(ctx context.Context) (any, error) {
+			return obj.Steps, nil
+		},
+		nil,
+		ec.marshalNDilutionResult2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResultᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundSequenceResult_steps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundSequenceResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "preRound":
+				return ec.fieldContext_DilutionResult_preRound(ctx, field)
+			case "postRound":
+				return ec.fieldContext_DilutionResult_postRound(ctx, field)
+			case "newInvestor":
+				return ec.fieldContext_DilutionResult_newInvestor(ctx, field)
+			case "roundName":
+				return ec.fieldContext_DilutionResult_roundName(ctx, field)
+			case "investors":
+				return ec.fieldContext_DilutionResult_investors(ctx, field)
+			case "allocatedAmount":
+				return ec.fieldContext_DilutionResult_allocatedAmount(ctx, field)
+			case "allocationMismatch":
+				return ec.fieldContext_DilutionResult_allocationMismatch(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_DilutionResult_effectivePPS(ctx, field)
+			case "poolIncrease":
+				return ec.fieldContext_DilutionResult_poolIncrease(ctx, field)
+			case "poolDilutionPct":
+				return ec.fieldContext_DilutionResult_poolDilutionPct(ctx, field)
+			case "newMoneyDilutionPct":
+				return ec.fieldContext_DilutionResult_newMoneyDilutionPct(ctx, field)
+			case "conversions":
+				return ec.fieldContext_DilutionResult_conversions(ctx, field)
+			case "conversionDilutionPct":
+				return ec.fieldContext_DilutionResult_conversionDilutionPct(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DilutionResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundSequenceResult_trajectories(ctx context.Context, field graphql.CollectedField, obj *model.RoundSequenceResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundSequenceResult_trajectories,
+		func(ctx context.Context) (any, error) {
+			return obj.Trajectories, nil
+		},
+		nil,
+		ec.marshalNOwnershipTrajectory2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOwnershipTrajectoryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundSequenceResult_trajectories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundSequenceResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stakeholderID":
+				return ec.fieldContext_OwnershipTrajectory_stakeholderID(ctx, field)
+			case "stakeholderName":
+				return ec.fieldContext_OwnershipTrajectory_stakeholderName(ctx, field)
+			case "ownershipPct":
+				return ec.fieldContext_OwnershipTrajectory_ownershipPct(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OwnershipTrajectory", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionPreview_scenarioName(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionPreview_scenarioName,
+		func(ctx context.Context) (any, error) {
+			return obj.ScenarioName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionPreview_scenarioName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionPreview_preMoneyValuation(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionPreview_preMoneyValuation,
+		func(ctx context.Context) (any, error) {
+			return obj.PreMoneyValuation, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionPreview_preMoneyValuation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionPreview_amountRaised(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionPreview_amountRaised,
+		func(ctx context.Context) (any, error) {
+			return obj.AmountRaised, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionPreview_amountRaised(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionPreview_pricePerShare(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionPreview_pricePerShare,
+		func(ctx context.Context) (any, error) {
+			return obj.PricePerShare, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionPreview_pricePerShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionPreview_postMoneyShares(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionPreview_postMoneyShares,
+		func(ctx context.Context) (any, error) {
+			return obj.PostMoneyShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionPreview_postMoneyShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionPreview_conversions(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionPreview_conversions,
+		func(ctx context.Context) (any, error) {
+			return obj.Conversions, nil
+		},
+		nil,
+		ec.marshalNSAFEConversionResult2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResultᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionPreview_conversions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "safeID":
+				return ec.fieldContext_SAFEConversionResult_safeID(ctx, field)
+			case "sharesIssued":
+				return ec.fieldContext_SAFEConversionResult_sharesIssued(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_SAFEConversionResult_effectivePPS(ctx, field)
+			case "conversionMethod":
+				return ec.fieldContext_SAFEConversionResult_conversionMethod(ctx, field)
+			case "grantID":
+				return ec.fieldContext_SAFEConversionResult_grantID(ctx, field)
+			case "inheritedTermsFrom":
+				return ec.fieldContext_SAFEConversionResult_inheritedTermsFrom(ctx, field)
+			case "capitalization":
+				return ec.fieldContext_SAFEConversionResult_capitalization(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFEConversionResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionPreview_holders(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionPreview) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionPreview_holders,
+		func(ctx context.Context) (any, error) {
+			return obj.Holders, nil
+		},
+		nil,
+		ec.marshalNSAFEHolderOwnership2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEHolderOwnershipᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionPreview_holders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionPreview",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "stakeholderID":
+				return ec.fieldContext_SAFEHolderOwnership_stakeholderID(ctx, field)
+			case "stakeholderName":
+				return ec.fieldContext_SAFEHolderOwnership_stakeholderName(ctx, field)
+			case "existingShares":
+				return ec.fieldContext_SAFEHolderOwnership_existingShares(ctx, field)
+			case "conversionShares":
+				return ec.fieldContext_SAFEHolderOwnership_conversionShares(ctx, field)
+			case "ownershipPct":
+				return ec.fieldContext_SAFEHolderOwnership_ownershipPct(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFEHolderOwnership", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionResult_safeID(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionResult_safeID,
+		func(ctx context.Context) (any, error) {
+			return obj.SafeID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionResult_safeID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionResult_sharesIssued(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionResult_sharesIssued,
+		func(ctx context.Context) (any, error) {
+			return obj.SharesIssued, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionResult_sharesIssued(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionResult_effectivePPS(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionResult_effectivePPS,
+		func(ctx context.Context) (any, error) {
+			return obj.EffectivePps, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionResult_effectivePPS(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionResult_conversionMethod(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionResult_conversionMethod,
+		func(ctx context.Context) (any, error) {
+			return obj.ConversionMethod, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionResult_conversionMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionResult_grantID(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionResult_grantID,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionResult_grantID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionResult_inheritedTermsFrom(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionResult_inheritedTermsFrom,
+		func(ctx context.Context) (any, error) {
+			return obj.InheritedTermsFrom, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionResult_inheritedTermsFrom(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEConversionResult_capitalization(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEConversionResult_capitalization,
+		func(ctx context.Context) (any, error) {
+			return obj.Capitalization, nil
+		},
+		nil,
+		ec.marshalOCapitalization2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalization,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEConversionResult_capitalization(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEConversionResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "definition":
+				return ec.fieldContext_Capitalization_definition(ctx, field)
+			case "issuedShares":
+				return ec.fieldContext_Capitalization_issuedShares(ctx, field)
+			case "issuedOptions":
+				return ec.fieldContext_Capitalization_issuedOptions(ctx, field)
+			case "promisedOptions":
+				return ec.fieldContext_Capitalization_promisedOptions(ctx, field)
+			case "unissuedPool":
+				return ec.fieldContext_Capitalization_unissuedPool(ctx, field)
+			case "poolIncrease":
+				return ec.fieldContext_Capitalization_poolIncrease(ctx, field)
+			case "total":
+				return ec.fieldContext_Capitalization_total(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Capitalization", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEHolderOwnership_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.SAFEHolderOwnership) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEHolderOwnership_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEHolderOwnership_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEHolderOwnership",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEHolderOwnership_stakeholderName(ctx context.Context, field graphql.CollectedField, obj *model.SAFEHolderOwnership) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEHolderOwnership_stakeholderName,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEHolderOwnership_stakeholderName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEHolderOwnership",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEHolderOwnership_existingShares(ctx context.Context, field graphql.CollectedField, obj *model.SAFEHolderOwnership) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEHolderOwnership_existingShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ExistingShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEHolderOwnership_existingShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEHolderOwnership",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEHolderOwnership_conversionShares(ctx context.Context, field graphql.CollectedField, obj *model.SAFEHolderOwnership) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEHolderOwnership_conversionShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ConversionShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEHolderOwnership_conversionShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEHolderOwnership",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFEHolderOwnership_ownershipPct(ctx context.Context, field graphql.CollectedField, obj *model.SAFEHolderOwnership) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFEHolderOwnership_ownershipPct,
+		func(ctx context.Context) (any, error) {
+			return obj.OwnershipPct, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFEHolderOwnership_ownershipPct(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFEHolderOwnership",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_id(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_companyID(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_investmentAmount(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_investmentAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.InvestmentAmount, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_investmentAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_valuationCap(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_valuationCap,
+		func(ctx context.Context) (any, error) {
+			return obj.ValuationCap, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_valuationCap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_discountRate(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_discountRate,
+		func(ctx context.Context) (any, error) {
+			return obj.DiscountRate, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_discountRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_safeType(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_safeType,
+		func(ctx context.Context) (any, error) {
+			return obj.SafeType, nil
+		},
+		nil,
+		ec.marshalNSAFEType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_safeType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SAFEType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_isMFN(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_isMFN,
+		func(ctx context.Context) (any, error) {
+			return obj.IsMfn, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_isMFN(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_capitalizationDefinition(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_capitalizationDefinition,
+		func(ctx context.Context) (any, error) {
+			return obj.CapitalizationDefinition, nil
+		},
+		nil,
+		ec.marshalNCapitalizationDefinition2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalizationDefinition,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_capitalizationDefinition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type CapitalizationDefinition does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_isConverted(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_isConverted,
+		func(ctx context.Context) (any, error) {
+			return obj.IsConverted, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_isConverted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_convertedInRound(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_convertedInRound,
+		func(ctx context.Context) (any, error) {
+			return obj.ConvertedInRound, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_convertedInRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_conversion(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_conversion,
+		func(ctx context.Context) (any, error) {
+			return obj.Conversion, nil
+		},
+		nil,
+		ec.marshalOSAFEConversionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_conversion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "safeID":
+				return ec.fieldContext_SAFEConversionResult_safeID(ctx, field)
+			case "sharesIssued":
+				return ec.fieldContext_SAFEConversionResult_sharesIssued(ctx, field)
+			case "effectivePPS":
+				return ec.fieldContext_SAFEConversionResult_effectivePPS(ctx, field)
+			case "conversionMethod":
+				return ec.fieldContext_SAFEConversionResult_conversionMethod(ctx, field)
+			case "grantID":
+				return ec.fieldContext_SAFEConversionResult_grantID(ctx, field)
+			case "inheritedTermsFrom":
+				return ec.fieldContext_SAFEConversionResult_inheritedTermsFrom(ctx, field)
+			case "capitalization":
+				return ec.fieldContext_SAFEConversionResult_capitalization(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SAFEConversionResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_issueDate(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_issueDate,
+		func(ctx context.Context) (any, error) {
+			return obj.IssueDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_issueDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SAFENote_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SAFENote_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SAFENote_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SAFENote",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_id(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_companyID(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_name(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_isPreferred(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_isPreferred,
+		func(ctx context.Context) (any, error) {
+			return obj.IsPreferred, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_isPreferred(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_liquidationMultiple(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_liquidationMultiple,
+		func(ctx context.Context) (any, error) {
+			return obj.LiquidationMultiple, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_liquidationMultiple(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_isParticipating(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_isParticipating,
+		func(ctx context.Context) (any, error) {
+			return obj.IsParticipating, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_isParticipating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_participationCap(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_participationCap,
+		func(ctx context.Context) (any, error) {
+			return obj.ParticipationCap, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_participationCap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_pricePerShare(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_pricePerShare,
+		func(ctx context.Context) (any, error) {
+			return obj.PricePerShare, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_pricePerShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_seniority(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_seniority,
+		func(ctx context.Context) (any, error) {
+			return obj.Seniority, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_seniority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_authorizedShares(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_authorizedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.AuthorizedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_authorizedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_parentShareClassID(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_parentShareClassID,
+		func(ctx context.Context) (any, error) {
+			return obj.ParentShareClassID, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_parentShareClassID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShareClass_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShareClass_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShareClass_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShareClass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_id(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_companyID(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_name(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_email(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_role(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_role,
+		func(ctx context.Context) (any, error) {
+			return obj.Role, nil
+		},
+		nil,
+		ec.marshalNStakeholderRole2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type StakeholderRole does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_terminationDate(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_terminationDate,
+		func(ctx context.Context) (any, error) {
+			return obj.TerminationDate, nil
+		},
+		nil,
+		ec.marshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_terminationDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_terminationReason(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_terminationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.TerminationReason, nil
+		},
+		nil,
+		ec.marshalOTerminationReason2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminationReason,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_terminationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TerminationReason does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_grants(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_grants,
+		func(ctx context.Context) (any, error) {
+			return obj.Grants, nil
+		},
+		nil,
+		ec.marshalNGrant2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_grants(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Grant_id(ctx, field)
+			case "companyID":
+				return ec.fieldContext_Grant_companyID(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_Grant_stakeholderID(ctx, field)
+			case "shareClassID":
+				return ec.fieldContext_Grant_shareClassID(ctx, field)
+			case "vestingScheduleID":
+				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
+			case "optionPoolID":
+				return ec.fieldContext_Grant_optionPoolID(ctx, field)
+			case "type":
+				return ec.fieldContext_Grant_type(ctx, field)
+			case "quantity":
+				return ec.fieldContext_Grant_quantity(ctx, field)
+			case "grantDate":
+				return ec.fieldContext_Grant_grantDate(ctx, field)
+			case "vestingCommencementDate":
+				return ec.fieldContext_Grant_vestingCommencementDate(ctx, field)
+			case "exercisePrice":
+				return ec.fieldContext_Grant_exercisePrice(ctx, field)
+			case "isExercised":
+				return ec.fieldContext_Grant_isExercised(ctx, field)
+			case "earlyExerciseAllowed":
+				return ec.fieldContext_Grant_earlyExerciseAllowed(ctx, field)
+			case "notes":
+				return ec.fieldContext_Grant_notes(ctx, field)
+			case "terminationDate":
+				return ec.fieldContext_Grant_terminationDate(ctx, field)
+			case "forfeitedQuantity":
+				return ec.fieldContext_Grant_forfeitedQuantity(ctx, field)
+			case "exerciseDeadline":
+				return ec.fieldContext_Grant_exerciseDeadline(ctx, field)
+			case "expiredQuantity":
+				return ec.fieldContext_Grant_expiredQuantity(ctx, field)
+			case "repurchasePrice":
+				return ec.fieldContext_Grant_repurchasePrice(ctx, field)
+			case "repurchasedQuantity":
+				return ec.fieldContext_Grant_repurchasedQuantity(ctx, field)
+			case "repurchaseDate":
+				return ec.fieldContext_Grant_repurchaseDate(ctx, field)
+			case "vestingSchedule":
+				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
+			case "milestones":
+				return ec.fieldContext_Grant_milestones(ctx, field)
+			case "leaves":
+				return ec.fieldContext_Grant_leaves(ctx, field)
+			case "exercises":
+				return ec.fieldContext_Grant_exercises(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Grant_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_leaves(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_leaves,
+		func(ctx context.Context) (any, error) {
+			return obj.Leaves, nil
+		},
+		nil,
+		ec.marshalNLeaveOfAbsence2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐLeaveOfAbsenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_leaves(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LeaveOfAbsence_id(ctx, field)
+			case "stakeholderID":
+				return ec.fieldContext_LeaveOfAbsence_stakeholderID(ctx, field)
+			case "grantID":
+				return ec.fieldContext_LeaveOfAbsence_grantID(ctx, field)
+			case "startDate":
+				return ec.fieldContext_LeaveOfAbsence_startDate(ctx, field)
+			case "endDate":
+				return ec.fieldContext_LeaveOfAbsence_endDate(ctx, field)
+			case "isPaid":
+				return ec.fieldContext_LeaveOfAbsence_isPaid(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LeaveOfAbsence_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LeaveOfAbsence", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_mfnNotices(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_mfnNotices,
+		func(ctx context.Context) (any, error) {
+			return obj.MfnNotices, nil
+		},
+		nil,
+		ec.marshalNMFNNotice2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMFNNoticeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_mfnNotices(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MFNNotice_id(ctx, field)
+			case "safeID":
+				return ec.fieldContext_MFNNotice_safeID(ctx, field)
+			case "sourceSafeID":
+				return ec.fieldContext_MFNNotice_sourceSafeID(ctx, field)
+			case "valuationCap":
+				return ec.fieldContext_MFNNotice_valuationCap(ctx, field)
+			case "discountRate":
+				return ec.fieldContext_MFNNotice_discountRate(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_MFNNotice_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MFNNotice", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stakeholder_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stakeholder_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stakeholder_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stakeholder",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestEvent_date(ctx context.Context, field graphql.CollectedField, obj *model.VestEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestEvent_date,
+		func(ctx context.Context) (any, error) {
+			return obj.Date, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestEvent_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestEvent_shares(ctx context.Context, field graphql.CollectedField, obj *model.VestEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestEvent_shares,
+		func(ctx context.Context) (any, error) {
+			return obj.Shares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestEvent_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestEvent_cumulativeVested(ctx context.Context, field graphql.CollectedField, obj *model.VestEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestEvent_cumulativeVested,
+		func(ctx context.Context) (any, error) {
+			return obj.CumulativeVested, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestEvent_cumulativeVested(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastLine_shareClassID(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastLine) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastLine_shareClassID,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClassID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastLine_shareClassID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastLine",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastLine_shareClassName(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastLine) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastLine_shareClassName,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClassName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastLine_shareClassName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastLine",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastLine_role(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastLine) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastLine_role,
+		func(ctx context.Context) (any, error) {
+			return obj.Role, nil
+		},
+		nil,
+		ec.marshalNStakeholderRole2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastLine_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastLine",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type StakeholderRole does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastLine_vestedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastLine) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastLine_vestedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.VestedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastLine_vestedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastLine",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastPeriod_periodStart(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastPeriod_periodStart,
+		func(ctx context.Context) (any, error) {
+			return obj.PeriodStart, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastPeriod_periodStart(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastPeriod_periodEnd(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastPeriod_periodEnd,
+		func(ctx context.Context) (any, error) {
+			return obj.PeriodEnd, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastPeriod_periodEnd(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastPeriod_vestedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastPeriod_vestedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.VestedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastPeriod_vestedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingForecastPeriod_breakdown(ctx context.Context, field graphql.CollectedField, obj *model.VestingForecastPeriod) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingForecastPeriod_breakdown,
+		func(ctx context.Context) (any, error) {
+			return obj.Breakdown, nil
+		},
+		nil,
+		ec.marshalNVestingForecastLine2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastLineᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingForecastPeriod_breakdown(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingForecastPeriod",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "shareClassID":
+				return ec.fieldContext_VestingForecastLine_shareClassID(ctx, field)
+			case "shareClassName":
+				return ec.fieldContext_VestingForecastLine_shareClassName(ctx, field)
+			case "role":
+				return ec.fieldContext_VestingForecastLine_role(ctx, field)
+			case "vestedShares":
+				return ec.fieldContext_VestingForecastLine_vestedShares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingForecastLine", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_id(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_companyID(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_companyID,
+		func(ctx context.Context) (any, error) {
+			return obj.CompanyID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_name(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_cliffMonths(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_cliffMonths,
+		func(ctx context.Context) (any, error) {
+			return obj.CliffMonths, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_cliffMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_totalMonths(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_totalMonths,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalMonths, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_totalMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_frequency(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_frequency,
+		func(ctx context.Context) (any, error) {
+			return obj.Frequency, nil
+		},
+		nil,
+		ec.marshalNVestingFrequency2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_frequency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type VestingFrequency does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_accelerationTrigger(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_accelerationTrigger,
+		func(ctx context.Context) (any, error) {
+			return obj.AccelerationTrigger, nil
+		},
+		nil,
+		ec.marshalNAccelerationTrigger2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_accelerationTrigger(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type AccelerationTrigger does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_accelerationPercent(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_accelerationPercent,
+		func(ctx context.Context) (any, error) {
+			return obj.AccelerationPercent, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_accelerationPercent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_accelerationMonths(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_accelerationMonths,
+		func(ctx context.Context) (any, error) {
+			return obj.AccelerationMonths, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_accelerationMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_accelerationWindowMonths(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_accelerationWindowMonths,
+		func(ctx context.Context) (any, error) {
+			return obj.AccelerationWindowMonths, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_accelerationWindowMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_tranches(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_tranches,
+		func(ctx context.Context) (any, error) {
+			return obj.Tranches, nil
+		},
+		nil,
+		ec.marshalNVestingTranche2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTrancheᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_tranches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "offsetMonths":
+				return ec.fieldContext_VestingTranche_offsetMonths(ctx, field)
+			case "percent":
+				return ec.fieldContext_VestingTranche_percent(ctx, field)
+			case "shares":
+				return ec.fieldContext_VestingTranche_shares(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VestingTranche", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_rounding(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_rounding,
+		func(ctx context.Context) (any, error) {
+			return obj.Rounding, nil
+		},
+		nil,
+		ec.marshalNVestingRounding2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingRounding,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_rounding(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type VestingRounding does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingSchedule_archivedAt(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingSchedule_archivedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ArchivedAt, nil
+		},
+		nil,
+		ec.marshalODateTime2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingSchedule_archivedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingSchedule",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DateTime does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_grantID(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_grantID,
+		func(ctx context.Context) (any, error) {
+			return obj.GrantID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_grantID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_asOfDate(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_asOfDate,
+		func(ctx context.Context) (any, error) {
+			return obj.AsOfDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_asOfDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_totalShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_totalShares,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_totalShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_vestedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_vestedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.VestedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_vestedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_unvestedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_unvestedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.UnvestedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_unvestedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_percentVested(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_percentVested,
+		func(ctx context.Context) (any, error) {
+			return obj.PercentVested, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_percentVested(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_cliffDate(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_cliffDate,
+		func(ctx context.Context) (any, error) {
+			return obj.CliffDate, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_cliffDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_fullyVestedAt(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_fullyVestedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.FullyVestedAt, nil
+		},
+		nil,
+		ec.marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_fullyVestedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Date does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_isFullyVested(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_isFullyVested,
+		func(ctx context.Context) (any, error) {
+			return obj.IsFullyVested, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_isFullyVested(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_acceleratedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_acceleratedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.AcceleratedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_acceleratedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Company_grants(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+func (ec *executionContext) _VestingStatus_exercisedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Company_grants,
+		ec.fieldContext_VestingStatus_exercisedShares,
 		func(ctx context.Context) (any, error) {
-			return obj.Grants, nil
+			return obj.ExercisedShares, nil
 		},
 		nil,
-		ec.marshalNGrant2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantᚄ,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Company_grants(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_VestingStatus_exercisedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Company",
+		Object:     "VestingStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Grant_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_Grant_companyID(ctx, field)
-			case "stakeholderID":
-				return ec.fieldContext_Grant_stakeholderID(ctx, field)
-			case "shareClassID":
-				return ec.fieldContext_Grant_shareClassID(ctx, field)
-			case "vestingScheduleID":
-				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
-			case "quantity":
-				return ec.fieldContext_Grant_quantity(ctx, field)
-			case "grantDate":
-				return ec.fieldContext_Grant_grantDate(ctx, field)
-			case "exercisePrice":
-				return ec.fieldContext_Grant_exercisePrice(ctx, field)
-			case "isExercised":
-				return ec.fieldContext_Grant_isExercised(ctx, field)
-			case "notes":
-				return ec.fieldContext_Grant_notes(ctx, field)
-			case "vestingSchedule":
-				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Grant_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Company_fundingRounds(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+func (ec *executionContext) _VestingStatus_releasedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_releasedShares,
+		func(ctx context.Context) (any, error) {
+			return obj.ReleasedShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_releasedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingStatus_repurchasableShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingStatus_repurchasableShares,
+		func(ctx context.Context) (any, error) {
+			return obj.RepurchasableShares, nil
+		},
+		nil,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingStatus_repurchasableShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingTranche_offsetMonths(ctx context.Context, field graphql.CollectedField, obj *model.VestingTranche) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingTranche_offsetMonths,
+		func(ctx context.Context) (any, error) {
+			return obj.OffsetMonths, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingTranche_offsetMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingTranche",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingTranche_percent(ctx context.Context, field graphql.CollectedField, obj *model.VestingTranche) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingTranche_percent,
+		func(ctx context.Context) (any, error) {
+			return obj.Percent, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingTranche_percent(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingTranche",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VestingTranche_shares(ctx context.Context, field graphql.CollectedField, obj *model.VestingTranche) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VestingTranche_shares,
+		func(ctx context.Context) (any, error) {
+			return obj.Shares, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_VestingTranche_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VestingTranche",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaterfallPayout_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaterfallPayout_stakeholderID,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaterfallPayout_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaterfallPayout",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaterfallPayout_stakeholderName(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaterfallPayout_stakeholderName,
+		func(ctx context.Context) (any, error) {
+			return obj.StakeholderName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaterfallPayout_stakeholderName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaterfallPayout",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaterfallPayout_shareClassName(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaterfallPayout_shareClassName,
+		func(ctx context.Context) (any, error) {
+			return obj.ShareClassName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaterfallPayout_shareClassName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaterfallPayout",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaterfallPayout_shares(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Company_fundingRounds,
+		ec.fieldContext_WaterfallPayout_shares,
 		func(ctx context.Context) (any, error) {
-			return obj.FundingRounds, nil
+			return obj.Shares, nil
 		},
 		nil,
-		ec.marshalNFundingRound2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRoundᚄ,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Company_fundingRounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_WaterfallPayout_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Company",
+		Object:     "WaterfallPayout",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_FundingRound_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_FundingRound_companyID(ctx, field)
-			case "name":
-				return ec.fieldContext_FundingRound_name(ctx, field)
-			case "preMoneyValuation":
-				return ec.fieldContext_FundingRound_preMoneyValuation(ctx, field)
-			case "amountRaised":
-				return ec.fieldContext_FundingRound_amountRaised(ctx, field)
-			case "pricePerShare":
-				return ec.fieldContext_FundingRound_pricePerShare(ctx, field)
-			case "shareClassID":
-				return ec.fieldContext_FundingRound_shareClassID(ctx, field)
-			case "roundDate":
-				return ec.fieldContext_FundingRound_roundDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_FundingRound_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FundingRound", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Company_safeNotes(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+func (ec *executionContext) _WaterfallPayout_payout(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Company_safeNotes,
+		ec.fieldContext_WaterfallPayout_payout,
 		func(ctx context.Context) (any, error) {
-			return obj.SafeNotes, nil
+			return obj.Payout, nil
 		},
 		nil,
-		ec.marshalNSAFENote2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENoteᚄ,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Company_safeNotes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_WaterfallPayout_payout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Company",
+		Object:     "WaterfallPayout",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_SAFENote_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_SAFENote_companyID(ctx, field)
-			case "stakeholderID":
-				return ec.fieldContext_SAFENote_stakeholderID(ctx, field)
-			case "investmentAmount":
-				return ec.fieldContext_SAFENote_investmentAmount(ctx, field)
-			case "valuationCap":
-				return ec.fieldContext_SAFENote_valuationCap(ctx, field)
-			case "discountRate":
-				return ec.fieldContext_SAFENote_discountRate(ctx, field)
-			case "safeType":
-				return ec.fieldContext_SAFENote_safeType(ctx, field)
-			case "isConverted":
-				return ec.fieldContext_SAFENote_isConverted(ctx, field)
-			case "convertedInRound":
-				return ec.fieldContext_SAFENote_convertedInRound(ctx, field)
-			case "issueDate":
-				return ec.fieldContext_SAFENote_issueDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_SAFENote_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SAFENote", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Company_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Company) (ret graphql.Marshaler) {
+func (ec *executionContext) _WaterfallPayout_payoutPerShare(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Company_createdAt,
+		ec.fieldContext_WaterfallPayout_payoutPerShare,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.PayoutPerShare, nil
 		},
 		nil,
-		ec.marshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Company_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_WaterfallPayout_payoutPerShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Company",
+		Object:     "WaterfallPayout",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DilutionResult_preRound(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _WaterfallPayout_claimElection(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DilutionResult_preRound,
+		ec.fieldContext_WaterfallPayout_claimElection,
 		func(ctx context.Context) (any, error) {
-			return obj.PreRound, nil
+			return obj.ClaimElection, nil
 		},
 		nil,
-		ec.marshalNCapTableSnapshot2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DilutionResult_preRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_WaterfallPayout_claimElection(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DilutionResult",
+		Object:     "WaterfallPayout",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "companyID":
-				return ec.fieldContext_CapTableSnapshot_companyID(ctx, field)
-			case "totalShares":
-				return ec.fieldContext_CapTableSnapshot_totalShares(ctx, field)
-			case "entries":
-				return ec.fieldContext_CapTableSnapshot_entries(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CapTableSnapshot", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DilutionResult_postRound(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _WaterfallResult_exitValuation(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DilutionResult_postRound,
+		ec.fieldContext_WaterfallResult_exitValuation,
 		func(ctx context.Context) (any, error) {
-			return obj.PostRound, nil
+			return obj.ExitValuation, nil
 		},
 		nil,
-		ec.marshalNCapTableSnapshot2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_DilutionResult_postRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_WaterfallResult_exitValuation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DilutionResult",
+		Object:     "WaterfallResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "companyID":
-				return ec.fieldContext_CapTableSnapshot_companyID(ctx, field)
-			case "totalShares":
-				return ec.fieldContext_CapTableSnapshot_totalShares(ctx, field)
-			case "entries":
-				return ec.fieldContext_CapTableSnapshot_entries(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CapTableSnapshot", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DilutionResult_newInvestor(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _WaterfallResult_totalPayout(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DilutionResult_newInvestor,
+		ec.fieldContext_WaterfallResult_totalPayout,
 		func(ctx context.Context) (any, error) {
-			return obj.NewInvestor, nil
+			return obj.TotalPayout, nil
 		},
 		nil,
-		ec.marshalNCapTableEntry2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntry,
+		ec.marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_DilutionResult_newInvestor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_WaterfallResult_totalPayout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DilutionResult",
+		Object:     "WaterfallResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "stakeholderID":
-				return ec.fieldContext_CapTableEntry_stakeholderID(ctx, field)
-			case "stakeholderName":
-				return ec.fieldContext_CapTableEntry_stakeholderName(ctx, field)
-			case "shareClassName":
-				return ec.fieldContext_CapTableEntry_shareClassName(ctx, field)
-			case "shares":
-				return ec.fieldContext_CapTableEntry_shares(ctx, field)
-			case "ownershipPct":
-				return ec.fieldContext_CapTableEntry_ownershipPct(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CapTableEntry", field.Name)
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DilutionResult_roundName(ctx context.Context, field graphql.CollectedField, obj *model.DilutionResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _WaterfallResult_payouts(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallResult) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DilutionResult_roundName,
+		ec.fieldContext_WaterfallResult_payouts,
 		func(ctx context.Context) (any, error) {
-			return obj.RoundName, nil
+			return obj.Payouts, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNWaterfallPayout2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayoutᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_DilutionResult_roundName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_WaterfallResult_payouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DilutionResult",
+		Object:     "WaterfallResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "stakeholderID":
+				return ec.fieldContext_WaterfallPayout_stakeholderID(ctx, field)
+			case "stakeholderName":
+				return ec.fieldContext_WaterfallPayout_stakeholderName(ctx, field)
+			case "shareClassName":
+				return ec.fieldContext_WaterfallPayout_shareClassName(ctx, field)
+			case "shares":
+				return ec.fieldContext_WaterfallPayout_shares(ctx, field)
+			case "payout":
+				return ec.fieldContext_WaterfallPayout_payout(ctx, field)
+			case "payoutPerShare":
+				return ec.fieldContext_WaterfallPayout_payoutPerShare(ctx, field)
+			case "claimElection":
+				return ec.fieldContext_WaterfallPayout_claimElection(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaterfallPayout", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_id(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_id,
+		ec.fieldContext___Directive_name,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__Directive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_companyID(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_companyID,
+		ec.fieldContext___Directive_description,
 		func(ctx context.Context) (any, error) {
-			return obj.CompanyID, nil
+			return obj.Description(), nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__Directive",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_name(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_name,
+		ec.fieldContext___Directive_isRepeatable,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.IsRepeatable, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__Directive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_preMoneyValuation(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_preMoneyValuation,
+		ec.fieldContext___Directive_locations,
 		func(ctx context.Context) (any, error) {
-			return obj.PreMoneyValuation, nil
+			return obj.Locations, nil
 		},
 		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_preMoneyValuation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__Directive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_amountRaised(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_amountRaised,
+		ec.fieldContext___Directive_args,
 		func(ctx context.Context) (any, error) {
-			return obj.AmountRaised, nil
+			return obj.Args, nil
 		},
 		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_amountRaised(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__Directive",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_pricePerShare(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_pricePerShare,
+		ec.fieldContext___EnumValue_name,
 		func(ctx context.Context) (any, error) {
-			return obj.PricePerShare, nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_pricePerShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__EnumValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_shareClassID(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_shareClassID,
+		ec.fieldContext___EnumValue_description,
 		func(ctx context.Context) (any, error) {
-			return obj.ShareClassID, nil
+			return obj.Description(), nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_shareClassID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__EnumValue",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_roundDate(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_roundDate,
+		ec.fieldContext___EnumValue_isDeprecated,
 		func(ctx context.Context) (any, error) {
-			return obj.RoundDate, nil
+			return obj.IsDeprecated(), nil
 		},
 		nil,
-		ec.marshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_roundDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__EnumValue",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FundingRound_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.FundingRound) (ret graphql.Marshaler) {
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_FundingRound_createdAt,
+		ec.fieldContext___EnumValue_deprecationReason,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.DeprecationReason(), nil
 		},
 		nil,
-		ec.marshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_FundingRound_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FundingRound",
+		Object:     "__EnumValue",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_id(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_id,
+		ec.fieldContext___Field_name,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__Field",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_companyID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_companyID,
+		ec.fieldContext___Field_description,
 		func(ctx context.Context) (any, error) {
-			return obj.CompanyID, nil
+			return obj.Description(), nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__Field",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_stakeholderID,
+		ec.fieldContext___Field_args,
 		func(ctx context.Context) (any, error) {
-			return obj.StakeholderID, nil
+			return obj.Args, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__Field",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_shareClassID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_shareClassID,
+		ec.fieldContext___Field_type,
 		func(ctx context.Context) (any, error) {
-			return obj.ShareClassID, nil
+			return obj.Type, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_shareClassID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__Field",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_vestingScheduleID(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_vestingScheduleID,
+		ec.fieldContext___Field_isDeprecated,
 		func(ctx context.Context) (any, error) {
-			return obj.VestingScheduleID, nil
+			return obj.IsDeprecated(), nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_vestingScheduleID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__Field",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_quantity(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_quantity,
+		ec.fieldContext___Field_deprecationReason,
 		func(ctx context.Context) (any, error) {
-			return obj.Quantity, nil
+			return obj.DeprecationReason(), nil
 		},
 		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__Field",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_grantDate(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_grantDate,
+		ec.fieldContext___InputValue_name,
 		func(ctx context.Context) (any, error) {
-			return obj.GrantDate, nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_grantDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__InputValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_exercisePrice(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_exercisePrice,
+		ec.fieldContext___InputValue_description,
 		func(ctx context.Context) (any, error) {
-			return obj.ExercisePrice, nil
+			return obj.Description(), nil
 		},
 		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_exercisePrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__InputValue",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_isExercised(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_isExercised,
+		ec.fieldContext___InputValue_type,
 		func(ctx context.Context) (any, error) {
-			return obj.IsExercised, nil
+			return obj.Type, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_isExercised(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__InputValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_notes(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_notes,
+		ec.fieldContext___InputValue_defaultValue,
 		func(ctx context.Context) (any, error) {
-			return obj.Notes, nil
+			return obj.DefaultValue, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2629,9 +14113,9 @@ func (ec *executionContext) _Grant_notes(ctx context.Context, field graphql.Coll
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_notes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__InputValue",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2642,654 +14126,501 @@ func (ec *executionContext) fieldContext_Grant_notes(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_vestingSchedule(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_vestingSchedule,
+		ec.fieldContext___InputValue_isDeprecated,
 		func(ctx context.Context) (any, error) {
-			return obj.VestingSchedule, nil
+			return obj.IsDeprecated(), nil
 		},
 		nil,
-		ec.marshalOVestingSchedule2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_vestingSchedule(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__InputValue",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_VestingSchedule_id(ctx, field)
-			case "cliffMonths":
-				return ec.fieldContext_VestingSchedule_cliffMonths(ctx, field)
-			case "totalMonths":
-				return ec.fieldContext_VestingSchedule_totalMonths(ctx, field)
-			case "frequency":
-				return ec.fieldContext_VestingSchedule_frequency(ctx, field)
-			case "accelerationTrigger":
-				return ec.fieldContext_VestingSchedule_accelerationTrigger(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VestingSchedule", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Grant_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Grant) (ret graphql.Marshaler) {
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Grant_createdAt,
+		ec.fieldContext___InputValue_deprecationReason,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.DeprecationReason(), nil
 		},
 		nil,
-		ec.marshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Grant_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Grant",
+		Object:     "__InputValue",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createCompany(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createCompany,
+		ec.fieldContext___Schema_description,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateCompany(ctx, fc.Args["input"].(model.CreateCompanyInput))
+			return obj.Description(), nil
 		},
 		nil,
-		ec.marshalNCompany2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createCompany(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Schema",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Company_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Company_name(ctx, field)
-			case "stakeholders":
-				return ec.fieldContext_Company_stakeholders(ctx, field)
-			case "shareClasses":
-				return ec.fieldContext_Company_shareClasses(ctx, field)
-			case "grants":
-				return ec.fieldContext_Company_grants(ctx, field)
-			case "fundingRounds":
-				return ec.fieldContext_Company_fundingRounds(ctx, field)
-			case "safeNotes":
-				return ec.fieldContext_Company_safeNotes(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Company_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Company", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createCompany_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addStakeholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_addStakeholder,
+		ec.fieldContext___Schema_types,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().AddStakeholder(ctx, fc.Args["input"].(model.AddStakeholderInput))
+			return obj.Types(), nil
 		},
 		nil,
-		ec.marshalNStakeholder2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addStakeholder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Schema",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stakeholder_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_Stakeholder_companyID(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
 			case "name":
-				return ec.fieldContext_Stakeholder_name(ctx, field)
-			case "email":
-				return ec.fieldContext_Stakeholder_email(ctx, field)
-			case "role":
-				return ec.fieldContext_Stakeholder_role(ctx, field)
-			case "grants":
-				return ec.fieldContext_Stakeholder_grants(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Stakeholder_createdAt(ctx, field)
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Stakeholder", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addStakeholder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createShareClass(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createShareClass,
+		ec.fieldContext___Schema_queryType,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateShareClass(ctx, fc.Args["input"].(model.CreateShareClassInput))
+			return obj.QueryType(), nil
 		},
 		nil,
-		ec.marshalNShareClass2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createShareClass(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Schema",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ShareClass_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_ShareClass_companyID(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
 			case "name":
-				return ec.fieldContext_ShareClass_name(ctx, field)
-			case "isPreferred":
-				return ec.fieldContext_ShareClass_isPreferred(ctx, field)
-			case "liquidationMultiple":
-				return ec.fieldContext_ShareClass_liquidationMultiple(ctx, field)
-			case "isParticipating":
-				return ec.fieldContext_ShareClass_isParticipating(ctx, field)
-			case "participationCap":
-				return ec.fieldContext_ShareClass_participationCap(ctx, field)
-			case "pricePerShare":
-				return ec.fieldContext_ShareClass_pricePerShare(ctx, field)
-			case "seniority":
-				return ec.fieldContext_ShareClass_seniority(ctx, field)
-			case "authorizedShares":
-				return ec.fieldContext_ShareClass_authorizedShares(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_ShareClass_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShareClass", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createShareClass_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createVestingSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createVestingSchedule,
+		ec.fieldContext___Schema_mutationType,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateVestingSchedule(ctx, fc.Args["input"].(model.CreateVestingScheduleInput))
+			return obj.MutationType(), nil
 		},
 		nil,
-		ec.marshalNVestingSchedule2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule,
-		true,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createVestingSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Schema",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_VestingSchedule_id(ctx, field)
-			case "cliffMonths":
-				return ec.fieldContext_VestingSchedule_cliffMonths(ctx, field)
-			case "totalMonths":
-				return ec.fieldContext_VestingSchedule_totalMonths(ctx, field)
-			case "frequency":
-				return ec.fieldContext_VestingSchedule_frequency(ctx, field)
-			case "accelerationTrigger":
-				return ec.fieldContext_VestingSchedule_accelerationTrigger(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type VestingSchedule", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createVestingSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_issueGrant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_issueGrant,
+		ec.fieldContext___Schema_subscriptionType,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().IssueGrant(ctx, fc.Args["input"].(model.IssueGrantInput))
+			return obj.SubscriptionType(), nil
 		},
 		nil,
-		ec.marshalNGrant2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant,
-		true,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_issueGrant(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Schema",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Grant_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_Grant_companyID(ctx, field)
-			case "stakeholderID":
-				return ec.fieldContext_Grant_stakeholderID(ctx, field)
-			case "shareClassID":
-				return ec.fieldContext_Grant_shareClassID(ctx, field)
-			case "vestingScheduleID":
-				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
-			case "quantity":
-				return ec.fieldContext_Grant_quantity(ctx, field)
-			case "grantDate":
-				return ec.fieldContext_Grant_grantDate(ctx, field)
-			case "exercisePrice":
-				return ec.fieldContext_Grant_exercisePrice(ctx, field)
-			case "isExercised":
-				return ec.fieldContext_Grant_isExercised(ctx, field)
-			case "notes":
-				return ec.fieldContext_Grant_notes(ctx, field)
-			case "vestingSchedule":
-				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Grant_createdAt(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_issueGrant_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_recordFundingRound(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_recordFundingRound,
+		ec.fieldContext___Schema_directives,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RecordFundingRound(ctx, fc.Args["input"].(model.RecordFundingRoundInput))
+			return obj.Directives(), nil
 		},
 		nil,
-		ec.marshalNFundingRound2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_recordFundingRound(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Schema",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_FundingRound_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_FundingRound_companyID(ctx, field)
 			case "name":
-				return ec.fieldContext_FundingRound_name(ctx, field)
-			case "preMoneyValuation":
-				return ec.fieldContext_FundingRound_preMoneyValuation(ctx, field)
-			case "amountRaised":
-				return ec.fieldContext_FundingRound_amountRaised(ctx, field)
-			case "pricePerShare":
-				return ec.fieldContext_FundingRound_pricePerShare(ctx, field)
-			case "shareClassID":
-				return ec.fieldContext_FundingRound_shareClassID(ctx, field)
-			case "roundDate":
-				return ec.fieldContext_FundingRound_roundDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_FundingRound_createdAt(ctx, field)
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type FundingRound", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_recordFundingRound_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_issueSAFE(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_issueSAFE,
+		ec.fieldContext___Type_kind,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().IssueSafe(ctx, fc.Args["input"].(model.IssueSAFEInput))
+			return obj.Kind(), nil
 		},
 		nil,
-		ec.marshalNSAFENote2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote,
+		ec.marshalN__TypeKind2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_issueSAFE(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_SAFENote_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_SAFENote_companyID(ctx, field)
-			case "stakeholderID":
-				return ec.fieldContext_SAFENote_stakeholderID(ctx, field)
-			case "investmentAmount":
-				return ec.fieldContext_SAFENote_investmentAmount(ctx, field)
-			case "valuationCap":
-				return ec.fieldContext_SAFENote_valuationCap(ctx, field)
-			case "discountRate":
-				return ec.fieldContext_SAFENote_discountRate(ctx, field)
-			case "safeType":
-				return ec.fieldContext_SAFENote_safeType(ctx, field)
-			case "isConverted":
-				return ec.fieldContext_SAFENote_isConverted(ctx, field)
-			case "convertedInRound":
-				return ec.fieldContext_SAFENote_convertedInRound(ctx, field)
-			case "issueDate":
-				return ec.fieldContext_SAFENote_issueDate(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_SAFENote_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SAFENote", field.Name)
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_issueSAFE_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_convertSAFE(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_convertSAFE,
+		ec.fieldContext___Type_description,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ConvertSafe(ctx, fc.Args["safeID"].(string), fc.Args["roundID"].(string))
+			return obj.Description(), nil
 		},
 		nil,
-		ec.marshalNSAFEConversionResult2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_convertSAFE(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "safeID":
-				return ec.fieldContext_SAFEConversionResult_safeID(ctx, field)
-			case "sharesIssued":
-				return ec.fieldContext_SAFEConversionResult_sharesIssued(ctx, field)
-			case "effectivePPS":
-				return ec.fieldContext_SAFEConversionResult_effectivePPS(ctx, field)
-			case "conversionMethod":
-				return ec.fieldContext_SAFEConversionResult_conversionMethod(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SAFEConversionResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_convertSAFE_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_company(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_company,
+		ec.fieldContext___Type_specifiedByURL,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Company(ctx, fc.Args["id"].(string))
+			return obj.SpecifiedByURL(), nil
 		},
 		nil,
-		ec.marshalOCompany2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_company(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Company_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Company_name(ctx, field)
-			case "stakeholders":
-				return ec.fieldContext_Company_stakeholders(ctx, field)
-			case "shareClasses":
-				return ec.fieldContext_Company_shareClasses(ctx, field)
-			case "grants":
-				return ec.fieldContext_Company_grants(ctx, field)
-			case "fundingRounds":
-				return ec.fieldContext_Company_fundingRounds(ctx, field)
-			case "safeNotes":
-				return ec.fieldContext_Company_safeNotes(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Company_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Company", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_company_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_stakeholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_stakeholder,
+		ec.fieldContext___Type_fields,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Stakeholder(ctx, fc.Args["id"].(string))
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
 		},
 		nil,
-		ec.marshalOStakeholder2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_stakeholder(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Stakeholder_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_Stakeholder_companyID(ctx, field)
 			case "name":
-				return ec.fieldContext_Stakeholder_name(ctx, field)
-			case "email":
-				return ec.fieldContext_Stakeholder_email(ctx, field)
-			case "role":
-				return ec.fieldContext_Stakeholder_role(ctx, field)
-			case "grants":
-				return ec.fieldContext_Stakeholder_grants(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Stakeholder_createdAt(ctx, field)
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Stakeholder", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
 		},
 	}
 	defer func() {
@@ -3299,158 +14630,154 @@ func (ec *executionContext) fieldContext_Query_stakeholder(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_stakeholder_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_vestingStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_vestingStatus,
+		ec.fieldContext___Type_interfaces,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().VestingStatus(ctx, fc.Args["grantID"].(string), fc.Args["asOfDate"].(model.Date))
+			return obj.Interfaces(), nil
 		},
 		nil,
-		ec.marshalNVestingStatus2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingStatus,
-		true,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_vestingStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "grantID":
-				return ec.fieldContext_VestingStatus_grantID(ctx, field)
-			case "asOfDate":
-				return ec.fieldContext_VestingStatus_asOfDate(ctx, field)
-			case "totalShares":
-				return ec.fieldContext_VestingStatus_totalShares(ctx, field)
-			case "vestedShares":
-				return ec.fieldContext_VestingStatus_vestedShares(ctx, field)
-			case "unvestedShares":
-				return ec.fieldContext_VestingStatus_unvestedShares(ctx, field)
-			case "percentVested":
-				return ec.fieldContext_VestingStatus_percentVested(ctx, field)
-			case "cliffDate":
-				return ec.fieldContext_VestingStatus_cliffDate(ctx, field)
-			case "fullyVestedAt":
-				return ec.fieldContext_VestingStatus_fullyVestedAt(ctx, field)
-			case "isFullyVested":
-				return ec.fieldContext_VestingStatus_isFullyVested(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type VestingStatus", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_vestingStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_capTable(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_capTable,
+		ec.fieldContext___Type_possibleTypes,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().CapTable(ctx, fc.Args["companyID"].(string))
+			return obj.PossibleTypes(), nil
 		},
 		nil,
-		ec.marshalNCapTableSnapshot2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot,
-		true,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_capTable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "companyID":
-				return ec.fieldContext_CapTableSnapshot_companyID(ctx, field)
-			case "totalShares":
-				return ec.fieldContext_CapTableSnapshot_totalShares(ctx, field)
-			case "entries":
-				return ec.fieldContext_CapTableSnapshot_entries(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CapTableSnapshot", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_capTable_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_modelDilution(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_modelDilution,
+		ec.fieldContext___Type_enumValues,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().ModelDilution(ctx, fc.Args["input"].(model.DilutionModelInput))
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
 		},
 		nil,
-		ec.marshalNDilutionResult2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResult,
-		true,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_modelDilution(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "preRound":
-				return ec.fieldContext_DilutionResult_preRound(ctx, field)
-			case "postRound":
-				return ec.fieldContext_DilutionResult_postRound(ctx, field)
-			case "newInvestor":
-				return ec.fieldContext_DilutionResult_newInvestor(ctx, field)
-			case "roundName":
-				return ec.fieldContext_DilutionResult_roundName(ctx, field)
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DilutionResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
 		},
 	}
 	defer func() {
@@ -3460,71 +14787,64 @@ func (ec *executionContext) fieldContext_Query_modelDilution(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_modelDilution_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_waterfall(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_waterfall,
+		ec.fieldContext___Type_inputFields,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Waterfall(ctx, fc.Args["companyID"].(string), fc.Args["exitValuation"].(model.Decimal))
+			return obj.InputFields(), nil
 		},
 		nil,
-		ec.marshalNWaterfallResult2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallResult,
-		true,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_waterfall(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "exitValuation":
-				return ec.fieldContext_WaterfallResult_exitValuation(ctx, field)
-			case "totalPayout":
-				return ec.fieldContext_WaterfallResult_totalPayout(ctx, field)
-			case "payouts":
-				return ec.fieldContext_WaterfallResult_payouts(ctx, field)
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type WaterfallResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_waterfall_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext___Type_ofType,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
+			return obj.OfType(), nil
 		},
 		nil,
 		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
@@ -3533,9 +14853,9 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: false,
@@ -3567,3693 +14887,3935 @@ func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field
 			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___schema,
+		ec.fieldContext___Type_isOneOf,
 		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
+			return obj.IsOneOf(), nil
 		},
 		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		ec.marshalOBoolean2bool,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "__Type",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddStakeholderInput(ctx context.Context, obj any) (model.AddStakeholderInput, error) {
+	var it model.AddStakeholderInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"companyID", "name", "email", "role"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "role":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("role"))
+			data, err := ec.unmarshalNStakeholderRole2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Role = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateCompanyInput(ctx context.Context, obj any) (model.CreateCompanyInput, error) {
+	var it model.CreateCompanyInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateOptionPoolInput(ctx context.Context, obj any) (model.CreateOptionPoolInput, error) {
+	var it model.CreateOptionPoolInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"companyID", "shareClassID", "name", "reservedShares"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "shareClassID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shareClassID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShareClassID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
+			it.Name = data
+		case "reservedShares":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reservedShares"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReservedShares = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFEConversionResult_safeID(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFEConversionResult_safeID,
-		func(ctx context.Context) (any, error) {
-			return obj.SafeID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFEConversionResult_safeID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFEConversionResult",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputCreateShareClassInput(ctx context.Context, obj any) (model.CreateShareClassInput, error) {
+	var it model.CreateShareClassInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _SAFEConversionResult_sharesIssued(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFEConversionResult_sharesIssued,
-		func(ctx context.Context) (any, error) {
-			return obj.SharesIssued, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
 
-func (ec *executionContext) fieldContext_SAFEConversionResult_sharesIssued(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFEConversionResult",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	fieldsInOrder := [...]string{"companyID", "name", "isPreferred", "liquidationMultiple", "isParticipating", "participationCap", "pricePerShare", "seniority", "authorizedShares"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "isPreferred":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isPreferred"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsPreferred = data
+		case "liquidationMultiple":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("liquidationMultiple"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LiquidationMultiple = data
+		case "isParticipating":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isParticipating"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsParticipating = data
+		case "participationCap":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("participationCap"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ParticipationCap = data
+		case "pricePerShare":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pricePerShare"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PricePerShare = data
+		case "seniority":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("seniority"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Seniority = data
+		case "authorizedShares":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authorizedShares"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AuthorizedShares = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFEConversionResult_effectivePPS(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFEConversionResult_effectivePPS,
-		func(ctx context.Context) (any, error) {
-			return obj.EffectivePps, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFEConversionResult_effectivePPS(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFEConversionResult",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputCreateVestingScheduleInput(ctx context.Context, obj any) (model.CreateVestingScheduleInput, error) {
+	var it model.CreateVestingScheduleInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFEConversionResult_conversionMethod(ctx context.Context, field graphql.CollectedField, obj *model.SAFEConversionResult) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFEConversionResult_conversionMethod,
-		func(ctx context.Context) (any, error) {
-			return obj.ConversionMethod, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	if _, present := asMap["rounding"]; !present {
+		asMap["rounding"] = "FRACTIONAL"
+	}
 
-func (ec *executionContext) fieldContext_SAFEConversionResult_conversionMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFEConversionResult",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	fieldsInOrder := [...]string{"companyID", "name", "cliffMonths", "totalMonths", "frequency", "accelerationTrigger", "accelerationPercent", "accelerationMonths", "accelerationWindowMonths", "tranches", "rounding"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "cliffMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cliffMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CliffMonths = data
+		case "totalMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TotalMonths = data
+		case "frequency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frequency"))
+			data, err := ec.unmarshalOVestingFrequency2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Frequency = data
+		case "accelerationTrigger":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationTrigger"))
+			data, err := ec.unmarshalOAccelerationTrigger2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationTrigger = data
+		case "accelerationPercent":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationPercent"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationPercent = data
+		case "accelerationMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationMonths = data
+		case "accelerationWindowMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationWindowMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationWindowMonths = data
+		case "tranches":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tranches"))
+			data, err := ec.unmarshalOVestingTrancheInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTrancheInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tranches = data
+		case "rounding":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rounding"))
+			data, err := ec.unmarshalOVestingRounding2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingRounding(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rounding = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_id(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFENote_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputDilutionModelInput(ctx context.Context, obj any) (model.DilutionModelInput, error) {
+	var it model.DilutionModelInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _SAFENote_companyID(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_companyID,
-		func(ctx context.Context) (any, error) {
-			return obj.CompanyID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
 
-func (ec *executionContext) fieldContext_SAFENote_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+	fieldsInOrder := [...]string{"companyID", "roundName", "preMoneyValuation", "amountRaised", "newShareClass", "investorName", "allocations", "targetPoolPct"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "roundName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roundName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RoundName = data
+		case "preMoneyValuation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preMoneyValuation"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PreMoneyValuation = data
+		case "amountRaised":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amountRaised"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AmountRaised = data
+		case "newShareClass":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newShareClass"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NewShareClass = data
+		case "investorName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("investorName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InvestorName = data
+		case "allocations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allocations"))
+			data, err := ec.unmarshalORoundAllocationInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundAllocationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Allocations = data
+		case "targetPoolPct":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetPoolPct"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TargetPoolPct = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_stakeholderID,
-		func(ctx context.Context) (any, error) {
-			return obj.StakeholderID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFENote_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputExerciseGrantInput(ctx context.Context, obj any) (model.ExerciseGrantInput, error) {
+	var it model.ExerciseGrantInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _SAFENote_investmentAmount(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_investmentAmount,
-		func(ctx context.Context) (any, error) {
-			return obj.InvestmentAmount, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
 
-func (ec *executionContext) fieldContext_SAFENote_investmentAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	fieldsInOrder := [...]string{"grantID", "quantity", "exerciseDate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "grantID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grantID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GrantID = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		case "exerciseDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exerciseDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExerciseDate = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_valuationCap(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_valuationCap,
-		func(ctx context.Context) (any, error) {
-			return obj.ValuationCap, nil
-		},
-		nil,
-		ec.marshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		false,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFENote_valuationCap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputGrantMilestoneInput(ctx context.Context, obj any) (model.GrantMilestoneInput, error) {
+	var it model.GrantMilestoneInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _SAFENote_discountRate(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_discountRate,
-		func(ctx context.Context) (any, error) {
-			return obj.DiscountRate, nil
-		},
-		nil,
-		ec.marshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		false,
-	)
-}
 
-func (ec *executionContext) fieldContext_SAFENote_discountRate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	fieldsInOrder := [...]string{"name", "shares", "condition"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "shares":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shares"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Shares = data
+		case "condition":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			data, err := ec.unmarshalOMilestoneCondition2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMilestoneCondition(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Condition = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_safeType(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_safeType,
-		func(ctx context.Context) (any, error) {
-			return obj.SafeType, nil
-		},
-		nil,
-		ec.marshalNSAFEType2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFENote_safeType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type SAFEType does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputIssueConvertibleNoteInput(ctx context.Context, obj any) (model.IssueConvertibleNoteInput, error) {
+	var it model.IssueConvertibleNoteInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_isConverted(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_isConverted,
-		func(ctx context.Context) (any, error) {
-			return obj.IsConverted, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	if _, present := asMap["interestType"]; !present {
+		asMap["interestType"] = "SIMPLE"
+	}
+	if _, present := asMap["dayCount"]; !present {
+		asMap["dayCount"] = "ACTUAL_365"
+	}
 
-func (ec *executionContext) fieldContext_SAFENote_isConverted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	fieldsInOrder := [...]string{"companyID", "stakeholderID", "principal", "interestRate", "interestType", "dayCount", "issueDate", "maturityDate", "valuationCap", "discountRate", "qualifiedFinancingAmount", "maturityValuationCap"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "stakeholderID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StakeholderID = data
+		case "principal":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("principal"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Principal = data
+		case "interestRate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("interestRate"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InterestRate = data
+		case "interestType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("interestType"))
+			data, err := ec.unmarshalOInterestType2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐInterestType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InterestType = data
+		case "dayCount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dayCount"))
+			data, err := ec.unmarshalODayCount2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDayCount(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DayCount = data
+		case "issueDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("issueDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IssueDate = data
+		case "maturityDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maturityDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaturityDate = data
+		case "valuationCap":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("valuationCap"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValuationCap = data
+		case "discountRate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("discountRate"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DiscountRate = data
+		case "qualifiedFinancingAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("qualifiedFinancingAmount"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.QualifiedFinancingAmount = data
+		case "maturityValuationCap":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maturityValuationCap"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaturityValuationCap = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_convertedInRound(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_convertedInRound,
-		func(ctx context.Context) (any, error) {
-			return obj.ConvertedInRound, nil
-		},
-		nil,
-		ec.marshalOID2ᚖstring,
-		true,
-		false,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFENote_convertedInRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputIssueGrantInput(ctx context.Context, obj any) (model.IssueGrantInput, error) {
+	var it model.IssueGrantInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_issueDate(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_issueDate,
-		func(ctx context.Context) (any, error) {
-			return obj.IssueDate, nil
-		},
-		nil,
-		ec.marshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
-		true,
-		true,
-	)
-}
+	if _, present := asMap["type"]; !present {
+		asMap["type"] = "OPTION"
+	}
+	if _, present := asMap["earlyExerciseAllowed"]; !present {
+		asMap["earlyExerciseAllowed"] = false
+	}
 
-func (ec *executionContext) fieldContext_SAFENote_issueDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
-		},
+	fieldsInOrder := [...]string{"companyID", "stakeholderID", "shareClassID", "vestingScheduleID", "optionPoolID", "type", "quantity", "grantDate", "vestingCommencementDate", "exercisePrice", "earlyExerciseAllowed", "notes", "milestones"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "stakeholderID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StakeholderID = data
+		case "shareClassID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shareClassID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShareClassID = data
+		case "vestingScheduleID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vestingScheduleID"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VestingScheduleID = data
+		case "optionPoolID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("optionPoolID"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OptionPoolID = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalOGrantType2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		case "grantDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grantDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GrantDate = data
+		case "vestingCommencementDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vestingCommencementDate"))
+			data, err := ec.unmarshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VestingCommencementDate = data
+		case "exercisePrice":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exercisePrice"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExercisePrice = data
+		case "earlyExerciseAllowed":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("earlyExerciseAllowed"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EarlyExerciseAllowed = data
+		case "notes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notes"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Notes = data
+		case "milestones":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("milestones"))
+			data, err := ec.unmarshalOGrantMilestoneInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestoneInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Milestones = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _SAFENote_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.SAFENote) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_SAFENote_createdAt,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
-		},
-		nil,
-		ec.marshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_SAFENote_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "SAFENote",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputIssueSAFEInput(ctx context.Context, obj any) (model.IssueSAFEInput, error) {
+	var it model.IssueSAFEInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_id(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+	if _, present := asMap["isMFN"]; !present {
+		asMap["isMFN"] = false
+	}
+	if _, present := asMap["capitalizationDefinition"]; !present {
+		asMap["capitalizationDefinition"] = "OUTSTANDING"
+	}
 
-func (ec *executionContext) fieldContext_ShareClass_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+	fieldsInOrder := [...]string{"companyID", "stakeholderID", "investmentAmount", "valuationCap", "discountRate", "safeType", "isMFN", "capitalizationDefinition", "issueDate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "stakeholderID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StakeholderID = data
+		case "investmentAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("investmentAmount"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InvestmentAmount = data
+		case "valuationCap":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("valuationCap"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ValuationCap = data
+		case "discountRate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("discountRate"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DiscountRate = data
+		case "safeType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("safeType"))
+			data, err := ec.unmarshalNSAFEType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SafeType = data
+		case "isMFN":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isMFN"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsMfn = data
+		case "capitalizationDefinition":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("capitalizationDefinition"))
+			data, err := ec.unmarshalOCapitalizationDefinition2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalizationDefinition(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CapitalizationDefinition = data
+		case "issueDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("issueDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IssueDate = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_companyID(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_companyID,
-		func(ctx context.Context) (any, error) {
-			return obj.CompanyID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_ShareClass_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputModeledRoundInput(ctx context.Context, obj any) (model.ModeledRoundInput, error) {
+	var it model.ModeledRoundInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_name(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	fieldsInOrder := [...]string{"roundName", "preMoneyValuation", "amountRaised", "newShareClass", "investorName", "allocations", "targetPoolPct"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "roundName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roundName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RoundName = data
+		case "preMoneyValuation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preMoneyValuation"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PreMoneyValuation = data
+		case "amountRaised":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amountRaised"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AmountRaised = data
+		case "newShareClass":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newShareClass"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NewShareClass = data
+		case "investorName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("investorName"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InvestorName = data
+		case "allocations":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allocations"))
+			data, err := ec.unmarshalORoundAllocationInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundAllocationInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Allocations = data
+		case "targetPoolPct":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetPoolPct"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TargetPoolPct = data
+		}
+	}
+
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_ShareClass_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputRecordFundingRoundInput(ctx context.Context, obj any) (model.RecordFundingRoundInput, error) {
+	var it model.RecordFundingRoundInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"companyID", "name", "preMoneyValuation", "amountRaised", "pricePerShare", "shareClassID", "roundDate", "optionPoolIncrease", "promisedOptions"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "companyID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CompanyID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "preMoneyValuation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preMoneyValuation"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PreMoneyValuation = data
+		case "amountRaised":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amountRaised"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AmountRaised = data
+		case "pricePerShare":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pricePerShare"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PricePerShare = data
+		case "shareClassID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shareClassID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShareClassID = data
+		case "roundDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roundDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RoundDate = data
+		case "optionPoolIncrease":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("optionPoolIncrease"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OptionPoolIncrease = data
+		case "promisedOptions":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("promisedOptions"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PromisedOptions = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_isPreferred(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_isPreferred,
-		func(ctx context.Context) (any, error) {
-			return obj.IsPreferred, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_ShareClass_isPreferred(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputRecordLeaveInput(ctx context.Context, obj any) (model.RecordLeaveInput, error) {
+	var it model.RecordLeaveInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _ShareClass_liquidationMultiple(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_liquidationMultiple,
-		func(ctx context.Context) (any, error) {
-			return obj.LiquidationMultiple, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
 
-func (ec *executionContext) fieldContext_ShareClass_liquidationMultiple(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	fieldsInOrder := [...]string{"stakeholderID", "grantID", "startDate", "endDate", "isPaid"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "stakeholderID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StakeholderID = data
+		case "grantID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grantID"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GrantID = data
+		case "startDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StartDate = data
+		case "endDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EndDate = data
+		case "isPaid":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isPaid"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsPaid = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_isParticipating(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_isParticipating,
-		func(ctx context.Context) (any, error) {
-			return obj.IsParticipating, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_ShareClass_isParticipating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputRepurchaseSharesInput(ctx context.Context, obj any) (model.RepurchaseSharesInput, error) {
+	var it model.RepurchaseSharesInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _ShareClass_participationCap(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_participationCap,
-		func(ctx context.Context) (any, error) {
-			return obj.ParticipationCap, nil
-		},
-		nil,
-		ec.marshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		false,
-	)
-}
 
-func (ec *executionContext) fieldContext_ShareClass_participationCap(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	fieldsInOrder := [...]string{"grantID", "repurchaseDate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "grantID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grantID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.GrantID = data
+		case "repurchaseDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repurchaseDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RepurchaseDate = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_pricePerShare(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_pricePerShare,
-		func(ctx context.Context) (any, error) {
-			return obj.PricePerShare, nil
-		},
-		nil,
-		ec.marshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		false,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_ShareClass_pricePerShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputRoundAllocationInput(ctx context.Context, obj any) (model.RoundAllocationInput, error) {
+	var it model.RoundAllocationInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _ShareClass_seniority(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_seniority,
-		func(ctx context.Context) (any, error) {
-			return obj.Seniority, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
 
-func (ec *executionContext) fieldContext_ShareClass_seniority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+	fieldsInOrder := [...]string{"investorName", "stakeholderID", "amount"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "investorName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("investorName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.InvestorName = data
+		case "stakeholderID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StakeholderID = data
+		case "amount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Amount = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_authorizedShares(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_authorizedShares,
-		func(ctx context.Context) (any, error) {
-			return obj.AuthorizedShares, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_ShareClass_authorizedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputSAFEConversionScenarioInput(ctx context.Context, obj any) (model.SAFEConversionScenarioInput, error) {
+	var it model.SAFEConversionScenarioInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _ShareClass_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.ShareClass) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_ShareClass_createdAt,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
-		},
-		nil,
-		ec.marshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
-		true,
-		true,
-	)
+	fieldsInOrder := [...]string{"name", "preMoneyValuation", "amountRaised", "pricePerShare"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "preMoneyValuation":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preMoneyValuation"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PreMoneyValuation = data
+		case "amountRaised":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amountRaised"))
+			data, err := ec.unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AmountRaised = data
+		case "pricePerShare":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pricePerShare"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PricePerShare = data
+		}
+	}
+
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_ShareClass_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ShareClass",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputSettleNoteAtMaturityInput(ctx context.Context, obj any) (model.SettleNoteAtMaturityInput, error) {
+	var it model.SettleNoteAtMaturityInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Stakeholder_id(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Stakeholder_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	fieldsInOrder := [...]string{"noteID", "settlementDate", "shareClassID"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "noteID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("noteID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NoteID = data
+		case "settlementDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("settlementDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SettlementDate = data
+		case "shareClassID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shareClassID"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShareClassID = data
+		}
+	}
+
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_Stakeholder_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Stakeholder",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputTerminateStakeholderInput(ctx context.Context, obj any) (model.TerminateStakeholderInput, error) {
+	var it model.TerminateStakeholderInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Stakeholder_companyID(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Stakeholder_companyID,
-		func(ctx context.Context) (any, error) {
-			return obj.CompanyID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	fieldsInOrder := [...]string{"stakeholderID", "terminationDate", "reason", "exerciseWindowDays", "fairMarketValue"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "stakeholderID":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StakeholderID = data
+		case "terminationDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("terminationDate"))
+			data, err := ec.unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TerminationDate = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalNTerminationReason2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminationReason(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		case "exerciseWindowDays":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exerciseWindowDays"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExerciseWindowDays = data
+		case "fairMarketValue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fairMarketValue"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FairMarketValue = data
+		}
+	}
+
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_Stakeholder_companyID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Stakeholder",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputUpdateVestingScheduleInput(ctx context.Context, obj any) (model.UpdateVestingScheduleInput, error) {
+	var it model.UpdateVestingScheduleInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "name", "cliffMonths", "totalMonths", "frequency", "accelerationTrigger", "accelerationPercent", "accelerationMonths", "accelerationWindowMonths", "tranches", "rounding"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "cliffMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cliffMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CliffMonths = data
+		case "totalMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TotalMonths = data
+		case "frequency":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frequency"))
+			data, err := ec.unmarshalOVestingFrequency2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Frequency = data
+		case "accelerationTrigger":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationTrigger"))
+			data, err := ec.unmarshalOAccelerationTrigger2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationTrigger = data
+		case "accelerationPercent":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationPercent"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationPercent = data
+		case "accelerationMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationMonths = data
+		case "accelerationWindowMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationWindowMonths"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AccelerationWindowMonths = data
+		case "tranches":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tranches"))
+			data, err := ec.unmarshalOVestingTrancheInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTrancheInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tranches = data
+		case "rounding":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rounding"))
+			data, err := ec.unmarshalOVestingRounding2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingRounding(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rounding = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Stakeholder_name(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Stakeholder_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_Stakeholder_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Stakeholder",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) unmarshalInputVestingTrancheInput(ctx context.Context, obj any) (model.VestingTrancheInput, error) {
+	var it model.VestingTrancheInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Stakeholder_email(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Stakeholder_email,
-		func(ctx context.Context) (any, error) {
-			return obj.Email, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
 
-func (ec *executionContext) fieldContext_Stakeholder_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Stakeholder",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	fieldsInOrder := [...]string{"offsetMonths", "percent", "shares"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "offsetMonths":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offsetMonths"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OffsetMonths = data
+		case "percent":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("percent"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Percent = data
+		case "shares":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shares"))
+			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Shares = data
+		}
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Stakeholder_role(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Stakeholder_role,
-		func(ctx context.Context) (any, error) {
-			return obj.Role, nil
-		},
-		nil,
-		ec.marshalNStakeholderRole2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole,
-		true,
-		true,
-	)
+	return it, nil
 }
 
-func (ec *executionContext) fieldContext_Stakeholder_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Stakeholder",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type StakeholderRole does not have child fields")
-		},
-	}
-	return fc, nil
-}
+// endregion **************************** input.gotpl *****************************
 
-func (ec *executionContext) _Stakeholder_grants(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Stakeholder_grants,
-		func(ctx context.Context) (any, error) {
-			return obj.Grants, nil
-		},
-		nil,
-		ec.marshalNGrant2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantᚄ,
-		true,
-		true,
-	)
-}
+// region    ************************** interface.gotpl ***************************
 
-func (ec *executionContext) fieldContext_Stakeholder_grants(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Stakeholder",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Grant_id(ctx, field)
-			case "companyID":
-				return ec.fieldContext_Grant_companyID(ctx, field)
-			case "stakeholderID":
-				return ec.fieldContext_Grant_stakeholderID(ctx, field)
-			case "shareClassID":
-				return ec.fieldContext_Grant_shareClassID(ctx, field)
-			case "vestingScheduleID":
-				return ec.fieldContext_Grant_vestingScheduleID(ctx, field)
-			case "quantity":
-				return ec.fieldContext_Grant_quantity(ctx, field)
-			case "grantDate":
-				return ec.fieldContext_Grant_grantDate(ctx, field)
-			case "exercisePrice":
-				return ec.fieldContext_Grant_exercisePrice(ctx, field)
-			case "isExercised":
-				return ec.fieldContext_Grant_isExercised(ctx, field)
-			case "notes":
-				return ec.fieldContext_Grant_notes(ctx, field)
-			case "vestingSchedule":
-				return ec.fieldContext_Grant_vestingSchedule(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Grant_createdAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Grant", field.Name)
-		},
-	}
-	return fc, nil
-}
+// endregion ************************** interface.gotpl ***************************
 
-func (ec *executionContext) _Stakeholder_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Stakeholder) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Stakeholder_createdAt,
-		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
-		},
-		nil,
-		ec.marshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime,
-		true,
-		true,
-	)
-}
+// region    **************************** object.gotpl ****************************
 
-func (ec *executionContext) fieldContext_Stakeholder_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Stakeholder",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type DateTime does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var capTableEntryImplementors = []string{"CapTableEntry"}
 
-func (ec *executionContext) _VestingSchedule_id(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingSchedule_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _CapTableEntry(ctx context.Context, sel ast.SelectionSet, obj *model.CapTableEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, capTableEntryImplementors)
 
-func (ec *executionContext) fieldContext_VestingSchedule_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingSchedule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CapTableEntry")
+		case "stakeholderID":
+			out.Values[i] = ec._CapTableEntry_stakeholderID(ctx, field, obj)
+		case "stakeholderName":
+			out.Values[i] = ec._CapTableEntry_stakeholderName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shareClassName":
+			out.Values[i] = ec._CapTableEntry_shareClassName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shares":
+			out.Values[i] = ec._CapTableEntry_shares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ownershipPct":
+			out.Values[i] = ec._CapTableEntry_ownershipPct(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exercisedUnvestedShares":
+			out.Values[i] = ec._CapTableEntry_exercisedUnvestedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issuedShares":
+			out.Values[i] = ec._CapTableEntry_issuedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "optionShares":
+			out.Values[i] = ec._CapTableEntry_optionShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "convertibleShares":
+			out.Values[i] = ec._CapTableEntry_convertibleShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "poolShares":
+			out.Values[i] = ec._CapTableEntry_poolShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingSchedule_cliffMonths(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingSchedule_cliffMonths,
-		func(ctx context.Context) (any, error) {
-			return obj.CliffMonths, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_VestingSchedule_cliffMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingSchedule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingSchedule_totalMonths(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingSchedule_totalMonths,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalMonths, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_VestingSchedule_totalMonths(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingSchedule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var capTableSnapshotImplementors = []string{"CapTableSnapshot"}
 
-func (ec *executionContext) _VestingSchedule_frequency(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingSchedule_frequency,
-		func(ctx context.Context) (any, error) {
-			return obj.Frequency, nil
-		},
-		nil,
-		ec.marshalNVestingFrequency2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _CapTableSnapshot(ctx context.Context, sel ast.SelectionSet, obj *model.CapTableSnapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, capTableSnapshotImplementors)
 
-func (ec *executionContext) fieldContext_VestingSchedule_frequency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingSchedule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type VestingFrequency does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CapTableSnapshot")
+		case "companyID":
+			out.Values[i] = ec._CapTableSnapshot_companyID(ctx, field, obj)
+		case "totalShares":
+			out.Values[i] = ec._CapTableSnapshot_totalShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entries":
+			out.Values[i] = ec._CapTableSnapshot_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "basis":
+			out.Values[i] = ec._CapTableSnapshot_basis(ctx, field, obj)
+		case "issuedShares":
+			out.Values[i] = ec._CapTableSnapshot_issuedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "optionShares":
+			out.Values[i] = ec._CapTableSnapshot_optionShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "convertibleShares":
+			out.Values[i] = ec._CapTableSnapshot_convertibleShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "poolShares":
+			out.Values[i] = ec._CapTableSnapshot_poolShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingSchedule_accelerationTrigger(ctx context.Context, field graphql.CollectedField, obj *model.VestingSchedule) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingSchedule_accelerationTrigger,
-		func(ctx context.Context) (any, error) {
-			return obj.AccelerationTrigger, nil
-		},
-		nil,
-		ec.marshalNAccelerationTrigger2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_VestingSchedule_accelerationTrigger(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingSchedule",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type AccelerationTrigger does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingStatus_grantID(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_grantID,
-		func(ctx context.Context) (any, error) {
-			return obj.GrantID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_VestingStatus_grantID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var capitalizationImplementors = []string{"Capitalization"}
 
-func (ec *executionContext) _VestingStatus_asOfDate(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_asOfDate,
-		func(ctx context.Context) (any, error) {
-			return obj.AsOfDate, nil
-		},
-		nil,
-		ec.marshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _Capitalization(ctx context.Context, sel ast.SelectionSet, obj *model.Capitalization) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, capitalizationImplementors)
 
-func (ec *executionContext) fieldContext_VestingStatus_asOfDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Capitalization")
+		case "definition":
+			out.Values[i] = ec._Capitalization_definition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issuedShares":
+			out.Values[i] = ec._Capitalization_issuedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issuedOptions":
+			out.Values[i] = ec._Capitalization_issuedOptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "promisedOptions":
+			out.Values[i] = ec._Capitalization_promisedOptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unissuedPool":
+			out.Values[i] = ec._Capitalization_unissuedPool(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "poolIncrease":
+			out.Values[i] = ec._Capitalization_poolIncrease(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._Capitalization_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingStatus_totalShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_totalShares,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalShares, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_VestingStatus_totalShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingStatus_vestedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_vestedShares,
-		func(ctx context.Context) (any, error) {
-			return obj.VestedShares, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_VestingStatus_vestedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var companyImplementors = []string{"Company"}
 
-func (ec *executionContext) _VestingStatus_unvestedShares(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_unvestedShares,
-		func(ctx context.Context) (any, error) {
-			return obj.UnvestedShares, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _Company(ctx context.Context, sel ast.SelectionSet, obj *model.Company) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, companyImplementors)
 
-func (ec *executionContext) fieldContext_VestingStatus_unvestedShares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Company")
+		case "id":
+			out.Values[i] = ec._Company_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Company_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stakeholders":
+			out.Values[i] = ec._Company_stakeholders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shareClasses":
+			out.Values[i] = ec._Company_shareClasses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grants":
+			out.Values[i] = ec._Company_grants(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fundingRounds":
+			out.Values[i] = ec._Company_fundingRounds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "safeNotes":
+			out.Values[i] = ec._Company_safeNotes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "convertibleNotes":
+			out.Values[i] = ec._Company_convertibleNotes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "optionPools":
+			out.Values[i] = ec._Company_optionPools(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "vestingSchedules":
+			out.Values[i] = ec._Company_vestingSchedules(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Company_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingStatus_percentVested(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_percentVested,
-		func(ctx context.Context) (any, error) {
-			return obj.PercentVested, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_VestingStatus_percentVested(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingStatus_cliffDate(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_cliffDate,
-		func(ctx context.Context) (any, error) {
-			return obj.CliffDate, nil
-		},
-		nil,
-		ec.marshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_VestingStatus_cliffDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
-		},
+var convertibleNoteImplementors = []string{"ConvertibleNote"}
+
+func (ec *executionContext) _ConvertibleNote(ctx context.Context, sel ast.SelectionSet, obj *model.ConvertibleNote) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, convertibleNoteImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConvertibleNote")
+		case "id":
+			out.Values[i] = ec._ConvertibleNote_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "companyID":
+			out.Values[i] = ec._ConvertibleNote_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stakeholderID":
+			out.Values[i] = ec._ConvertibleNote_stakeholderID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "principal":
+			out.Values[i] = ec._ConvertibleNote_principal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "interestRate":
+			out.Values[i] = ec._ConvertibleNote_interestRate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "interestType":
+			out.Values[i] = ec._ConvertibleNote_interestType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dayCount":
+			out.Values[i] = ec._ConvertibleNote_dayCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issueDate":
+			out.Values[i] = ec._ConvertibleNote_issueDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maturityDate":
+			out.Values[i] = ec._ConvertibleNote_maturityDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "valuationCap":
+			out.Values[i] = ec._ConvertibleNote_valuationCap(ctx, field, obj)
+		case "discountRate":
+			out.Values[i] = ec._ConvertibleNote_discountRate(ctx, field, obj)
+		case "qualifiedFinancingAmount":
+			out.Values[i] = ec._ConvertibleNote_qualifiedFinancingAmount(ctx, field, obj)
+		case "maturityValuationCap":
+			out.Values[i] = ec._ConvertibleNote_maturityValuationCap(ctx, field, obj)
+		case "settlement":
+			out.Values[i] = ec._ConvertibleNote_settlement(ctx, field, obj)
+		case "settledInRound":
+			out.Values[i] = ec._ConvertibleNote_settledInRound(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._ConvertibleNote_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingStatus_fullyVestedAt(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_fullyVestedAt,
-		func(ctx context.Context) (any, error) {
-			return obj.FullyVestedAt, nil
-		},
-		nil,
-		ec.marshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_VestingStatus_fullyVestedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Date does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _VestingStatus_isFullyVested(ctx context.Context, field graphql.CollectedField, obj *model.VestingStatus) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_VestingStatus_isFullyVested,
-		func(ctx context.Context) (any, error) {
-			return obj.IsFullyVested, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_VestingStatus_isFullyVested(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "VestingStatus",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+var dilutionResultImplementors = []string{"DilutionResult"}
+
+func (ec *executionContext) _DilutionResult(ctx context.Context, sel ast.SelectionSet, obj *model.DilutionResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dilutionResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DilutionResult")
+		case "preRound":
+			out.Values[i] = ec._DilutionResult_preRound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "postRound":
+			out.Values[i] = ec._DilutionResult_postRound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "newInvestor":
+			out.Values[i] = ec._DilutionResult_newInvestor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "roundName":
+			out.Values[i] = ec._DilutionResult_roundName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "investors":
+			out.Values[i] = ec._DilutionResult_investors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allocatedAmount":
+			out.Values[i] = ec._DilutionResult_allocatedAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allocationMismatch":
+			out.Values[i] = ec._DilutionResult_allocationMismatch(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectivePPS":
+			out.Values[i] = ec._DilutionResult_effectivePPS(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "poolIncrease":
+			out.Values[i] = ec._DilutionResult_poolIncrease(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "poolDilutionPct":
+			out.Values[i] = ec._DilutionResult_poolDilutionPct(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "newMoneyDilutionPct":
+			out.Values[i] = ec._DilutionResult_newMoneyDilutionPct(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "conversions":
+			out.Values[i] = ec._DilutionResult_conversions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "conversionDilutionPct":
+			out.Values[i] = ec._DilutionResult_conversionDilutionPct(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallPayout_stakeholderID(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallPayout_stakeholderID,
-		func(ctx context.Context) (any, error) {
-			return obj.StakeholderID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_WaterfallPayout_stakeholderID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallPayout",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallPayout_stakeholderName(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallPayout_stakeholderName,
-		func(ctx context.Context) (any, error) {
-			return obj.StakeholderName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_WaterfallPayout_stakeholderName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallPayout",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var fundingRoundImplementors = []string{"FundingRound"}
+
+func (ec *executionContext) _FundingRound(ctx context.Context, sel ast.SelectionSet, obj *model.FundingRound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fundingRoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FundingRound")
+		case "id":
+			out.Values[i] = ec._FundingRound_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "companyID":
+			out.Values[i] = ec._FundingRound_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._FundingRound_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "preMoneyValuation":
+			out.Values[i] = ec._FundingRound_preMoneyValuation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "amountRaised":
+			out.Values[i] = ec._FundingRound_amountRaised(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pricePerShare":
+			out.Values[i] = ec._FundingRound_pricePerShare(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shareClassID":
+			out.Values[i] = ec._FundingRound_shareClassID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "roundDate":
+			out.Values[i] = ec._FundingRound_roundDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "optionPoolIncrease":
+			out.Values[i] = ec._FundingRound_optionPoolIncrease(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "promisedOptions":
+			out.Values[i] = ec._FundingRound_promisedOptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._FundingRound_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallPayout_shareClassName(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallPayout_shareClassName,
-		func(ctx context.Context) (any, error) {
-			return obj.ShareClassName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_WaterfallPayout_shareClassName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallPayout",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallPayout_shares(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallPayout_shares,
-		func(ctx context.Context) (any, error) {
-			return obj.Shares, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_WaterfallPayout_shares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallPayout",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+var grantImplementors = []string{"Grant"}
+
+func (ec *executionContext) _Grant(ctx context.Context, sel ast.SelectionSet, obj *model.Grant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, grantImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Grant")
+		case "id":
+			out.Values[i] = ec._Grant_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "companyID":
+			out.Values[i] = ec._Grant_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stakeholderID":
+			out.Values[i] = ec._Grant_stakeholderID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shareClassID":
+			out.Values[i] = ec._Grant_shareClassID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "vestingScheduleID":
+			out.Values[i] = ec._Grant_vestingScheduleID(ctx, field, obj)
+		case "optionPoolID":
+			out.Values[i] = ec._Grant_optionPoolID(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec._Grant_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._Grant_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grantDate":
+			out.Values[i] = ec._Grant_grantDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "vestingCommencementDate":
+			out.Values[i] = ec._Grant_vestingCommencementDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exercisePrice":
+			out.Values[i] = ec._Grant_exercisePrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isExercised":
+			out.Values[i] = ec._Grant_isExercised(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "earlyExerciseAllowed":
+			out.Values[i] = ec._Grant_earlyExerciseAllowed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "notes":
+			out.Values[i] = ec._Grant_notes(ctx, field, obj)
+		case "terminationDate":
+			out.Values[i] = ec._Grant_terminationDate(ctx, field, obj)
+		case "forfeitedQuantity":
+			out.Values[i] = ec._Grant_forfeitedQuantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exerciseDeadline":
+			out.Values[i] = ec._Grant_exerciseDeadline(ctx, field, obj)
+		case "expiredQuantity":
+			out.Values[i] = ec._Grant_expiredQuantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repurchasePrice":
+			out.Values[i] = ec._Grant_repurchasePrice(ctx, field, obj)
+		case "repurchasedQuantity":
+			out.Values[i] = ec._Grant_repurchasedQuantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repurchaseDate":
+			out.Values[i] = ec._Grant_repurchaseDate(ctx, field, obj)
+		case "vestingSchedule":
+			out.Values[i] = ec._Grant_vestingSchedule(ctx, field, obj)
+		case "milestones":
+			out.Values[i] = ec._Grant_milestones(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "leaves":
+			out.Values[i] = ec._Grant_leaves(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exercises":
+			out.Values[i] = ec._Grant_exercises(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Grant_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallPayout_payout(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallPayout_payout,
-		func(ctx context.Context) (any, error) {
-			return obj.Payout, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_WaterfallPayout_payout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallPayout",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallPayout_payoutPerShare(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallPayout) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallPayout_payoutPerShare,
-		func(ctx context.Context) (any, error) {
-			return obj.PayoutPerShare, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_WaterfallPayout_payoutPerShare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallPayout",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+var grantExerciseImplementors = []string{"GrantExercise"}
+
+func (ec *executionContext) _GrantExercise(ctx context.Context, sel ast.SelectionSet, obj *model.GrantExercise) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, grantExerciseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GrantExercise")
+		case "id":
+			out.Values[i] = ec._GrantExercise_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grantID":
+			out.Values[i] = ec._GrantExercise_grantID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._GrantExercise_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exerciseDate":
+			out.Values[i] = ec._GrantExercise_exerciseDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._GrantExercise_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallResult_exitValuation(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallResult) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallResult_exitValuation,
-		func(ctx context.Context) (any, error) {
-			return obj.ExitValuation, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_WaterfallResult_exitValuation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallResult",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+var grantMilestoneImplementors = []string{"GrantMilestone"}
+
+func (ec *executionContext) _GrantMilestone(ctx context.Context, sel ast.SelectionSet, obj *model.GrantMilestone) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, grantMilestoneImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GrantMilestone")
+		case "id":
+			out.Values[i] = ec._GrantMilestone_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grantID":
+			out.Values[i] = ec._GrantMilestone_grantID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._GrantMilestone_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shares":
+			out.Values[i] = ec._GrantMilestone_shares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "condition":
+			out.Values[i] = ec._GrantMilestone_condition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "achievedDate":
+			out.Values[i] = ec._GrantMilestone_achievedDate(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._GrantMilestone_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallResult_totalPayout(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallResult) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallResult_totalPayout,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPayout, nil
-		},
-		nil,
-		ec.marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_WaterfallResult_totalPayout(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallResult",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Decimal does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _WaterfallResult_payouts(ctx context.Context, field graphql.CollectedField, obj *model.WaterfallResult) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_WaterfallResult_payouts,
-		func(ctx context.Context) (any, error) {
-			return obj.Payouts, nil
-		},
-		nil,
-		ec.marshalNWaterfallPayout2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayoutᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext_WaterfallResult_payouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "WaterfallResult",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "stakeholderID":
-				return ec.fieldContext_WaterfallPayout_stakeholderID(ctx, field)
-			case "stakeholderName":
-				return ec.fieldContext_WaterfallPayout_stakeholderName(ctx, field)
-			case "shareClassName":
-				return ec.fieldContext_WaterfallPayout_shareClassName(ctx, field)
-			case "shares":
-				return ec.fieldContext_WaterfallPayout_shares(ctx, field)
-			case "payout":
-				return ec.fieldContext_WaterfallPayout_payout(ctx, field)
-			case "payoutPerShare":
-				return ec.fieldContext_WaterfallPayout_payoutPerShare(ctx, field)
+var leaveOfAbsenceImplementors = []string{"LeaveOfAbsence"}
+
+func (ec *executionContext) _LeaveOfAbsence(ctx context.Context, sel ast.SelectionSet, obj *model.LeaveOfAbsence) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, leaveOfAbsenceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LeaveOfAbsence")
+		case "id":
+			out.Values[i] = ec._LeaveOfAbsence_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type WaterfallPayout", field.Name)
-		},
+		case "stakeholderID":
+			out.Values[i] = ec._LeaveOfAbsence_stakeholderID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grantID":
+			out.Values[i] = ec._LeaveOfAbsence_grantID(ctx, field, obj)
+		case "startDate":
+			out.Values[i] = ec._LeaveOfAbsence_startDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endDate":
+			out.Values[i] = ec._LeaveOfAbsence_endDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isPaid":
+			out.Values[i] = ec._LeaveOfAbsence_isPaid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._LeaveOfAbsence_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var mFNNoticeImplementors = []string{"MFNNotice"}
+
+func (ec *executionContext) _MFNNotice(ctx context.Context, sel ast.SelectionSet, obj *model.MFNNotice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mFNNoticeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MFNNotice")
+		case "id":
+			out.Values[i] = ec._MFNNotice_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "safeID":
+			out.Values[i] = ec._MFNNotice_safeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sourceSafeID":
+			out.Values[i] = ec._MFNNotice_sourceSafeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "valuationCap":
+			out.Values[i] = ec._MFNNotice_valuationCap(ctx, field, obj)
+		case "discountRate":
+			out.Values[i] = ec._MFNNotice_discountRate(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._MFNNotice_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_isRepeatable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_locations,
-		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
-		},
-		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var mutationImplementors = []string{"Mutation"}
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createCompany":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createCompany(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "addStakeholder":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addStakeholder(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createShareClass":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createShareClass(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createVestingSchedule":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createVestingSchedule(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateVestingSchedule":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateVestingSchedule(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "archiveVestingSchedule":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_archiveVestingSchedule(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issueGrant":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_issueGrant(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createOptionPool":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createOptionPool(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "terminateStakeholder":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_terminateStakeholder(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordLeave":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recordLeave(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exerciseGrant":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_exerciseGrant(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repurchaseShares":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_repurchaseShares(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordFundingRound":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recordFundingRound(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issueSAFE":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_issueSAFE(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "convertSAFE":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_convertSAFE(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "convertAllSAFEs":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_convertAllSAFEs(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issueConvertibleNote":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_issueConvertibleNote(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "convertNote":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_convertNote(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "settleNoteAtMaturity":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_settleNoteAtMaturity(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "recordMilestoneAchieved":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_recordMilestoneAchieved(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var noteAccrualImplementors = []string{"NoteAccrual"}
+
+func (ec *executionContext) _NoteAccrual(ctx context.Context, sel ast.SelectionSet, obj *model.NoteAccrual) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, noteAccrualImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NoteAccrual")
+		case "noteID":
+			out.Values[i] = ec._NoteAccrual_noteID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "asOfDate":
+			out.Values[i] = ec._NoteAccrual_asOfDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "principal":
+			out.Values[i] = ec._NoteAccrual_principal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accruedInterest":
+			out.Values[i] = ec._NoteAccrual_accruedInterest(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "balance":
+			out.Values[i] = ec._NoteAccrual_balance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var noteSettlementImplementors = []string{"NoteSettlement"}
+
+func (ec *executionContext) _NoteSettlement(ctx context.Context, sel ast.SelectionSet, obj *model.NoteSettlement) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, noteSettlementImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NoteSettlement")
+		case "noteID":
+			out.Values[i] = ec._NoteSettlement_noteID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "date":
+			out.Values[i] = ec._NoteSettlement_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "principal":
+			out.Values[i] = ec._NoteSettlement_principal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accruedInterest":
+			out.Values[i] = ec._NoteSettlement_accruedInterest(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sharesIssued":
+			out.Values[i] = ec._NoteSettlement_sharesIssued(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectivePPS":
+			out.Values[i] = ec._NoteSettlement_effectivePPS(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repaymentAmount":
+			out.Values[i] = ec._NoteSettlement_repaymentAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "method":
+			out.Values[i] = ec._NoteSettlement_method(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grantID":
+			out.Values[i] = ec._NoteSettlement_grantID(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+var optionPoolImplementors = []string{"OptionPool"}
+
+func (ec *executionContext) _OptionPool(ctx context.Context, sel ast.SelectionSet, obj *model.OptionPool) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, optionPoolImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OptionPool")
+		case "id":
+			out.Values[i] = ec._OptionPool_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "companyID":
+			out.Values[i] = ec._OptionPool_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shareClassID":
+			out.Values[i] = ec._OptionPool_shareClassID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._OptionPool_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reservedShares":
+			out.Values[i] = ec._OptionPool_reservedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grantedShares":
+			out.Values[i] = ec._OptionPool_grantedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableShares":
+			out.Values[i] = ec._OptionPool_availableShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._OptionPool_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+var ownershipTrajectoryImplementors = []string{"OwnershipTrajectory"}
+
+func (ec *executionContext) _OwnershipTrajectory(ctx context.Context, sel ast.SelectionSet, obj *model.OwnershipTrajectory) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ownershipTrajectoryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OwnershipTrajectory")
+		case "stakeholderID":
+			out.Values[i] = ec._OwnershipTrajectory_stakeholderID(ctx, field, obj)
+		case "stakeholderName":
+			out.Values[i] = ec._OwnershipTrajectory_stakeholderName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "ownershipPct":
+			out.Values[i] = ec._OwnershipTrajectory_ownershipPct(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var queryImplementors = []string{"Query"}
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "company":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_company(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "stakeholder":
+			field := field
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_stakeholder(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "vestingStatus":
+			field := field
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_vestingStatus(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_defaultValue,
-		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "vestingStatusWithEvents":
+			field := field
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_vestingStatusWithEvents(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "vestingSchedule":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_vestingSchedule(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "vestingForecast":
+			field := field
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_vestingForecast(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_types,
-		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "capTable":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_capTable(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_queryType,
-		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "modelDilution":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_modelDilution(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_mutationType,
-		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "modelRoundSequence":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_modelRoundSequence(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_subscriptionType,
-		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "previewSAFEConversions":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_previewSAFEConversions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_directives,
-		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
-		},
-		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
-		true,
-		true,
-	)
-}
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_kind,
-		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
-		},
-		nil,
-		ec.marshalN__TypeKind2string,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "noteAccrual":
+			field := field
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_noteAccrual(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "waterfall":
+			field := field
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_waterfall(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_specifiedByURL,
-		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_fields,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+var roundSequenceResultImplementors = []string{"RoundSequenceResult"}
+
+func (ec *executionContext) _RoundSequenceResult(ctx context.Context, sel ast.SelectionSet, obj *model.RoundSequenceResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, roundSequenceResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RoundSequenceResult")
+		case "steps":
+			out.Values[i] = ec._RoundSequenceResult_steps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "trajectories":
+			out.Values[i] = ec._RoundSequenceResult_trajectories(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_interfaces,
-		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_possibleTypes,
-		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
+var sAFEConversionPreviewImplementors = []string{"SAFEConversionPreview"}
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_enumValues,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _SAFEConversionPreview(ctx context.Context, sel ast.SelectionSet, obj *model.SAFEConversionPreview) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sAFEConversionPreviewImplementors)
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SAFEConversionPreview")
+		case "scenarioName":
+			out.Values[i] = ec._SAFEConversionPreview_scenarioName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "preMoneyValuation":
+			out.Values[i] = ec._SAFEConversionPreview_preMoneyValuation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "amountRaised":
+			out.Values[i] = ec._SAFEConversionPreview_amountRaised(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pricePerShare":
+			out.Values[i] = ec._SAFEConversionPreview_pricePerShare(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "postMoneyShares":
+			out.Values[i] = ec._SAFEConversionPreview_postMoneyShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "conversions":
+			out.Values[i] = ec._SAFEConversionPreview_conversions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "holders":
+			out.Values[i] = ec._SAFEConversionPreview_holders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_inputFields,
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_ofType,
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var sAFEConversionResultImplementors = []string{"SAFEConversionResult"}
+
+func (ec *executionContext) _SAFEConversionResult(ctx context.Context, sel ast.SelectionSet, obj *model.SAFEConversionResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sAFEConversionResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SAFEConversionResult")
+		case "safeID":
+			out.Values[i] = ec._SAFEConversionResult_safeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "sharesIssued":
+			out.Values[i] = ec._SAFEConversionResult_sharesIssued(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "effectivePPS":
+			out.Values[i] = ec._SAFEConversionResult_effectivePPS(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "conversionMethod":
+			out.Values[i] = ec._SAFEConversionResult_conversionMethod(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "grantID":
+			out.Values[i] = ec._SAFEConversionResult_grantID(ctx, field, obj)
+		case "inheritedTermsFrom":
+			out.Values[i] = ec._SAFEConversionResult_inheritedTermsFrom(ctx, field, obj)
+		case "capitalization":
+			out.Values[i] = ec._SAFEConversionResult_capitalization(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_isOneOf,
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		ec.marshalOBoolean2bool,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
+
+	return out
 }
 
-// endregion **************************** field.gotpl *****************************
+var sAFEHolderOwnershipImplementors = []string{"SAFEHolderOwnership"}
 
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _SAFEHolderOwnership(ctx context.Context, sel ast.SelectionSet, obj *model.SAFEHolderOwnership) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sAFEHolderOwnershipImplementors)
 
-func (ec *executionContext) unmarshalInputAddStakeholderInput(ctx context.Context, obj any) (model.AddStakeholderInput, error) {
-	var it model.AddStakeholderInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SAFEHolderOwnership")
+		case "stakeholderID":
+			out.Values[i] = ec._SAFEHolderOwnership_stakeholderID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stakeholderName":
+			out.Values[i] = ec._SAFEHolderOwnership_stakeholderName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "existingShares":
+			out.Values[i] = ec._SAFEHolderOwnership_existingShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "conversionShares":
+			out.Values[i] = ec._SAFEHolderOwnership_conversionShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ownershipPct":
+			out.Values[i] = ec._SAFEHolderOwnership_ownershipPct(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	fieldsInOrder := [...]string{"companyID", "name", "email", "role"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sAFENoteImplementors = []string{"SAFENote"}
+
+func (ec *executionContext) _SAFENote(ctx context.Context, sel ast.SelectionSet, obj *model.SAFENote) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sAFENoteImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SAFENote")
+		case "id":
+			out.Values[i] = ec._SAFENote_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "companyID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._SAFENote_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CompanyID = data
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "stakeholderID":
+			out.Values[i] = ec._SAFENote_stakeholderID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
-		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "investmentAmount":
+			out.Values[i] = ec._SAFENote_investmentAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Email = data
-		case "role":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("role"))
-			data, err := ec.unmarshalNStakeholderRole2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole(ctx, v)
-			if err != nil {
-				return it, err
+		case "valuationCap":
+			out.Values[i] = ec._SAFENote_valuationCap(ctx, field, obj)
+		case "discountRate":
+			out.Values[i] = ec._SAFENote_discountRate(ctx, field, obj)
+		case "safeType":
+			out.Values[i] = ec._SAFENote_safeType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Role = data
+		case "isMFN":
+			out.Values[i] = ec._SAFENote_isMFN(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "capitalizationDefinition":
+			out.Values[i] = ec._SAFENote_capitalizationDefinition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isConverted":
+			out.Values[i] = ec._SAFENote_isConverted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "convertedInRound":
+			out.Values[i] = ec._SAFENote_convertedInRound(ctx, field, obj)
+		case "conversion":
+			out.Values[i] = ec._SAFENote_conversion(ctx, field, obj)
+		case "issueDate":
+			out.Values[i] = ec._SAFENote_issueDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._SAFENote_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputCreateCompanyInput(ctx context.Context, obj any) (model.CreateCompanyInput, error) {
-	var it model.CreateCompanyInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	fieldsInOrder := [...]string{"name"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputCreateShareClassInput(ctx context.Context, obj any) (model.CreateShareClassInput, error) {
-	var it model.CreateShareClassInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var shareClassImplementors = []string{"ShareClass"}
 
-	fieldsInOrder := [...]string{"companyID", "name", "isPreferred", "liquidationMultiple", "isParticipating", "participationCap", "pricePerShare", "seniority", "authorizedShares"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+func (ec *executionContext) _ShareClass(ctx context.Context, sel ast.SelectionSet, obj *model.ShareClass) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shareClassImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ShareClass")
+		case "id":
+			out.Values[i] = ec._ShareClass_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "companyID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ShareClass_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CompanyID = data
 		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ShareClass_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
 		case "isPreferred":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isPreferred"))
-			data, err := ec.unmarshalNBoolean2bool(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ShareClass_isPreferred(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.IsPreferred = data
 		case "liquidationMultiple":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("liquidationMultiple"))
-			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ShareClass_liquidationMultiple(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.LiquidationMultiple = data
 		case "isParticipating":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isParticipating"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ShareClass_isParticipating(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.IsParticipating = data
 		case "participationCap":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("participationCap"))
-			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ParticipationCap = data
+			out.Values[i] = ec._ShareClass_participationCap(ctx, field, obj)
 		case "pricePerShare":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pricePerShare"))
-			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PricePerShare = data
+			out.Values[i] = ec._ShareClass_pricePerShare(ctx, field, obj)
 		case "seniority":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("seniority"))
-			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ShareClass_seniority(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Seniority = data
 		case "authorizedShares":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("authorizedShares"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AuthorizedShares = data
-		}
-	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputCreateVestingScheduleInput(ctx context.Context, obj any) (model.CreateVestingScheduleInput, error) {
-	var it model.CreateVestingScheduleInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"cliffMonths", "totalMonths", "frequency", "accelerationTrigger"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "cliffMonths":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cliffMonths"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CliffMonths = data
-		case "totalMonths":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalMonths"))
-			data, err := ec.unmarshalNInt2int(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.TotalMonths = data
-		case "frequency":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frequency"))
-			data, err := ec.unmarshalNVestingFrequency2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ShareClass_authorizedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Frequency = data
-		case "accelerationTrigger":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("accelerationTrigger"))
-			data, err := ec.unmarshalOAccelerationTrigger2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx, v)
-			if err != nil {
-				return it, err
+		case "parentShareClassID":
+			out.Values[i] = ec._ShareClass_parentShareClassID(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._ShareClass_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.AccelerationTrigger = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputDilutionModelInput(ctx context.Context, obj any) (model.DilutionModelInput, error) {
-	var it model.DilutionModelInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"companyID", "roundName", "preMoneyValuation", "amountRaised", "newShareClass", "investorName"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+	return out
+}
+
+var stakeholderImplementors = []string{"Stakeholder"}
+
+func (ec *executionContext) _Stakeholder(ctx context.Context, sel ast.SelectionSet, obj *model.Stakeholder) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, stakeholderImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Stakeholder")
+		case "id":
+			out.Values[i] = ec._Stakeholder_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "companyID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._Stakeholder_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CompanyID = data
-		case "roundName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roundName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "name":
+			out.Values[i] = ec._Stakeholder_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.RoundName = data
-		case "preMoneyValuation":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preMoneyValuation"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		case "email":
+			out.Values[i] = ec._Stakeholder_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PreMoneyValuation = data
-		case "amountRaised":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amountRaised"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		case "role":
+			out.Values[i] = ec._Stakeholder_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.AmountRaised = data
-		case "newShareClass":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newShareClass"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "terminationDate":
+			out.Values[i] = ec._Stakeholder_terminationDate(ctx, field, obj)
+		case "terminationReason":
+			out.Values[i] = ec._Stakeholder_terminationReason(ctx, field, obj)
+		case "grants":
+			out.Values[i] = ec._Stakeholder_grants(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.NewShareClass = data
-		case "investorName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("investorName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "leaves":
+			out.Values[i] = ec._Stakeholder_leaves(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.InvestorName = data
+		case "mfnNotices":
+			out.Values[i] = ec._Stakeholder_mfnNotices(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._Stakeholder_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputIssueGrantInput(ctx context.Context, obj any) (model.IssueGrantInput, error) {
-	var it model.IssueGrantInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"companyID", "stakeholderID", "shareClassID", "vestingScheduleID", "quantity", "grantDate", "exercisePrice", "notes"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "companyID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CompanyID = data
-		case "stakeholderID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var vestEventImplementors = []string{"VestEvent"}
+
+func (ec *executionContext) _VestEvent(ctx context.Context, sel ast.SelectionSet, obj *model.VestEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vestEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VestEvent")
+		case "date":
+			out.Values[i] = ec._VestEvent_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.StakeholderID = data
-		case "shareClassID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shareClassID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "shares":
+			out.Values[i] = ec._VestEvent_shares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ShareClassID = data
-		case "vestingScheduleID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vestingScheduleID"))
-			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "cumulativeVested":
+			out.Values[i] = ec._VestEvent_cumulativeVested(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.VestingScheduleID = data
-		case "quantity":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var vestingForecastLineImplementors = []string{"VestingForecastLine"}
+
+func (ec *executionContext) _VestingForecastLine(ctx context.Context, sel ast.SelectionSet, obj *model.VestingForecastLine) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vestingForecastLineImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VestingForecastLine")
+		case "shareClassID":
+			out.Values[i] = ec._VestingForecastLine_shareClassID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Quantity = data
-		case "grantDate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grantDate"))
-			data, err := ec.unmarshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
-			if err != nil {
-				return it, err
+		case "shareClassName":
+			out.Values[i] = ec._VestingForecastLine_shareClassName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.GrantDate = data
-		case "exercisePrice":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("exercisePrice"))
-			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		case "role":
+			out.Values[i] = ec._VestingForecastLine_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ExercisePrice = data
-		case "notes":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notes"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "vestedShares":
+			out.Values[i] = ec._VestingForecastLine_vestedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Notes = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputIssueSAFEInput(ctx context.Context, obj any) (model.IssueSAFEInput, error) {
-	var it model.IssueSAFEInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var vestingForecastPeriodImplementors = []string{"VestingForecastPeriod"}
 
-	fieldsInOrder := [...]string{"companyID", "stakeholderID", "investmentAmount", "valuationCap", "discountRate", "safeType", "issueDate"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "companyID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CompanyID = data
-		case "stakeholderID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stakeholderID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.StakeholderID = data
-		case "investmentAmount":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("investmentAmount"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.InvestmentAmount = data
-		case "valuationCap":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("valuationCap"))
-			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _VestingForecastPeriod(ctx context.Context, sel ast.SelectionSet, obj *model.VestingForecastPeriod) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vestingForecastPeriodImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VestingForecastPeriod")
+		case "periodStart":
+			out.Values[i] = ec._VestingForecastPeriod_periodStart(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ValuationCap = data
-		case "discountRate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("discountRate"))
-			data, err := ec.unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		case "periodEnd":
+			out.Values[i] = ec._VestingForecastPeriod_periodEnd(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.DiscountRate = data
-		case "safeType":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("safeType"))
-			data, err := ec.unmarshalNSAFEType2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType(ctx, v)
-			if err != nil {
-				return it, err
+		case "vestedShares":
+			out.Values[i] = ec._VestingForecastPeriod_vestedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.SafeType = data
-		case "issueDate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("issueDate"))
-			data, err := ec.unmarshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
-			if err != nil {
-				return it, err
+		case "breakdown":
+			out.Values[i] = ec._VestingForecastPeriod_breakdown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.IssueDate = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputRecordFundingRoundInput(ctx context.Context, obj any) (model.RecordFundingRoundInput, error) {
-	var it model.RecordFundingRoundInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"companyID", "name", "preMoneyValuation", "amountRaised", "pricePerShare", "shareClassID", "roundDate"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+	return out
+}
+
+var vestingScheduleImplementors = []string{"VestingSchedule"}
+
+func (ec *executionContext) _VestingSchedule(ctx context.Context, sel ast.SelectionSet, obj *model.VestingSchedule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vestingScheduleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VestingSchedule")
+		case "id":
+			out.Values[i] = ec._VestingSchedule_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "companyID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("companyID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._VestingSchedule_companyID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CompanyID = data
 		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._VestingSchedule_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
-		case "preMoneyValuation":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preMoneyValuation"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		case "cliffMonths":
+			out.Values[i] = ec._VestingSchedule_cliffMonths(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PreMoneyValuation = data
-		case "amountRaised":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amountRaised"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		case "totalMonths":
+			out.Values[i] = ec._VestingSchedule_totalMonths(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.AmountRaised = data
-		case "pricePerShare":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pricePerShare"))
-			data, err := ec.unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, v)
-			if err != nil {
-				return it, err
+		case "frequency":
+			out.Values[i] = ec._VestingSchedule_frequency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PricePerShare = data
-		case "shareClassID":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shareClassID"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "accelerationTrigger":
+			out.Values[i] = ec._VestingSchedule_accelerationTrigger(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ShareClassID = data
-		case "roundDate":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("roundDate"))
-			data, err := ec.unmarshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx, v)
-			if err != nil {
-				return it, err
+		case "accelerationPercent":
+			out.Values[i] = ec._VestingSchedule_accelerationPercent(ctx, field, obj)
+		case "accelerationMonths":
+			out.Values[i] = ec._VestingSchedule_accelerationMonths(ctx, field, obj)
+		case "accelerationWindowMonths":
+			out.Values[i] = ec._VestingSchedule_accelerationWindowMonths(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.RoundDate = data
+		case "tranches":
+			out.Values[i] = ec._VestingSchedule_tranches(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rounding":
+			out.Values[i] = ec._VestingSchedule_rounding(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "archivedAt":
+			out.Values[i] = ec._VestingSchedule_archivedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var capTableEntryImplementors = []string{"CapTableEntry"}
+var vestingStatusImplementors = []string{"VestingStatus"}
 
-func (ec *executionContext) _CapTableEntry(ctx context.Context, sel ast.SelectionSet, obj *model.CapTableEntry) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, capTableEntryImplementors)
+func (ec *executionContext) _VestingStatus(ctx context.Context, sel ast.SelectionSet, obj *model.VestingStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vestingStatusImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CapTableEntry")
-		case "stakeholderID":
-			out.Values[i] = ec._CapTableEntry_stakeholderID(ctx, field, obj)
-		case "stakeholderName":
-			out.Values[i] = ec._CapTableEntry_stakeholderName(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("VestingStatus")
+		case "grantID":
+			out.Values[i] = ec._VestingStatus_grantID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "asOfDate":
+			out.Values[i] = ec._VestingStatus_asOfDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalShares":
+			out.Values[i] = ec._VestingStatus_totalShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "vestedShares":
+			out.Values[i] = ec._VestingStatus_vestedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unvestedShares":
+			out.Values[i] = ec._VestingStatus_unvestedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "percentVested":
+			out.Values[i] = ec._VestingStatus_percentVested(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cliffDate":
+			out.Values[i] = ec._VestingStatus_cliffDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fullyVestedAt":
+			out.Values[i] = ec._VestingStatus_fullyVestedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "shareClassName":
-			out.Values[i] = ec._CapTableEntry_shareClassName(ctx, field, obj)
+		case "isFullyVested":
+			out.Values[i] = ec._VestingStatus_isFullyVested(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "shares":
-			out.Values[i] = ec._CapTableEntry_shares(ctx, field, obj)
+		case "acceleratedShares":
+			out.Values[i] = ec._VestingStatus_acceleratedShares(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "ownershipPct":
-			out.Values[i] = ec._CapTableEntry_ownershipPct(ctx, field, obj)
+		case "exercisedShares":
+			out.Values[i] = ec._VestingStatus_exercisedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "releasedShares":
+			out.Values[i] = ec._VestingStatus_releasedShares(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "repurchasableShares":
+			out.Values[i] = ec._VestingStatus_repurchasableShares(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7280,29 +18842,26 @@ func (ec *executionContext) _CapTableEntry(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var capTableSnapshotImplementors = []string{"CapTableSnapshot"}
+var vestingTrancheImplementors = []string{"VestingTranche"}
 
-func (ec *executionContext) _CapTableSnapshot(ctx context.Context, sel ast.SelectionSet, obj *model.CapTableSnapshot) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, capTableSnapshotImplementors)
+func (ec *executionContext) _VestingTranche(ctx context.Context, sel ast.SelectionSet, obj *model.VestingTranche) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vestingTrancheImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CapTableSnapshot")
-		case "companyID":
-			out.Values[i] = ec._CapTableSnapshot_companyID(ctx, field, obj)
-		case "totalShares":
-			out.Values[i] = ec._CapTableSnapshot_totalShares(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "entries":
-			out.Values[i] = ec._CapTableSnapshot_entries(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("VestingTranche")
+		case "offsetMonths":
+			out.Values[i] = ec._VestingTranche_offsetMonths(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "percent":
+			out.Values[i] = ec._VestingTranche_percent(ctx, field, obj)
+		case "shares":
+			out.Values[i] = ec._VestingTranche_shares(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7326,57 +18885,49 @@ func (ec *executionContext) _CapTableSnapshot(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var companyImplementors = []string{"Company"}
+var waterfallPayoutImplementors = []string{"WaterfallPayout"}
 
-func (ec *executionContext) _Company(ctx context.Context, sel ast.SelectionSet, obj *model.Company) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, companyImplementors)
+func (ec *executionContext) _WaterfallPayout(ctx context.Context, sel ast.SelectionSet, obj *model.WaterfallPayout) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, waterfallPayoutImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Company")
-		case "id":
-			out.Values[i] = ec._Company_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._Company_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "stakeholders":
-			out.Values[i] = ec._Company_stakeholders(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("WaterfallPayout")
+		case "stakeholderID":
+			out.Values[i] = ec._WaterfallPayout_stakeholderID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "shareClasses":
-			out.Values[i] = ec._Company_shareClasses(ctx, field, obj)
+		case "stakeholderName":
+			out.Values[i] = ec._WaterfallPayout_stakeholderName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "grants":
-			out.Values[i] = ec._Company_grants(ctx, field, obj)
+		case "shareClassName":
+			out.Values[i] = ec._WaterfallPayout_shareClassName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "fundingRounds":
-			out.Values[i] = ec._Company_fundingRounds(ctx, field, obj)
+		case "shares":
+			out.Values[i] = ec._WaterfallPayout_shares(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "safeNotes":
-			out.Values[i] = ec._Company_safeNotes(ctx, field, obj)
+		case "payout":
+			out.Values[i] = ec._WaterfallPayout_payout(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._Company_createdAt(ctx, field, obj)
+		case "payoutPerShare":
+			out.Values[i] = ec._WaterfallPayout_payoutPerShare(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "claimElection":
+			out.Values[i] = ec._WaterfallPayout_claimElection(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7400,34 +18951,29 @@ func (ec *executionContext) _Company(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var dilutionResultImplementors = []string{"DilutionResult"}
+var waterfallResultImplementors = []string{"WaterfallResult"}
 
-func (ec *executionContext) _DilutionResult(ctx context.Context, sel ast.SelectionSet, obj *model.DilutionResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dilutionResultImplementors)
+func (ec *executionContext) _WaterfallResult(ctx context.Context, sel ast.SelectionSet, obj *model.WaterfallResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, waterfallResultImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DilutionResult")
-		case "preRound":
-			out.Values[i] = ec._DilutionResult_preRound(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "postRound":
-			out.Values[i] = ec._DilutionResult_postRound(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("WaterfallResult")
+		case "exitValuation":
+			out.Values[i] = ec._WaterfallResult_exitValuation(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "newInvestor":
-			out.Values[i] = ec._DilutionResult_newInvestor(ctx, field, obj)
+		case "totalPayout":
+			out.Values[i] = ec._WaterfallResult_totalPayout(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "roundName":
-			out.Values[i] = ec._DilutionResult_roundName(ctx, field, obj)
+		case "payouts":
+			out.Values[i] = ec._WaterfallResult_payouts(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7454,59 +19000,36 @@ func (ec *executionContext) _DilutionResult(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var fundingRoundImplementors = []string{"FundingRound"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _FundingRound(ctx context.Context, sel ast.SelectionSet, obj *model.FundingRound) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fundingRoundImplementors)
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FundingRound")
-		case "id":
-			out.Values[i] = ec._FundingRound_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "companyID":
-			out.Values[i] = ec._FundingRound_companyID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
+			out.Values[i] = graphql.MarshalString("__Directive")
 		case "name":
-			out.Values[i] = ec._FundingRound_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "preMoneyValuation":
-			out.Values[i] = ec._FundingRound_preMoneyValuation(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "amountRaised":
-			out.Values[i] = ec._FundingRound_amountRaised(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pricePerShare":
-			out.Values[i] = ec._FundingRound_pricePerShare(ctx, field, obj)
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "shareClassID":
-			out.Values[i] = ec._FundingRound_shareClassID(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "roundDate":
-			out.Values[i] = ec._FundingRound_roundDate(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._FundingRound_createdAt(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7533,68 +19056,31 @@ func (ec *executionContext) _FundingRound(ctx context.Context, sel ast.Selection
 	return out
 }
 
-var grantImplementors = []string{"Grant"}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-func (ec *executionContext) _Grant(ctx context.Context, sel ast.SelectionSet, obj *model.Grant) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, grantImplementors)
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Grant")
-		case "id":
-			out.Values[i] = ec._Grant_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "companyID":
-			out.Values[i] = ec._Grant_companyID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "stakeholderID":
-			out.Values[i] = ec._Grant_stakeholderID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "shareClassID":
-			out.Values[i] = ec._Grant_shareClassID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "vestingScheduleID":
-			out.Values[i] = ec._Grant_vestingScheduleID(ctx, field, obj)
-		case "quantity":
-			out.Values[i] = ec._Grant_quantity(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "grantDate":
-			out.Values[i] = ec._Grant_grantDate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "exercisePrice":
-			out.Values[i] = ec._Grant_exercisePrice(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isExercised":
-			out.Values[i] = ec._Grant_isExercised(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "notes":
-			out.Values[i] = ec._Grant_notes(ctx, field, obj)
-		case "vestingSchedule":
-			out.Values[i] = ec._Grant_vestingSchedule(ctx, field, obj)
-		case "createdAt":
-			out.Values[i] = ec._Grant_createdAt(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7618,81 +19104,41 @@ func (ec *executionContext) _Grant(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createCompany":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createCompany(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "addStakeholder":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addStakeholder(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createShareClass":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createShareClass(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createVestingSchedule":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createVestingSchedule(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "issueGrant":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_issueGrant(ctx, field)
-			})
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "recordFundingRound":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_recordFundingRound(ctx, field)
-			})
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "issueSAFE":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_issueSAFE(ctx, field)
-			})
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "convertSAFE":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_convertSAFE(ctx, field)
-			})
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7716,159 +19162,38 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "company":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_company(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "stakeholder":
-			field := field
-
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_stakeholder(ctx, field)
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "vestingStatus":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_vestingStatus(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "capTable":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_capTable(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "modelDilution":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_modelDilution(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "waterfall":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_waterfall(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7892,37 +19217,97 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var sAFEConversionResultImplementors = []string{"SAFEConversionResult"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _SAFEConversionResult(ctx context.Context, sel ast.SelectionSet, obj *model.SAFEConversionResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sAFEConversionResultImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SAFEConversionResult")
-		case "safeID":
-			out.Values[i] = ec._SAFEConversionResult_safeID(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "sharesIssued":
-			out.Values[i] = ec._SAFEConversionResult_sharesIssued(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "effectivePPS":
-			out.Values[i] = ec._SAFEConversionResult_effectivePPS(ctx, field, obj)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "conversionMethod":
-			out.Values[i] = ec._SAFEConversionResult_conversionMethod(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7946,856 +19331,1114 @@ func (ec *executionContext) _SAFEConversionResult(ctx context.Context, sel ast.S
 	return out
 }
 
-var sAFENoteImplementors = []string{"SAFENote"}
+// endregion **************************** object.gotpl ****************************
 
-func (ec *executionContext) _SAFENote(ctx context.Context, sel ast.SelectionSet, obj *model.SAFENote) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sAFENoteImplementors)
+// region    ***************************** type.gotpl *****************************
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("SAFENote")
-		case "id":
-			out.Values[i] = ec._SAFENote_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "companyID":
-			out.Values[i] = ec._SAFENote_companyID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "stakeholderID":
-			out.Values[i] = ec._SAFENote_stakeholderID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "investmentAmount":
-			out.Values[i] = ec._SAFENote_investmentAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "valuationCap":
-			out.Values[i] = ec._SAFENote_valuationCap(ctx, field, obj)
-		case "discountRate":
-			out.Values[i] = ec._SAFENote_discountRate(ctx, field, obj)
-		case "safeType":
-			out.Values[i] = ec._SAFENote_safeType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isConverted":
-			out.Values[i] = ec._SAFENote_isConverted(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "convertedInRound":
-			out.Values[i] = ec._SAFENote_convertedInRound(ctx, field, obj)
-		case "issueDate":
-			out.Values[i] = ec._SAFENote_issueDate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) unmarshalNAccelerationTrigger2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, v any) (model.AccelerationTrigger, error) {
+	var res model.AccelerationTrigger
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAccelerationTrigger2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, sel ast.SelectionSet, v model.AccelerationTrigger) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNAddStakeholderInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAddStakeholderInput(ctx context.Context, v any) (model.AddStakeholderInput, error) {
+	res, err := ec.unmarshalInputAddStakeholderInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CapTableEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "createdAt":
-			out.Values[i] = ec._SAFENote_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			ret[i] = ec.marshalNCapTableEntry2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCapTableEntry2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntry(ctx context.Context, sel ast.SelectionSet, v *model.CapTableEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CapTableEntry(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCapTableSnapshot2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot(ctx context.Context, sel ast.SelectionSet, v model.CapTableSnapshot) graphql.Marshaler {
+	return ec._CapTableSnapshot(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCapTableSnapshot2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot(ctx context.Context, sel ast.SelectionSet, v *model.CapTableSnapshot) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CapTableSnapshot(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCapitalizationDefinition2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalizationDefinition(ctx context.Context, v any) (model.CapitalizationDefinition, error) {
+	var res model.CapitalizationDefinition
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCapitalizationDefinition2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalizationDefinition(ctx context.Context, sel ast.SelectionSet, v model.CapitalizationDefinition) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNCompany2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany(ctx context.Context, sel ast.SelectionSet, v model.Company) graphql.Marshaler {
+	return ec._Company(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCompany2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany(ctx context.Context, sel ast.SelectionSet, v *model.Company) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Company(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNConvertibleNote2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐConvertibleNote(ctx context.Context, sel ast.SelectionSet, v model.ConvertibleNote) graphql.Marshaler {
+	return ec._ConvertibleNote(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNConvertibleNote2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐConvertibleNoteᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ConvertibleNote) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNConvertibleNote2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐConvertibleNote(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNConvertibleNote2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐConvertibleNote(ctx context.Context, sel ast.SelectionSet, v *model.ConvertibleNote) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ConvertibleNote(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCreateCompanyInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateCompanyInput(ctx context.Context, v any) (model.CreateCompanyInput, error) {
+	res, err := ec.unmarshalInputCreateCompanyInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateOptionPoolInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateOptionPoolInput(ctx context.Context, v any) (model.CreateOptionPoolInput, error) {
+	res, err := ec.unmarshalInputCreateOptionPoolInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateShareClassInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateShareClassInput(ctx context.Context, v any) (model.CreateShareClassInput, error) {
+	res, err := ec.unmarshalInputCreateShareClassInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNCreateVestingScheduleInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateVestingScheduleInput(ctx context.Context, v any) (model.CreateVestingScheduleInput, error) {
+	res, err := ec.unmarshalInputCreateVestingScheduleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx context.Context, v any) (model.Date, error) {
+	var res model.Date
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDate2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx context.Context, sel ast.SelectionSet, v model.Date) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime(ctx context.Context, v any) (model.DateTime, error) {
+	var res model.DateTime
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDateTime2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime(ctx context.Context, sel ast.SelectionSet, v model.DateTime) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDayCount2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDayCount(ctx context.Context, v any) (model.DayCount, error) {
+	var res model.DayCount
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDayCount2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDayCount(ctx context.Context, sel ast.SelectionSet, v model.DayCount) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, v any) (model.Decimal, error) {
+	var res model.Decimal
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDecimal2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, sel ast.SelectionSet, v model.Decimal) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDecimal2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimalᚄ(ctx context.Context, v any) ([]*model.Decimal, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.Decimal, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNDecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNDecimal2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimalᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Decimal) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNDecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx, sel, v[i])
+	}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var shareClassImplementors = []string{"ShareClass"}
+func (ec *executionContext) unmarshalNDecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, v any) (*model.Decimal, error) {
+	var res = new(model.Decimal)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _ShareClass(ctx context.Context, sel ast.SelectionSet, obj *model.ShareClass) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, shareClassImplementors)
+func (ec *executionContext) marshalNDecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, sel ast.SelectionSet, v *model.Decimal) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ShareClass")
-		case "id":
-			out.Values[i] = ec._ShareClass_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "companyID":
-			out.Values[i] = ec._ShareClass_companyID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._ShareClass_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isPreferred":
-			out.Values[i] = ec._ShareClass_isPreferred(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "liquidationMultiple":
-			out.Values[i] = ec._ShareClass_liquidationMultiple(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isParticipating":
-			out.Values[i] = ec._ShareClass_isParticipating(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "participationCap":
-			out.Values[i] = ec._ShareClass_participationCap(ctx, field, obj)
-		case "pricePerShare":
-			out.Values[i] = ec._ShareClass_pricePerShare(ctx, field, obj)
-		case "seniority":
-			out.Values[i] = ec._ShareClass_seniority(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "authorizedShares":
-			out.Values[i] = ec._ShareClass_authorizedShares(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._ShareClass_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) unmarshalNDilutionModelInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionModelInput(ctx context.Context, v any) (model.DilutionModelInput, error) {
+	res, err := ec.unmarshalInputDilutionModelInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDilutionResult2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResult(ctx context.Context, sel ast.SelectionSet, v model.DilutionResult) graphql.Marshaler {
+	return ec._DilutionResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDilutionResult2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DilutionResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNDilutionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDilutionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResult(ctx context.Context, sel ast.SelectionSet, v *model.DilutionResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._DilutionResult(ctx, sel, v)
 }
 
-var stakeholderImplementors = []string{"Stakeholder"}
+func (ec *executionContext) unmarshalNExerciseGrantInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐExerciseGrantInput(ctx context.Context, v any) (model.ExerciseGrantInput, error) {
+	res, err := ec.unmarshalInputExerciseGrantInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _Stakeholder(ctx context.Context, sel ast.SelectionSet, obj *model.Stakeholder) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, stakeholderImplementors)
+func (ec *executionContext) marshalNFundingRound2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound(ctx context.Context, sel ast.SelectionSet, v model.FundingRound) graphql.Marshaler {
+	return ec._FundingRound(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Stakeholder")
-		case "id":
-			out.Values[i] = ec._Stakeholder_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "companyID":
-			out.Values[i] = ec._Stakeholder_companyID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._Stakeholder_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "email":
-			out.Values[i] = ec._Stakeholder_email(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "role":
-			out.Values[i] = ec._Stakeholder_role(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "grants":
-			out.Values[i] = ec._Stakeholder_grants(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._Stakeholder_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNFundingRound2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRoundᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FundingRound) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNFundingRound2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNFundingRound2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound(ctx context.Context, sel ast.SelectionSet, v *model.FundingRound) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._FundingRound(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNGrant2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant(ctx context.Context, sel ast.SelectionSet, v model.Grant) graphql.Marshaler {
+	return ec._Grant(ctx, sel, &v)
 }
 
-var vestingScheduleImplementors = []string{"VestingSchedule"}
+func (ec *executionContext) marshalNGrant2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Grant) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGrant2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) _VestingSchedule(ctx context.Context, sel ast.SelectionSet, obj *model.VestingSchedule) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, vestingScheduleImplementors)
+	}
+	wg.Wait()
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("VestingSchedule")
-		case "id":
-			out.Values[i] = ec._VestingSchedule_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "cliffMonths":
-			out.Values[i] = ec._VestingSchedule_cliffMonths(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalMonths":
-			out.Values[i] = ec._VestingSchedule_totalMonths(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "frequency":
-			out.Values[i] = ec._VestingSchedule_frequency(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "accelerationTrigger":
-			out.Values[i] = ec._VestingSchedule_accelerationTrigger(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNGrant2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant(ctx context.Context, sel ast.SelectionSet, v *model.Grant) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._Grant(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNGrantExercise2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantExerciseᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.GrantExercise) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGrantExercise2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantExercise(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var vestingStatusImplementors = []string{"VestingStatus"}
+func (ec *executionContext) marshalNGrantExercise2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantExercise(ctx context.Context, sel ast.SelectionSet, v *model.GrantExercise) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._GrantExercise(ctx, sel, v)
+}
 
-func (ec *executionContext) _VestingStatus(ctx context.Context, sel ast.SelectionSet, obj *model.VestingStatus) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, vestingStatusImplementors)
+func (ec *executionContext) marshalNGrantMilestone2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestone(ctx context.Context, sel ast.SelectionSet, v model.GrantMilestone) graphql.Marshaler {
+	return ec._GrantMilestone(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("VestingStatus")
-		case "grantID":
-			out.Values[i] = ec._VestingStatus_grantID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "asOfDate":
-			out.Values[i] = ec._VestingStatus_asOfDate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalShares":
-			out.Values[i] = ec._VestingStatus_totalShares(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "vestedShares":
-			out.Values[i] = ec._VestingStatus_vestedShares(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "unvestedShares":
-			out.Values[i] = ec._VestingStatus_unvestedShares(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "percentVested":
-			out.Values[i] = ec._VestingStatus_percentVested(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "cliffDate":
-			out.Values[i] = ec._VestingStatus_cliffDate(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fullyVestedAt":
-			out.Values[i] = ec._VestingStatus_fullyVestedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isFullyVested":
-			out.Values[i] = ec._VestingStatus_isFullyVested(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNGrantMilestone2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestoneᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.GrantMilestone) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNGrantMilestone2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestone(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNGrantMilestone2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestone(ctx context.Context, sel ast.SelectionSet, v *model.GrantMilestone) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._GrantMilestone(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNGrantMilestoneInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestoneInput(ctx context.Context, v any) (*model.GrantMilestoneInput, error) {
+	res, err := ec.unmarshalInputGrantMilestoneInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-var waterfallPayoutImplementors = []string{"WaterfallPayout"}
+func (ec *executionContext) unmarshalNGrantType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantType(ctx context.Context, v any) (model.GrantType, error) {
+	var res model.GrantType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _WaterfallPayout(ctx context.Context, sel ast.SelectionSet, obj *model.WaterfallPayout) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, waterfallPayoutImplementors)
+func (ec *executionContext) marshalNGrantType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantType(ctx context.Context, sel ast.SelectionSet, v model.GrantType) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("WaterfallPayout")
-		case "stakeholderID":
-			out.Values[i] = ec._WaterfallPayout_stakeholderID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "stakeholderName":
-			out.Values[i] = ec._WaterfallPayout_stakeholderName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "shareClassName":
-			out.Values[i] = ec._WaterfallPayout_shareClassName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "shares":
-			out.Values[i] = ec._WaterfallPayout_shares(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "payout":
-			out.Values[i] = ec._WaterfallPayout_payout(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "payoutPerShare":
-			out.Values[i] = ec._WaterfallPayout_payoutPerShare(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return res
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
+	return res
+}
 
-	return out
+func (ec *executionContext) unmarshalNInterestType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐInterestType(ctx context.Context, v any) (model.InterestType, error) {
+	var res model.InterestType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var waterfallResultImplementors = []string{"WaterfallResult"}
+func (ec *executionContext) marshalNInterestType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐInterestType(ctx context.Context, sel ast.SelectionSet, v model.InterestType) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) _WaterfallResult(ctx context.Context, sel ast.SelectionSet, obj *model.WaterfallResult) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, waterfallResultImplementors)
+func (ec *executionContext) unmarshalNIssueConvertibleNoteInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueConvertibleNoteInput(ctx context.Context, v any) (model.IssueConvertibleNoteInput, error) {
+	res, err := ec.unmarshalInputIssueConvertibleNoteInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("WaterfallResult")
-		case "exitValuation":
-			out.Values[i] = ec._WaterfallResult_exitValuation(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalPayout":
-			out.Values[i] = ec._WaterfallResult_totalPayout(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "payouts":
-			out.Values[i] = ec._WaterfallResult_payouts(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) unmarshalNIssueGrantInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueGrantInput(ctx context.Context, v any) (model.IssueGrantInput, error) {
+	res, err := ec.unmarshalInputIssueGrantInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNIssueSAFEInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueSAFEInput(ctx context.Context, v any) (model.IssueSAFEInput, error) {
+	res, err := ec.unmarshalInputIssueSAFEInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLeaveOfAbsence2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐLeaveOfAbsence(ctx context.Context, sel ast.SelectionSet, v model.LeaveOfAbsence) graphql.Marshaler {
+	return ec._LeaveOfAbsence(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLeaveOfAbsence2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐLeaveOfAbsenceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LeaveOfAbsence) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNLeaveOfAbsence2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐLeaveOfAbsence(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNLeaveOfAbsence2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐLeaveOfAbsence(ctx context.Context, sel ast.SelectionSet, v *model.LeaveOfAbsence) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LeaveOfAbsence(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNMFNNotice2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMFNNoticeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MFNNotice) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNMFNNotice2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMFNNotice(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNMFNNotice2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMFNNotice(ctx context.Context, sel ast.SelectionSet, v *model.MFNNotice) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._MFNNotice(ctx, sel, v)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) unmarshalNMilestoneCondition2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMilestoneCondition(ctx context.Context, v any) (model.MilestoneCondition, error) {
+	var res model.MilestoneCondition
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNMilestoneCondition2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMilestoneCondition(ctx context.Context, sel ast.SelectionSet, v model.MilestoneCondition) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNModeledRoundInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐModeledRoundInputᚄ(ctx context.Context, v any) ([]*model.ModeledRoundInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.ModeledRoundInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNModeledRoundInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐModeledRoundInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return res, nil
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNModeledRoundInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐModeledRoundInput(ctx context.Context, v any) (*model.ModeledRoundInput, error) {
+	res, err := ec.unmarshalInputModeledRoundInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNNoteAccrual2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteAccrual(ctx context.Context, sel ast.SelectionSet, v model.NoteAccrual) graphql.Marshaler {
+	return ec._NoteAccrual(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNNoteAccrual2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteAccrual(ctx context.Context, sel ast.SelectionSet, v *model.NoteAccrual) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._NoteAccrual(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNNoteSettlement2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteSettlement(ctx context.Context, sel ast.SelectionSet, v model.NoteSettlement) graphql.Marshaler {
+	return ec._NoteSettlement(ctx, sel, &v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) marshalNNoteSettlement2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteSettlement(ctx context.Context, sel ast.SelectionSet, v *model.NoteSettlement) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._NoteSettlement(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNOptionPool2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOptionPool(ctx context.Context, sel ast.SelectionSet, v model.OptionPool) graphql.Marshaler {
+	return ec._OptionPool(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNOptionPool2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOptionPoolᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OptionPool) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNOptionPool2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOptionPool(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalNOptionPool2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOptionPool(ctx context.Context, sel ast.SelectionSet, v *model.OptionPool) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OptionPool(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNOwnershipTrajectory2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOwnershipTrajectoryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OwnershipTrajectory) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNOwnershipTrajectory2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOwnershipTrajectory(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNOwnershipTrajectory2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐOwnershipTrajectory(ctx context.Context, sel ast.SelectionSet, v *model.OwnershipTrajectory) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._OwnershipTrajectory(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNRecordFundingRoundInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRecordFundingRoundInput(ctx context.Context, v any) (model.RecordFundingRoundInput, error) {
+	res, err := ec.unmarshalInputRecordFundingRoundInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNRecordLeaveInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRecordLeaveInput(ctx context.Context, v any) (model.RecordLeaveInput, error) {
+	res, err := ec.unmarshalInputRecordLeaveInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) unmarshalNRepurchaseSharesInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRepurchaseSharesInput(ctx context.Context, v any) (model.RepurchaseSharesInput, error) {
+	res, err := ec.unmarshalInputRepurchaseSharesInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) unmarshalNRoundAllocationInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundAllocationInput(ctx context.Context, v any) (*model.RoundAllocationInput, error) {
+	res, err := ec.unmarshalInputRoundAllocationInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalNRoundSequenceResult2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundSequenceResult(ctx context.Context, sel ast.SelectionSet, v model.RoundSequenceResult) graphql.Marshaler {
+	return ec._RoundSequenceResult(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNRoundSequenceResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundSequenceResult(ctx context.Context, sel ast.SelectionSet, v *model.RoundSequenceResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._RoundSequenceResult(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNSAFEConversionPreview2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionPreviewᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SAFEConversionPreview) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSAFEConversionPreview2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionPreview(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	return out
-}
-
-// endregion **************************** object.gotpl ****************************
+	}
+	wg.Wait()
 
-// region    ***************************** type.gotpl *****************************
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-func (ec *executionContext) unmarshalNAccelerationTrigger2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, v any) (model.AccelerationTrigger, error) {
-	var res model.AccelerationTrigger
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNAccelerationTrigger2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, sel ast.SelectionSet, v model.AccelerationTrigger) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNSAFEConversionPreview2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionPreview(ctx context.Context, sel ast.SelectionSet, v *model.SAFEConversionPreview) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SAFEConversionPreview(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNAddStakeholderInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAddStakeholderInput(ctx context.Context, v any) (model.AddStakeholderInput, error) {
-	res, err := ec.unmarshalInputAddStakeholderInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNSAFEConversionResult2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult(ctx context.Context, sel ast.SelectionSet, v model.SAFEConversionResult) graphql.Marshaler {
+	return ec._SAFEConversionResult(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNSAFEConversionResult2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SAFEConversionResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSAFEConversionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNSAFEConversionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult(ctx context.Context, sel ast.SelectionSet, v *model.SAFEConversionResult) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
+	}
+	return ec._SAFEConversionResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSAFEConversionScenarioInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionScenarioInputᚄ(ctx context.Context, v any) ([]*model.SAFEConversionScenarioInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.SAFEConversionScenarioInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSAFEConversionScenarioInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionScenarioInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return res
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNSAFEConversionScenarioInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionScenarioInput(ctx context.Context, v any) (*model.SAFEConversionScenarioInput, error) {
+	res, err := ec.unmarshalInputSAFEConversionScenarioInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CapTableEntry) graphql.Marshaler {
+func (ec *executionContext) marshalNSAFEHolderOwnership2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEHolderOwnershipᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SAFEHolderOwnership) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8819,7 +20462,7 @@ func (ec *executionContext) marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋjoshtyler
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCapTableEntry2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntry(ctx, sel, v[i])
+			ret[i] = ec.marshalNSAFEHolderOwnership2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEHolderOwnership(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8839,113 +20482,94 @@ func (ec *executionContext) marshalNCapTableEntry2ᚕᚖgithubᚗcomᚋjoshtyler
 	return ret
 }
 
-func (ec *executionContext) marshalNCapTableEntry2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableEntry(ctx context.Context, sel ast.SelectionSet, v *model.CapTableEntry) graphql.Marshaler {
+func (ec *executionContext) marshalNSAFEHolderOwnership2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEHolderOwnership(ctx context.Context, sel ast.SelectionSet, v *model.SAFEHolderOwnership) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CapTableEntry(ctx, sel, v)
+	return ec._SAFEHolderOwnership(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCapTableSnapshot2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot(ctx context.Context, sel ast.SelectionSet, v model.CapTableSnapshot) graphql.Marshaler {
-	return ec._CapTableSnapshot(ctx, sel, &v)
+func (ec *executionContext) marshalNSAFENote2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote(ctx context.Context, sel ast.SelectionSet, v model.SAFENote) graphql.Marshaler {
+	return ec._SAFENote(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCapTableSnapshot2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableSnapshot(ctx context.Context, sel ast.SelectionSet, v *model.CapTableSnapshot) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) marshalNSAFENote2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENoteᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SAFENote) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		return graphql.Null
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSAFENote2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return ec._CapTableSnapshot(ctx, sel, v)
-}
+	wg.Wait()
 
-func (ec *executionContext) marshalNCompany2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany(ctx context.Context, sel ast.SelectionSet, v model.Company) graphql.Marshaler {
-	return ec._Company(ctx, sel, &v)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNCompany2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany(ctx context.Context, sel ast.SelectionSet, v *model.Company) graphql.Marshaler {
+func (ec *executionContext) marshalNSAFENote2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote(ctx context.Context, sel ast.SelectionSet, v *model.SAFENote) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Company(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNCreateCompanyInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateCompanyInput(ctx context.Context, v any) (model.CreateCompanyInput, error) {
-	res, err := ec.unmarshalInputCreateCompanyInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCreateShareClassInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateShareClassInput(ctx context.Context, v any) (model.CreateShareClassInput, error) {
-	res, err := ec.unmarshalInputCreateShareClassInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNCreateVestingScheduleInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCreateVestingScheduleInput(ctx context.Context, v any) (model.CreateVestingScheduleInput, error) {
-	res, err := ec.unmarshalInputCreateVestingScheduleInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx context.Context, v any) (model.Date, error) {
-	var res model.Date
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNDate2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx context.Context, sel ast.SelectionSet, v model.Date) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime(ctx context.Context, v any) (model.DateTime, error) {
-	var res model.DateTime
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNDateTime2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime(ctx context.Context, sel ast.SelectionSet, v model.DateTime) graphql.Marshaler {
-	return v
+	return ec._SAFENote(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, v any) (model.Decimal, error) {
-	var res model.Decimal
+func (ec *executionContext) unmarshalNSAFEType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType(ctx context.Context, v any) (model.SAFEType, error) {
+	var res model.SAFEType
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDecimal2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, sel ast.SelectionSet, v model.Decimal) graphql.Marshaler {
+func (ec *executionContext) marshalNSAFEType2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType(ctx context.Context, sel ast.SelectionSet, v model.SAFEType) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNDilutionModelInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionModelInput(ctx context.Context, v any) (model.DilutionModelInput, error) {
-	res, err := ec.unmarshalInputDilutionModelInput(ctx, v)
+func (ec *executionContext) unmarshalNSettleNoteAtMaturityInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSettleNoteAtMaturityInput(ctx context.Context, v any) (model.SettleNoteAtMaturityInput, error) {
+	res, err := ec.unmarshalInputSettleNoteAtMaturityInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDilutionResult2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResult(ctx context.Context, sel ast.SelectionSet, v model.DilutionResult) graphql.Marshaler {
-	return ec._DilutionResult(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNDilutionResult2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDilutionResult(ctx context.Context, sel ast.SelectionSet, v *model.DilutionResult) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._DilutionResult(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNFundingRound2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound(ctx context.Context, sel ast.SelectionSet, v model.FundingRound) graphql.Marshaler {
-	return ec._FundingRound(ctx, sel, &v)
+func (ec *executionContext) marshalNShareClass2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass(ctx context.Context, sel ast.SelectionSet, v model.ShareClass) graphql.Marshaler {
+	return ec._ShareClass(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNFundingRound2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRoundᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FundingRound) graphql.Marshaler {
+func (ec *executionContext) marshalNShareClass2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClassᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShareClass) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -8969,7 +20593,7 @@ func (ec *executionContext) marshalNFundingRound2ᚕᚖgithubᚗcomᚋjoshtyler
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFundingRound2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound(ctx, sel, v[i])
+			ret[i] = ec.marshalNShareClass2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -8989,21 +20613,21 @@ func (ec *executionContext) marshalNFundingRound2ᚕᚖgithubᚗcomᚋjoshtyler
 	return ret
 }
 
-func (ec *executionContext) marshalNFundingRound2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐFundingRound(ctx context.Context, sel ast.SelectionSet, v *model.FundingRound) graphql.Marshaler {
+func (ec *executionContext) marshalNShareClass2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass(ctx context.Context, sel ast.SelectionSet, v *model.ShareClass) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._FundingRound(ctx, sel, v)
+	return ec._ShareClass(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNGrant2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant(ctx context.Context, sel ast.SelectionSet, v model.Grant) graphql.Marshaler {
-	return ec._Grant(ctx, sel, &v)
+func (ec *executionContext) marshalNStakeholder2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx context.Context, sel ast.SelectionSet, v model.Stakeholder) graphql.Marshaler {
+	return ec._Stakeholder(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNGrant2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Grant) graphql.Marshaler {
+func (ec *executionContext) marshalNStakeholder2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Stakeholder) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9027,7 +20651,7 @@ func (ec *executionContext) marshalNGrant2ᚕᚖgithubᚗcomᚋjoshtylerᚋvesti
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNGrant2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant(ctx, sel, v[i])
+			ret[i] = ec.marshalNStakeholder2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9047,40 +20671,34 @@ func (ec *executionContext) marshalNGrant2ᚕᚖgithubᚗcomᚋjoshtylerᚋvesti
 	return ret
 }
 
-func (ec *executionContext) marshalNGrant2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrant(ctx context.Context, sel ast.SelectionSet, v *model.Grant) graphql.Marshaler {
+func (ec *executionContext) marshalNStakeholder2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx context.Context, sel ast.SelectionSet, v *model.Stakeholder) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Grant(ctx, sel, v)
+	return ec._Stakeholder(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalNStakeholderRole2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole(ctx context.Context, v any) (model.StakeholderRole, error) {
+	var res model.StakeholderRole
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNStakeholderRole2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole(ctx context.Context, sel ast.SelectionSet, v model.StakeholderRole) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalInt(v)
+	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
@@ -9089,40 +20707,81 @@ func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.Selecti
 	return res
 }
 
-func (ec *executionContext) unmarshalNIssueGrantInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueGrantInput(ctx context.Context, v any) (model.IssueGrantInput, error) {
-	res, err := ec.unmarshalInputIssueGrantInput(ctx, v)
+func (ec *executionContext) unmarshalNTerminateStakeholderInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminateStakeholderInput(ctx context.Context, v any) (model.TerminateStakeholderInput, error) {
+	res, err := ec.unmarshalInputTerminateStakeholderInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNIssueSAFEInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐIssueSAFEInput(ctx context.Context, v any) (model.IssueSAFEInput, error) {
-	res, err := ec.unmarshalInputIssueSAFEInput(ctx, v)
+func (ec *executionContext) unmarshalNTerminationReason2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminationReason(ctx context.Context, v any) (model.TerminationReason, error) {
+	var res model.TerminationReason
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNRecordFundingRoundInput2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐRecordFundingRoundInput(ctx context.Context, v any) (model.RecordFundingRoundInput, error) {
-	res, err := ec.unmarshalInputRecordFundingRoundInput(ctx, v)
+func (ec *executionContext) marshalNTerminationReason2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminationReason(ctx context.Context, sel ast.SelectionSet, v model.TerminationReason) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNUpdateVestingScheduleInput2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐUpdateVestingScheduleInput(ctx context.Context, v any) (model.UpdateVestingScheduleInput, error) {
+	res, err := ec.unmarshalInputUpdateVestingScheduleInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNSAFEConversionResult2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult(ctx context.Context, sel ast.SelectionSet, v model.SAFEConversionResult) graphql.Marshaler {
-	return ec._SAFEConversionResult(ctx, sel, &v)
+func (ec *executionContext) marshalNVestEvent2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.VestEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNVestEvent2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNSAFEConversionResult2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult(ctx context.Context, sel ast.SelectionSet, v *model.SAFEConversionResult) graphql.Marshaler {
+func (ec *executionContext) marshalNVestEvent2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestEvent(ctx context.Context, sel ast.SelectionSet, v *model.VestEvent) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SAFEConversionResult(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNSAFENote2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote(ctx context.Context, sel ast.SelectionSet, v model.SAFENote) graphql.Marshaler {
-	return ec._SAFENote(ctx, sel, &v)
+	return ec._VestEvent(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSAFENote2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENoteᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SAFENote) graphql.Marshaler {
+func (ec *executionContext) marshalNVestingForecastLine2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastLineᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.VestingForecastLine) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9146,7 +20805,7 @@ func (ec *executionContext) marshalNSAFENote2ᚕᚖgithubᚗcomᚋjoshtylerᚋve
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSAFENote2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote(ctx, sel, v[i])
+			ret[i] = ec.marshalNVestingForecastLine2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastLine(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9166,31 +20825,17 @@ func (ec *executionContext) marshalNSAFENote2ᚕᚖgithubᚗcomᚋjoshtylerᚋve
 	return ret
 }
 
-func (ec *executionContext) marshalNSAFENote2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFENote(ctx context.Context, sel ast.SelectionSet, v *model.SAFENote) graphql.Marshaler {
+func (ec *executionContext) marshalNVestingForecastLine2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastLine(ctx context.Context, sel ast.SelectionSet, v *model.VestingForecastLine) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SAFENote(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNSAFEType2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType(ctx context.Context, v any) (model.SAFEType, error) {
-	var res model.SAFEType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNSAFEType2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEType(ctx context.Context, sel ast.SelectionSet, v model.SAFEType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNShareClass2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass(ctx context.Context, sel ast.SelectionSet, v model.ShareClass) graphql.Marshaler {
-	return ec._ShareClass(ctx, sel, &v)
+	return ec._VestingForecastLine(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNShareClass2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClassᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShareClass) graphql.Marshaler {
+func (ec *executionContext) marshalNVestingForecastPeriod2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastPeriodᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.VestingForecastPeriod) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9214,7 +20859,7 @@ func (ec *executionContext) marshalNShareClass2ᚕᚖgithubᚗcomᚋjoshtylerᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNShareClass2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass(ctx, sel, v[i])
+			ret[i] = ec.marshalNVestingForecastPeriod2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastPeriod(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9234,21 +20879,41 @@ func (ec *executionContext) marshalNShareClass2ᚕᚖgithubᚗcomᚋjoshtylerᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNShareClass2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐShareClass(ctx context.Context, sel ast.SelectionSet, v *model.ShareClass) graphql.Marshaler {
+func (ec *executionContext) marshalNVestingForecastPeriod2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingForecastPeriod(ctx context.Context, sel ast.SelectionSet, v *model.VestingForecastPeriod) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ShareClass(ctx, sel, v)
+	return ec._VestingForecastPeriod(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNStakeholder2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx context.Context, sel ast.SelectionSet, v model.Stakeholder) graphql.Marshaler {
-	return ec._Stakeholder(ctx, sel, &v)
+func (ec *executionContext) unmarshalNVestingFrequency2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx context.Context, v any) (model.VestingFrequency, error) {
+	var res model.VestingFrequency
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNVestingFrequency2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx context.Context, sel ast.SelectionSet, v model.VestingFrequency) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNVestingRounding2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingRounding(ctx context.Context, v any) (model.VestingRounding, error) {
+	var res model.VestingRounding
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNVestingRounding2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingRounding(ctx context.Context, sel ast.SelectionSet, v model.VestingRounding) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNVestingSchedule2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule(ctx context.Context, sel ast.SelectionSet, v model.VestingSchedule) graphql.Marshaler {
+	return ec._VestingSchedule(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNStakeholder2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Stakeholder) graphql.Marshaler {
+func (ec *executionContext) marshalNVestingSchedule2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingScheduleᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.VestingSchedule) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9272,7 +20937,7 @@ func (ec *executionContext) marshalNStakeholder2ᚕᚖgithubᚗcomᚋjoshtyler
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNStakeholder2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx, sel, v[i])
+			ret[i] = ec.marshalNVestingSchedule2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9292,81 +20957,90 @@ func (ec *executionContext) marshalNStakeholder2ᚕᚖgithubᚗcomᚋjoshtyler
 	return ret
 }
 
-func (ec *executionContext) marshalNStakeholder2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx context.Context, sel ast.SelectionSet, v *model.Stakeholder) graphql.Marshaler {
+func (ec *executionContext) marshalNVestingSchedule2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule(ctx context.Context, sel ast.SelectionSet, v *model.VestingSchedule) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Stakeholder(ctx, sel, v)
+	return ec._VestingSchedule(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNStakeholderRole2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole(ctx context.Context, v any) (model.StakeholderRole, error) {
-	var res model.StakeholderRole
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNVestingStatus2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingStatus(ctx context.Context, sel ast.SelectionSet, v model.VestingStatus) graphql.Marshaler {
+	return ec._VestingStatus(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNStakeholderRole2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholderRole(ctx context.Context, sel ast.SelectionSet, v model.StakeholderRole) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNVestingStatus2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingStatus(ctx context.Context, sel ast.SelectionSet, v *model.VestingStatus) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._VestingStatus(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+func (ec *executionContext) marshalNVestingTranche2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTrancheᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.VestingTranche) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNVestingTranche2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTranche(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNVestingFrequency2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx context.Context, v any) (model.VestingFrequency, error) {
-	var res model.VestingFrequency
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNVestingFrequency2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx context.Context, sel ast.SelectionSet, v model.VestingFrequency) graphql.Marshaler {
-	return v
-}
 
-func (ec *executionContext) marshalNVestingSchedule2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule(ctx context.Context, sel ast.SelectionSet, v model.VestingSchedule) graphql.Marshaler {
-	return ec._VestingSchedule(ctx, sel, &v)
+	return ret
 }
 
-func (ec *executionContext) marshalNVestingSchedule2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule(ctx context.Context, sel ast.SelectionSet, v *model.VestingSchedule) graphql.Marshaler {
+func (ec *executionContext) marshalNVestingTranche2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTranche(ctx context.Context, sel ast.SelectionSet, v *model.VestingTranche) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._VestingSchedule(ctx, sel, v)
+	return ec._VestingTranche(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNVestingStatus2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingStatus(ctx context.Context, sel ast.SelectionSet, v model.VestingStatus) graphql.Marshaler {
-	return ec._VestingStatus(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNVestingStatus2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingStatus(ctx context.Context, sel ast.SelectionSet, v *model.VestingStatus) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._VestingStatus(ctx, sel, v)
+func (ec *executionContext) unmarshalNVestingTrancheInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTrancheInput(ctx context.Context, v any) (*model.VestingTrancheInput, error) {
+	res, err := ec.unmarshalInputVestingTrancheInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNWaterfallPayout2ᚕᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayoutᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.WaterfallPayout) graphql.Marshaler {
+func (ec *executionContext) marshalNWaterfallPayout2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayoutᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.WaterfallPayout) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9390,7 +21064,7 @@ func (ec *executionContext) marshalNWaterfallPayout2ᚕᚖgithubᚗcomᚋjoshtyl
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNWaterfallPayout2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayout(ctx, sel, v[i])
+			ret[i] = ec.marshalNWaterfallPayout2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayout(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9410,7 +21084,7 @@ func (ec *executionContext) marshalNWaterfallPayout2ᚕᚖgithubᚗcomᚋjoshtyl
 	return ret
 }
 
-func (ec *executionContext) marshalNWaterfallPayout2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayout(ctx context.Context, sel ast.SelectionSet, v *model.WaterfallPayout) graphql.Marshaler {
+func (ec *executionContext) marshalNWaterfallPayout2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallPayout(ctx context.Context, sel ast.SelectionSet, v *model.WaterfallPayout) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
@@ -9420,11 +21094,11 @@ func (ec *executionContext) marshalNWaterfallPayout2ᚖgithubᚗcomᚋjoshtyler
 	return ec._WaterfallPayout(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNWaterfallResult2githubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallResult(ctx context.Context, sel ast.SelectionSet, v model.WaterfallResult) graphql.Marshaler {
+func (ec *executionContext) marshalNWaterfallResult2githubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallResult(ctx context.Context, sel ast.SelectionSet, v model.WaterfallResult) graphql.Marshaler {
 	return ec._WaterfallResult(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNWaterfallResult2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallResult(ctx context.Context, sel ast.SelectionSet, v *model.WaterfallResult) graphql.Marshaler {
+func (ec *executionContext) marshalNWaterfallResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐWaterfallResult(ctx context.Context, sel ast.SelectionSet, v *model.WaterfallResult) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
@@ -9687,7 +21361,7 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
-func (ec *executionContext) unmarshalOAccelerationTrigger2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, v any) (*model.AccelerationTrigger, error) {
+func (ec *executionContext) unmarshalOAccelerationTrigger2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, v any) (*model.AccelerationTrigger, error) {
 	if v == nil {
 		return nil, nil
 	}
@@ -9696,7 +21370,7 @@ func (ec *executionContext) unmarshalOAccelerationTrigger2ᚖgithubᚗcomᚋjosh
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOAccelerationTrigger2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, sel ast.SelectionSet, v *model.AccelerationTrigger) graphql.Marshaler {
+func (ec *executionContext) marshalOAccelerationTrigger2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐAccelerationTrigger(ctx context.Context, sel ast.SelectionSet, v *model.AccelerationTrigger) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9733,14 +21407,101 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
-func (ec *executionContext) marshalOCompany2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany(ctx context.Context, sel ast.SelectionSet, v *model.Company) graphql.Marshaler {
+func (ec *executionContext) unmarshalOCapTableBasis2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableBasis(ctx context.Context, v any) (*model.CapTableBasis, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.CapTableBasis)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCapTableBasis2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapTableBasis(ctx context.Context, sel ast.SelectionSet, v *model.CapTableBasis) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOCapitalization2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalization(ctx context.Context, sel ast.SelectionSet, v *model.Capitalization) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Capitalization(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOCapitalizationDefinition2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalizationDefinition(ctx context.Context, v any) (*model.CapitalizationDefinition, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.CapitalizationDefinition)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOCapitalizationDefinition2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCapitalizationDefinition(ctx context.Context, sel ast.SelectionSet, v *model.CapitalizationDefinition) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOCompany2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐCompany(ctx context.Context, sel ast.SelectionSet, v *model.Company) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._Company(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, v any) (*model.Decimal, error) {
+func (ec *executionContext) unmarshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx context.Context, v any) (*model.Date, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.Date)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODate2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDate(ctx context.Context, sel ast.SelectionSet, v *model.Date) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalODateTime2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime(ctx context.Context, v any) (*model.DateTime, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.DateTime)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODateTime2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDateTime(ctx context.Context, sel ast.SelectionSet, v *model.DateTime) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalODayCount2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDayCount(ctx context.Context, v any) (*model.DayCount, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.DayCount)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODayCount2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDayCount(ctx context.Context, sel ast.SelectionSet, v *model.DayCount) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, v any) (*model.Decimal, error) {
 	if v == nil {
 		return nil, nil
 	}
@@ -9749,7 +21510,57 @@ func (ec *executionContext) unmarshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvest
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalODecimal2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, sel ast.SelectionSet, v *model.Decimal) graphql.Marshaler {
+func (ec *executionContext) marshalODecimal2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐDecimal(ctx context.Context, sel ast.SelectionSet, v *model.Decimal) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOForecastBucket2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐForecastBucket(ctx context.Context, v any) (*model.ForecastBucket, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ForecastBucket)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOForecastBucket2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐForecastBucket(ctx context.Context, sel ast.SelectionSet, v *model.ForecastBucket) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOGrantMilestoneInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestoneInputᚄ(ctx context.Context, v any) ([]*model.GrantMilestoneInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.GrantMilestoneInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNGrantMilestoneInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantMilestoneInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalOGrantType2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantType(ctx context.Context, v any) (*model.GrantType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.GrantType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOGrantType2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐGrantType(ctx context.Context, sel ast.SelectionSet, v *model.GrantType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9792,7 +21603,71 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
-func (ec *executionContext) marshalOStakeholder2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx context.Context, sel ast.SelectionSet, v *model.Stakeholder) graphql.Marshaler {
+func (ec *executionContext) unmarshalOInterestType2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐInterestType(ctx context.Context, v any) (*model.InterestType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.InterestType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInterestType2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐInterestType(ctx context.Context, sel ast.SelectionSet, v *model.InterestType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOMilestoneCondition2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMilestoneCondition(ctx context.Context, v any) (*model.MilestoneCondition, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.MilestoneCondition)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOMilestoneCondition2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐMilestoneCondition(ctx context.Context, sel ast.SelectionSet, v *model.MilestoneCondition) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalONoteSettlement2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐNoteSettlement(ctx context.Context, sel ast.SelectionSet, v *model.NoteSettlement) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._NoteSettlement(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORoundAllocationInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundAllocationInputᚄ(ctx context.Context, v any) ([]*model.RoundAllocationInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.RoundAllocationInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRoundAllocationInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐRoundAllocationInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOSAFEConversionResult2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐSAFEConversionResult(ctx context.Context, sel ast.SelectionSet, v *model.SAFEConversionResult) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SAFEConversionResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOStakeholder2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐStakeholder(ctx context.Context, sel ast.SelectionSet, v *model.Stakeholder) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -9817,13 +21692,79 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
-func (ec *executionContext) marshalOVestingSchedule2ᚖgithubᚗcomᚋjoshtylerᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule(ctx context.Context, sel ast.SelectionSet, v *model.VestingSchedule) graphql.Marshaler {
+func (ec *executionContext) unmarshalOTerminationReason2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminationReason(ctx context.Context, v any) (*model.TerminationReason, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.TerminationReason)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTerminationReason2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐTerminationReason(ctx context.Context, sel ast.SelectionSet, v *model.TerminationReason) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOVestingFrequency2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx context.Context, v any) (*model.VestingFrequency, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.VestingFrequency)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOVestingFrequency2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingFrequency(ctx context.Context, sel ast.SelectionSet, v *model.VestingFrequency) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOVestingRounding2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingRounding(ctx context.Context, v any) (*model.VestingRounding, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.VestingRounding)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOVestingRounding2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingRounding(ctx context.Context, sel ast.SelectionSet, v *model.VestingRounding) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOVestingSchedule2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingSchedule(ctx context.Context, sel ast.SelectionSet, v *model.VestingSchedule) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._VestingSchedule(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOVestingTrancheInput2ᚕᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTrancheInputᚄ(ctx context.Context, v any) ([]*model.VestingTrancheInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.VestingTrancheInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNVestingTrancheInput2ᚖgithubᚗcomᚋhutfutᚋvestigoᚋinternalᚋgraphᚋmodelᚐVestingTrancheInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -22,29 +22,95 @@ type CapTableEntry struct {
 	ShareClassName  string  `json:"shareClassName"`
 	Shares          Decimal `json:"shares"`
 	OwnershipPct    Decimal `json:"ownershipPct"`
+	// Part of shares issued but not yet vested, by early exercise or as restricted
+	// stock, and still subject to repurchase.
+	ExercisedUnvestedShares Decimal `json:"exercisedUnvestedShares"`
+	// The columns shares sums, as far as the cap table's basis counts them. Zero
+	// outside capTable.
+	IssuedShares Decimal `json:"issuedShares"`
+	OptionShares Decimal `json:"optionShares"`
+	// SAFEs and convertible notes as converted at their valuation caps.
+	ConvertibleShares Decimal `json:"convertibleShares"`
+	// The unallocated option pool.
+	PoolShares Decimal `json:"poolShares"`
 }
 
 type CapTableSnapshot struct {
 	CompanyID   *string          `json:"companyID,omitempty"`
 	TotalShares Decimal          `json:"totalShares"`
 	Entries     []*CapTableEntry `json:"entries"`
+	// The basis totalShares counts. Null outside capTable.
+	Basis *CapTableBasis `json:"basis,omitempty"`
+	// Column totals across every entry, whether the basis counts them or not.
+	IssuedShares      Decimal `json:"issuedShares"`
+	OptionShares      Decimal `json:"optionShares"`
+	ConvertibleShares Decimal `json:"convertibleShares"`
+	PoolShares        Decimal `json:"poolShares"`
+}
+
+type Capitalization struct {
+	Definition CapitalizationDefinition `json:"definition"`
+	// Stock, restricted stock and exercised options.
+	IssuedShares Decimal `json:"issuedShares"`
+	// Granted options not yet exercised.
+	IssuedOptions   Decimal `json:"issuedOptions"`
+	PromisedOptions Decimal `json:"promisedOptions"`
+	// Available in option pools, less promised options.
+	UnissuedPool Decimal `json:"unissuedPool"`
+	PoolIncrease Decimal `json:"poolIncrease"`
+	Total        Decimal `json:"total"`
 }
 
 type Company struct {
-	ID            string          `json:"id"`
-	Name          string          `json:"name"`
-	Stakeholders  []*Stakeholder  `json:"stakeholders"`
-	ShareClasses  []*ShareClass   `json:"shareClasses"`
-	Grants        []*Grant        `json:"grants"`
-	FundingRounds []*FundingRound `json:"fundingRounds"`
-	SafeNotes     []*SAFENote     `json:"safeNotes"`
-	CreatedAt     DateTime        `json:"createdAt"`
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Stakeholders     []*Stakeholder     `json:"stakeholders"`
+	ShareClasses     []*ShareClass      `json:"shareClasses"`
+	Grants           []*Grant           `json:"grants"`
+	FundingRounds    []*FundingRound    `json:"fundingRounds"`
+	SafeNotes        []*SAFENote        `json:"safeNotes"`
+	ConvertibleNotes []*ConvertibleNote `json:"convertibleNotes"`
+	OptionPools      []*OptionPool      `json:"optionPools"`
+	// Active vesting schedule templates, by name.
+	VestingSchedules []*VestingSchedule `json:"vestingSchedules"`
+	CreatedAt        DateTime           `json:"createdAt"`
+}
+
+type ConvertibleNote struct {
+	ID            string  `json:"id"`
+	CompanyID     string  `json:"companyID"`
+	StakeholderID string  `json:"stakeholderID"`
+	Principal     Decimal `json:"principal"`
+	// Annual rate; 0.08 = 8%.
+	InterestRate Decimal      `json:"interestRate"`
+	InterestType InterestType `json:"interestType"`
+	DayCount     DayCount     `json:"dayCount"`
+	IssueDate    Date         `json:"issueDate"`
+	MaturityDate Date         `json:"maturityDate"`
+	// Pre-money valuation cap at a qualified financing.
+	ValuationCap *Decimal `json:"valuationCap,omitempty"`
+	DiscountRate *Decimal `json:"discountRate,omitempty"`
+	// Smallest round that converts the note; null means any priced round.
+	QualifiedFinancingAmount *Decimal `json:"qualifiedFinancingAmount,omitempty"`
+	// Valuation the note converts at if it matures; null means it is repaid.
+	MaturityValuationCap *Decimal `json:"maturityValuationCap,omitempty"`
+	// How the note was converted or repaid; null while outstanding.
+	Settlement     *NoteSettlement `json:"settlement,omitempty"`
+	SettledInRound *string         `json:"settledInRound,omitempty"`
+	CreatedAt      DateTime        `json:"createdAt"`
 }
 
 type CreateCompanyInput struct {
 	Name string `json:"name"`
 }
 
+type CreateOptionPoolInput struct {
+	CompanyID      string  `json:"companyID"`
+	ShareClassID   string  `json:"shareClassID"`
+	Name           string  `json:"name"`
+	ReservedShares Decimal `json:"reservedShares"`
+}
+
 type CreateShareClassInput struct {
 	CompanyID           string   `json:"companyID"`
 	Name                string   `json:"name"`
@@ -57,11 +123,22 @@ type CreateShareClassInput struct {
 	AuthorizedShares    Decimal  `json:"authorizedShares"`
 }
 
+// Either a uniform schedule (cliffMonths, totalMonths, frequency) or an explicit
+// tranche list. With tranches, cliff and total months are derived from the first
+// and last tranche offsets.
 type CreateVestingScheduleInput struct {
-	CliffMonths         int                  `json:"cliffMonths"`
-	TotalMonths         int                  `json:"totalMonths"`
-	Frequency           VestingFrequency     `json:"frequency"`
-	AccelerationTrigger *AccelerationTrigger `json:"accelerationTrigger,omitempty"`
+	CompanyID string `json:"companyID"`
+	// Unique among the company's active schedules, e.g. "Standard 4y/1y".
+	Name                     string                 `json:"name"`
+	CliffMonths              *int                   `json:"cliffMonths,omitempty"`
+	TotalMonths              *int                   `json:"totalMonths,omitempty"`
+	Frequency                *VestingFrequency      `json:"frequency,omitempty"`
+	AccelerationTrigger      *AccelerationTrigger   `json:"accelerationTrigger,omitempty"`
+	AccelerationPercent      *Decimal               `json:"accelerationPercent,omitempty"`
+	AccelerationMonths       *int                   `json:"accelerationMonths,omitempty"`
+	AccelerationWindowMonths *int                   `json:"accelerationWindowMonths,omitempty"`
+	Tranches                 []*VestingTrancheInput `json:"tranches,omitempty"`
+	Rounding                 *VestingRounding       `json:"rounding,omitempty"`
 }
 
 type DilutionModelInput struct {
@@ -70,78 +147,306 @@ type DilutionModelInput struct {
 	PreMoneyValuation Decimal `json:"preMoneyValuation"`
 	AmountRaised      Decimal `json:"amountRaised"`
 	NewShareClass     string  `json:"newShareClass"`
-	InvestorName      string  `json:"investorName"`
+	// The single investor taking the whole round, when allocations are omitted.
+	InvestorName *string                 `json:"investorName,omitempty"`
+	Allocations  []*RoundAllocationInput `json:"allocations,omitempty"`
+	// Unallocated option pool required post-money, as a percentage. The increase
+	// to reach it comes out of the pre-money.
+	TargetPoolPct *Decimal `json:"targetPoolPct,omitempty"`
 }
 
 type DilutionResult struct {
-	PreRound    *CapTableSnapshot `json:"preRound"`
-	PostRound   *CapTableSnapshot `json:"postRound"`
-	NewInvestor *CapTableEntry    `json:"newInvestor"`
-	RoundName   string            `json:"roundName"`
+	PreRound  *CapTableSnapshot `json:"preRound"`
+	PostRound *CapTableSnapshot `json:"postRound"`
+	// Every investor's new shares together.
+	NewInvestor *CapTableEntry `json:"newInvestor"`
+	RoundName   string         `json:"roundName"`
+	// Each investor's new shares at the round price.
+	Investors       []*CapTableEntry `json:"investors"`
+	AllocatedAmount Decimal          `json:"allocatedAmount"`
+	// Set when the allocations do not sum to the amount raised.
+	AllocationMismatch bool `json:"allocationMismatch"`
+	// Pre-money valuation over the pre-money shares, pool increase included.
+	EffectivePps Decimal `json:"effectivePPS"`
+	// New pool shares to reach the target post-money pool.
+	PoolIncrease Decimal `json:"poolIncrease"`
+	// Percentage points of ownership existing holders lose to the pool increase.
+	PoolDilutionPct Decimal `json:"poolDilutionPct"`
+	// Percentage points of ownership existing holders lose to the new money.
+	NewMoneyDilutionPct Decimal `json:"newMoneyDilutionPct"`
+	// Shares the company's outstanding SAFEs and convertible notes convert into at the round, per holder.
+	Conversions []*CapTableEntry `json:"conversions"`
+	// Percentage points of ownership existing holders lose to converting SAFEs and notes.
+	ConversionDilutionPct Decimal `json:"conversionDilutionPct"`
+}
+
+type ExerciseGrantInput struct {
+	GrantID      string  `json:"grantID"`
+	Quantity     Decimal `json:"quantity"`
+	ExerciseDate Date    `json:"exerciseDate"`
 }
 
 type FundingRound struct {
-	ID                string   `json:"id"`
-	CompanyID         string   `json:"companyID"`
-	Name              string   `json:"name"`
-	PreMoneyValuation Decimal  `json:"preMoneyValuation"`
-	AmountRaised      Decimal  `json:"amountRaised"`
-	PricePerShare     Decimal  `json:"pricePerShare"`
-	ShareClassID      string   `json:"shareClassID"`
-	RoundDate         Date     `json:"roundDate"`
-	CreatedAt         DateTime `json:"createdAt"`
+	ID                string  `json:"id"`
+	CompanyID         string  `json:"companyID"`
+	Name              string  `json:"name"`
+	PreMoneyValuation Decimal `json:"preMoneyValuation"`
+	AmountRaised      Decimal `json:"amountRaised"`
+	PricePerShare     Decimal `json:"pricePerShare"`
+	ShareClassID      string  `json:"shareClassID"`
+	RoundDate         Date    `json:"roundDate"`
+	// Shares added to the option pool in the round.
+	OptionPoolIncrease Decimal `json:"optionPoolIncrease"`
+	// Options promised but not yet granted when the round closes.
+	PromisedOptions Decimal  `json:"promisedOptions"`
+	CreatedAt       DateTime `json:"createdAt"`
 }
 
 type Grant struct {
-	ID                string           `json:"id"`
-	CompanyID         string           `json:"companyID"`
-	StakeholderID     string           `json:"stakeholderID"`
-	ShareClassID      string           `json:"shareClassID"`
-	VestingScheduleID *string          `json:"vestingScheduleID,omitempty"`
-	Quantity          Decimal          `json:"quantity"`
-	GrantDate         Date             `json:"grantDate"`
-	ExercisePrice     Decimal          `json:"exercisePrice"`
-	IsExercised       bool             `json:"isExercised"`
-	Notes             *string          `json:"notes,omitempty"`
-	VestingSchedule   *VestingSchedule `json:"vestingSchedule,omitempty"`
-	CreatedAt         DateTime         `json:"createdAt"`
+	ID                      string    `json:"id"`
+	CompanyID               string    `json:"companyID"`
+	StakeholderID           string    `json:"stakeholderID"`
+	ShareClassID            string    `json:"shareClassID"`
+	VestingScheduleID       *string   `json:"vestingScheduleID,omitempty"`
+	OptionPoolID            *string   `json:"optionPoolID,omitempty"`
+	Type                    GrantType `json:"type"`
+	Quantity                Decimal   `json:"quantity"`
+	GrantDate               Date      `json:"grantDate"`
+	VestingCommencementDate Date      `json:"vestingCommencementDate"`
+	// Purchase price per share for restricted stock.
+	ExercisePrice Decimal `json:"exercisePrice"`
+	// Every outstanding option has been exercised.
+	IsExercised bool `json:"isExercised"`
+	// Options may be exercised before they vest.
+	EarlyExerciseAllowed bool    `json:"earlyExerciseAllowed"`
+	Notes                *string `json:"notes,omitempty"`
+	// Date vesting stopped because the holder was terminated.
+	TerminationDate *Date `json:"terminationDate,omitempty"`
+	// Unexercised options unvested at termination, returned to the option pool.
+	ForfeitedQuantity Decimal `json:"forfeitedQuantity"`
+	// Last day vested options can be exercised after termination.
+	ExerciseDeadline *Date `json:"exerciseDeadline,omitempty"`
+	// Vested options left unexercised once the exercise deadline passed. They have
+	// expired and returned to the option pool.
+	ExpiredQuantity Decimal `json:"expiredQuantity"`
+	// Per-share price of the company's right to repurchase shares exercised early
+	// but unvested at termination: the lower of cost and fair market value.
+	RepurchasePrice *Decimal `json:"repurchasePrice,omitempty"`
+	// Unvested shares bought back into treasury after the holder left.
+	RepurchasedQuantity Decimal           `json:"repurchasedQuantity"`
+	RepurchaseDate      *Date             `json:"repurchaseDate,omitempty"`
+	VestingSchedule     *VestingSchedule  `json:"vestingSchedule,omitempty"`
+	Milestones          []*GrantMilestone `json:"milestones"`
+	Leaves              []*LeaveOfAbsence `json:"leaves"`
+	Exercises           []*GrantExercise  `json:"exercises"`
+	CreatedAt           DateTime          `json:"createdAt"`
+}
+
+type GrantExercise struct {
+	ID           string   `json:"id"`
+	GrantID      string   `json:"grantID"`
+	Quantity     Decimal  `json:"quantity"`
+	ExerciseDate Date     `json:"exerciseDate"`
+	CreatedAt    DateTime `json:"createdAt"`
+}
+
+type GrantMilestone struct {
+	ID           string             `json:"id"`
+	GrantID      string             `json:"grantID"`
+	Name         string             `json:"name"`
+	Shares       Decimal            `json:"shares"`
+	Condition    MilestoneCondition `json:"condition"`
+	AchievedDate *Date              `json:"achievedDate,omitempty"`
+	CreatedAt    DateTime           `json:"createdAt"`
+}
+
+type GrantMilestoneInput struct {
+	Name      string              `json:"name"`
+	Shares    Decimal             `json:"shares"`
+	Condition *MilestoneCondition `json:"condition,omitempty"`
+}
+
+type IssueConvertibleNoteInput struct {
+	CompanyID                string        `json:"companyID"`
+	StakeholderID            string        `json:"stakeholderID"`
+	Principal                Decimal       `json:"principal"`
+	InterestRate             Decimal       `json:"interestRate"`
+	InterestType             *InterestType `json:"interestType,omitempty"`
+	DayCount                 *DayCount     `json:"dayCount,omitempty"`
+	IssueDate                Date          `json:"issueDate"`
+	MaturityDate             Date          `json:"maturityDate"`
+	ValuationCap             *Decimal      `json:"valuationCap,omitempty"`
+	DiscountRate             *Decimal      `json:"discountRate,omitempty"`
+	QualifiedFinancingAmount *Decimal      `json:"qualifiedFinancingAmount,omitempty"`
+	MaturityValuationCap     *Decimal      `json:"maturityValuationCap,omitempty"`
 }
 
 type IssueGrantInput struct {
-	CompanyID         string   `json:"companyID"`
-	StakeholderID     string   `json:"stakeholderID"`
-	ShareClassID      string   `json:"shareClassID"`
-	VestingScheduleID *string  `json:"vestingScheduleID,omitempty"`
-	Quantity          Decimal  `json:"quantity"`
-	GrantDate         Date     `json:"grantDate"`
-	ExercisePrice     *Decimal `json:"exercisePrice,omitempty"`
-	Notes             *string  `json:"notes,omitempty"`
+	CompanyID         string     `json:"companyID"`
+	StakeholderID     string     `json:"stakeholderID"`
+	ShareClassID      string     `json:"shareClassID"`
+	VestingScheduleID *string    `json:"vestingScheduleID,omitempty"`
+	OptionPoolID      *string    `json:"optionPoolID,omitempty"`
+	Type              *GrantType `json:"type,omitempty"`
+	Quantity          Decimal    `json:"quantity"`
+	GrantDate         Date       `json:"grantDate"`
+	// Defaults to grantDate.
+	VestingCommencementDate *Date                  `json:"vestingCommencementDate,omitempty"`
+	ExercisePrice           *Decimal               `json:"exercisePrice,omitempty"`
+	EarlyExerciseAllowed    *bool                  `json:"earlyExerciseAllowed,omitempty"`
+	Notes                   *string                `json:"notes,omitempty"`
+	Milestones              []*GrantMilestoneInput `json:"milestones,omitempty"`
 }
 
 type IssueSAFEInput struct {
-	CompanyID        string   `json:"companyID"`
-	StakeholderID    string   `json:"stakeholderID"`
-	InvestmentAmount Decimal  `json:"investmentAmount"`
-	ValuationCap     *Decimal `json:"valuationCap,omitempty"`
-	DiscountRate     *Decimal `json:"discountRate,omitempty"`
-	SafeType         SAFEType `json:"safeType"`
-	IssueDate        Date     `json:"issueDate"`
+	CompanyID                string                    `json:"companyID"`
+	StakeholderID            string                    `json:"stakeholderID"`
+	InvestmentAmount         Decimal                   `json:"investmentAmount"`
+	ValuationCap             *Decimal                  `json:"valuationCap,omitempty"`
+	DiscountRate             *Decimal                  `json:"discountRate,omitempty"`
+	SafeType                 SAFEType                  `json:"safeType"`
+	IsMfn                    *bool                     `json:"isMFN,omitempty"`
+	CapitalizationDefinition *CapitalizationDefinition `json:"capitalizationDefinition,omitempty"`
+	IssueDate                Date                      `json:"issueDate"`
+}
+
+type LeaveOfAbsence struct {
+	ID            string `json:"id"`
+	StakeholderID string `json:"stakeholderID"`
+	// Null when the leave applies to all of the stakeholder's grants.
+	GrantID   *string `json:"grantID,omitempty"`
+	StartDate Date    `json:"startDate"`
+	// First day back at work.
+	EndDate   Date     `json:"endDate"`
+	IsPaid    bool     `json:"isPaid"`
+	CreatedAt DateTime `json:"createdAt"`
+}
+
+type MFNNotice struct {
+	ID string `json:"id"`
+	// The holder's MFN SAFE.
+	SafeID string `json:"safeID"`
+	// The later SAFE issued on better terms.
+	SourceSafeID string   `json:"sourceSafeID"`
+	ValuationCap *Decimal `json:"valuationCap,omitempty"`
+	DiscountRate *Decimal `json:"discountRate,omitempty"`
+	CreatedAt    DateTime `json:"createdAt"`
+}
+
+// One round of a modeled sequence, with the same terms as DilutionModelInput.
+type ModeledRoundInput struct {
+	RoundName         string                  `json:"roundName"`
+	PreMoneyValuation Decimal                 `json:"preMoneyValuation"`
+	AmountRaised      Decimal                 `json:"amountRaised"`
+	NewShareClass     string                  `json:"newShareClass"`
+	InvestorName      *string                 `json:"investorName,omitempty"`
+	Allocations       []*RoundAllocationInput `json:"allocations,omitempty"`
+	TargetPoolPct     *Decimal                `json:"targetPoolPct,omitempty"`
 }
 
 type Mutation struct {
 }
 
+type NoteAccrual struct {
+	NoteID          string  `json:"noteID"`
+	AsOfDate        Date    `json:"asOfDate"`
+	Principal       Decimal `json:"principal"`
+	AccruedInterest Decimal `json:"accruedInterest"`
+	// Principal plus accrued interest.
+	Balance Decimal `json:"balance"`
+}
+
+type NoteSettlement struct {
+	NoteID          string  `json:"noteID"`
+	Date            Date    `json:"date"`
+	Principal       Decimal `json:"principal"`
+	AccruedInterest Decimal `json:"accruedInterest"`
+	// Zero when repaid.
+	SharesIssued Decimal `json:"sharesIssued"`
+	// Zero when repaid.
+	EffectivePps Decimal `json:"effectivePPS"`
+	// Zero when converted.
+	RepaymentAmount Decimal `json:"repaymentAmount"`
+	// One of cap, discount, round_price, maturity_cap or repayment.
+	Method string `json:"method"`
+	// The stock grant that issued the converted shares.
+	GrantID *string `json:"grantID,omitempty"`
+}
+
+type OptionPool struct {
+	ID              string   `json:"id"`
+	CompanyID       string   `json:"companyID"`
+	ShareClassID    string   `json:"shareClassID"`
+	Name            string   `json:"name"`
+	ReservedShares  Decimal  `json:"reservedShares"`
+	GrantedShares   Decimal  `json:"grantedShares"`
+	AvailableShares Decimal  `json:"availableShares"`
+	CreatedAt       DateTime `json:"createdAt"`
+}
+
+type OwnershipTrajectory struct {
+	// Null for investors modeled by name and for the pool.
+	StakeholderID   *string `json:"stakeholderID,omitempty"`
+	StakeholderName string  `json:"stakeholderName"`
+	// Ownership before the first round, then after each round.
+	OwnershipPct []*Decimal `json:"ownershipPct"`
+}
+
 type Query struct {
 }
 
 type RecordFundingRoundInput struct {
-	CompanyID         string  `json:"companyID"`
-	Name              string  `json:"name"`
+	CompanyID          string   `json:"companyID"`
+	Name               string   `json:"name"`
+	PreMoneyValuation  Decimal  `json:"preMoneyValuation"`
+	AmountRaised       Decimal  `json:"amountRaised"`
+	PricePerShare      Decimal  `json:"pricePerShare"`
+	ShareClassID       string   `json:"shareClassID"`
+	RoundDate          Date     `json:"roundDate"`
+	OptionPoolIncrease *Decimal `json:"optionPoolIncrease,omitempty"`
+	PromisedOptions    *Decimal `json:"promisedOptions,omitempty"`
+}
+
+// Unpaid leaves longer than 30 days suspend vesting and push the schedule out by
+// the length of the leave.
+type RecordLeaveInput struct {
+	StakeholderID string `json:"stakeholderID"`
+	// Limit the leave to one grant. Omit to apply it to all of the stakeholder's grants.
+	GrantID   *string `json:"grantID,omitempty"`
+	StartDate Date    `json:"startDate"`
+	EndDate   Date    `json:"endDate"`
+	IsPaid    *bool   `json:"isPaid,omitempty"`
+}
+
+type RepurchaseSharesInput struct {
+	GrantID        string `json:"grantID"`
+	RepurchaseDate Date   `json:"repurchaseDate"`
+}
+
+// One investor's part of a round. An existing stakeholder's new shares join
+// their post-round entry.
+type RoundAllocationInput struct {
+	InvestorName  string  `json:"investorName"`
+	StakeholderID *string `json:"stakeholderID,omitempty"`
+	Amount        Decimal `json:"amount"`
+}
+
+type RoundSequenceResult struct {
+	// Each round's result, in order.
+	Steps        []*DilutionResult      `json:"steps"`
+	Trajectories []*OwnershipTrajectory `json:"trajectories"`
+}
+
+// What a company's unconverted SAFEs would convert into at a candidate round.
+type SAFEConversionPreview struct {
+	ScenarioName      string  `json:"scenarioName"`
 	PreMoneyValuation Decimal `json:"preMoneyValuation"`
 	AmountRaised      Decimal `json:"amountRaised"`
 	PricePerShare     Decimal `json:"pricePerShare"`
-	ShareClassID      string  `json:"shareClassID"`
-	RoundDate         Date    `json:"roundDate"`
+	// Existing shares plus every SAFE's shares plus the new investors' shares.
+	PostMoneyShares Decimal                 `json:"postMoneyShares"`
+	Conversions     []*SAFEConversionResult `json:"conversions"`
+	Holders         []*SAFEHolderOwnership  `json:"holders"`
 }
 
 type SAFEConversionResult struct {
@@ -149,6 +454,28 @@ type SAFEConversionResult struct {
 	SharesIssued     Decimal `json:"sharesIssued"`
 	EffectivePps     Decimal `json:"effectivePPS"`
 	ConversionMethod string  `json:"conversionMethod"`
+	// The stock grant that issued the shares in the round's share class.
+	GrantID *string `json:"grantID,omitempty"`
+	// For an MFN SAFE, the later SAFE whose cap and discount it converted on.
+	InheritedTermsFrom *string `json:"inheritedTermsFrom,omitempty"`
+	// The capitalization the SAFE converted against.
+	Capitalization *Capitalization `json:"capitalization,omitempty"`
+}
+
+type SAFEConversionScenarioInput struct {
+	Name              string  `json:"name"`
+	PreMoneyValuation Decimal `json:"preMoneyValuation"`
+	AmountRaised      Decimal `json:"amountRaised"`
+	// Defaults to the pre-money valuation over the outstanding shares.
+	PricePerShare *Decimal `json:"pricePerShare,omitempty"`
+}
+
+type SAFEHolderOwnership struct {
+	StakeholderID    string  `json:"stakeholderID"`
+	StakeholderName  string  `json:"stakeholderName"`
+	ExistingShares   Decimal `json:"existingShares"`
+	ConversionShares Decimal `json:"conversionShares"`
+	OwnershipPct     Decimal `json:"ownershipPct"`
 }
 
 type SAFENote struct {
@@ -159,10 +486,22 @@ type SAFENote struct {
 	ValuationCap     *Decimal `json:"valuationCap,omitempty"`
 	DiscountRate     *Decimal `json:"discountRate,omitempty"`
 	SafeType         SAFEType `json:"safeType"`
-	IsConverted      bool     `json:"isConverted"`
-	ConvertedInRound *string  `json:"convertedInRound,omitempty"`
-	IssueDate        Date     `json:"issueDate"`
-	CreatedAt        DateTime `json:"createdAt"`
+	// Uncapped; inherits the best cap and discount of SAFEs issued after it.
+	IsMfn bool `json:"isMFN"`
+	// The Company Capitalization the SAFE's contract converts against.
+	CapitalizationDefinition CapitalizationDefinition `json:"capitalizationDefinition"`
+	IsConverted              bool                     `json:"isConverted"`
+	ConvertedInRound         *string                  `json:"convertedInRound,omitempty"`
+	Conversion               *SAFEConversionResult    `json:"conversion,omitempty"`
+	IssueDate                Date                     `json:"issueDate"`
+	CreatedAt                DateTime                 `json:"createdAt"`
+}
+
+type SettleNoteAtMaturityInput struct {
+	NoteID         string `json:"noteID"`
+	SettlementDate Date   `json:"settlementDate"`
+	// Class the note converts into at its maturity cap; unused when repaid.
+	ShareClassID *string `json:"shareClassID,omitempty"`
 }
 
 type ShareClass struct {
@@ -176,37 +515,121 @@ type ShareClass struct {
 	PricePerShare       *Decimal `json:"pricePerShare,omitempty"`
 	Seniority           int      `json:"seniority"`
 	AuthorizedShares    Decimal  `json:"authorizedShares"`
-	CreatedAt           DateTime `json:"createdAt"`
+	// For a shadow series, such as Series A-1, the round's class it belongs to.
+	ParentShareClassID *string  `json:"parentShareClassID,omitempty"`
+	CreatedAt          DateTime `json:"createdAt"`
 }
 
 type Stakeholder struct {
-	ID        string          `json:"id"`
-	CompanyID string          `json:"companyID"`
-	Name      string          `json:"name"`
-	Email     string          `json:"email"`
-	Role      StakeholderRole `json:"role"`
-	Grants    []*Grant        `json:"grants"`
-	CreatedAt DateTime        `json:"createdAt"`
+	ID                string             `json:"id"`
+	CompanyID         string             `json:"companyID"`
+	Name              string             `json:"name"`
+	Email             string             `json:"email"`
+	Role              StakeholderRole    `json:"role"`
+	TerminationDate   *Date              `json:"terminationDate,omitempty"`
+	TerminationReason *TerminationReason `json:"terminationReason,omitempty"`
+	Grants            []*Grant           `json:"grants"`
+	Leaves            []*LeaveOfAbsence  `json:"leaves"`
+	// Notices that later SAFEs were issued on terms the holder's MFN SAFEs may inherit, newest first.
+	MfnNotices []*MFNNotice `json:"mfnNotices"`
+	CreatedAt  DateTime     `json:"createdAt"`
+}
+
+type TerminateStakeholderInput struct {
+	StakeholderID   string            `json:"stakeholderID"`
+	TerminationDate Date              `json:"terminationDate"`
+	Reason          TerminationReason `json:"reason"`
+	// Post-termination exercise window in days. Defaults to 90.
+	ExerciseWindowDays *int `json:"exerciseWindowDays,omitempty"`
+	// Per-share fair market value on the termination date, used to price the
+	// repurchase of shares exercised early. Defaults to each grant's exercise price.
+	FairMarketValue *Decimal `json:"fairMarketValue,omitempty"`
+}
+
+// Renames a schedule and/or changes its terms. Terms left out keep their stored
+// values. Tranches replace cliffMonths, totalMonths and frequency, and switching a
+// tranche schedule back needs all three. Terms can only be changed while no grant
+// vests on the schedule.
+type UpdateVestingScheduleInput struct {
+	ID                       string                 `json:"id"`
+	Name                     *string                `json:"name,omitempty"`
+	CliffMonths              *int                   `json:"cliffMonths,omitempty"`
+	TotalMonths              *int                   `json:"totalMonths,omitempty"`
+	Frequency                *VestingFrequency      `json:"frequency,omitempty"`
+	AccelerationTrigger      *AccelerationTrigger   `json:"accelerationTrigger,omitempty"`
+	AccelerationPercent      *Decimal               `json:"accelerationPercent,omitempty"`
+	AccelerationMonths       *int                   `json:"accelerationMonths,omitempty"`
+	AccelerationWindowMonths *int                   `json:"accelerationWindowMonths,omitempty"`
+	Tranches                 []*VestingTrancheInput `json:"tranches,omitempty"`
+	Rounding                 *VestingRounding       `json:"rounding,omitempty"`
+}
+
+type VestEvent struct {
+	Date             Date    `json:"date"`
+	Shares           Decimal `json:"shares"`
+	CumulativeVested Decimal `json:"cumulativeVested"`
+}
+
+type VestingForecastLine struct {
+	ShareClassID   string          `json:"shareClassID"`
+	ShareClassName string          `json:"shareClassName"`
+	Role           StakeholderRole `json:"role"`
+	VestedShares   Decimal         `json:"vestedShares"`
+}
+
+type VestingForecastPeriod struct {
+	PeriodStart  Date                   `json:"periodStart"`
+	PeriodEnd    Date                   `json:"periodEnd"`
+	VestedShares Decimal                `json:"vestedShares"`
+	Breakdown    []*VestingForecastLine `json:"breakdown"`
 }
 
 type VestingSchedule struct {
-	ID                  string              `json:"id"`
-	CliffMonths         int                 `json:"cliffMonths"`
-	TotalMonths         int                 `json:"totalMonths"`
-	Frequency           VestingFrequency    `json:"frequency"`
-	AccelerationTrigger AccelerationTrigger `json:"accelerationTrigger"`
+	ID                       string              `json:"id"`
+	CompanyID                string              `json:"companyID"`
+	Name                     string              `json:"name"`
+	CliffMonths              int                 `json:"cliffMonths"`
+	TotalMonths              int                 `json:"totalMonths"`
+	Frequency                VestingFrequency    `json:"frequency"`
+	AccelerationTrigger      AccelerationTrigger `json:"accelerationTrigger"`
+	AccelerationPercent      *Decimal            `json:"accelerationPercent,omitempty"`
+	AccelerationMonths       *int                `json:"accelerationMonths,omitempty"`
+	AccelerationWindowMonths int                 `json:"accelerationWindowMonths"`
+	Tranches                 []*VestingTranche   `json:"tranches"`
+	Rounding                 VestingRounding     `json:"rounding"`
+	// Set once the schedule is retired. Grants already issued on it keep vesting.
+	ArchivedAt *DateTime `json:"archivedAt,omitempty"`
 }
 
 type VestingStatus struct {
-	GrantID        string  `json:"grantID"`
-	AsOfDate       Date    `json:"asOfDate"`
-	TotalShares    Decimal `json:"totalShares"`
-	VestedShares   Decimal `json:"vestedShares"`
-	UnvestedShares Decimal `json:"unvestedShares"`
-	PercentVested  Decimal `json:"percentVested"`
-	CliffDate      Date    `json:"cliffDate"`
-	FullyVestedAt  Date    `json:"fullyVestedAt"`
-	IsFullyVested  bool    `json:"isFullyVested"`
+	GrantID           string  `json:"grantID"`
+	AsOfDate          Date    `json:"asOfDate"`
+	TotalShares       Decimal `json:"totalShares"`
+	VestedShares      Decimal `json:"vestedShares"`
+	UnvestedShares    Decimal `json:"unvestedShares"`
+	PercentVested     Decimal `json:"percentVested"`
+	CliffDate         Date    `json:"cliffDate"`
+	FullyVestedAt     Date    `json:"fullyVestedAt"`
+	IsFullyVested     bool    `json:"isFullyVested"`
+	AcceleratedShares Decimal `json:"acceleratedShares"`
+	ExercisedShares   Decimal `json:"exercisedShares"`
+	// Issued shares, exercised or restricted stock, that have vested.
+	ReleasedShares Decimal `json:"releasedShares"`
+	// Issued shares not yet vested, which the company can repurchase.
+	RepurchasableShares Decimal `json:"repurchasableShares"`
+}
+
+type VestingTranche struct {
+	OffsetMonths int      `json:"offsetMonths"`
+	Percent      *Decimal `json:"percent,omitempty"`
+	Shares       *Decimal `json:"shares,omitempty"`
+}
+
+// Exactly one of percent or shares must be set.
+type VestingTrancheInput struct {
+	OffsetMonths int      `json:"offsetMonths"`
+	Percent      *Decimal `json:"percent,omitempty"`
+	Shares       *Decimal `json:"shares,omitempty"`
 }
 
 type WaterfallPayout struct {
@@ -216,6 +639,8 @@ type WaterfallPayout struct {
 	Shares          Decimal `json:"shares"`
 	Payout          Decimal `json:"payout"`
 	PayoutPerShare  Decimal `json:"payoutPerShare"`
+	// For a SAFE or convertible note: CASH_OUT or CONVERT, whichever paid more.
+	ClaimElection *string `json:"claimElection,omitempty"`
 }
 
 type WaterfallResult struct {
@@ -281,6 +706,421 @@ func (e AccelerationTrigger) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Which shares a cap table counts. Each basis counts everything the one before
+// it does: OUTSTANDING counts issued stock, restricted stock and exercised
+// options; FULLY_DILUTED adds unexercised options; AS_CONVERTED adds SAFEs and
+// convertible notes converted at their valuation caps; FULLY_DILUTED_WITH_POOL
+// adds the unallocated option pool.
+type CapTableBasis string
+
+const (
+	CapTableBasisOutstanding          CapTableBasis = "OUTSTANDING"
+	CapTableBasisFullyDiluted         CapTableBasis = "FULLY_DILUTED"
+	CapTableBasisAsConverted          CapTableBasis = "AS_CONVERTED"
+	CapTableBasisFullyDilutedWithPool CapTableBasis = "FULLY_DILUTED_WITH_POOL"
+)
+
+var AllCapTableBasis = []CapTableBasis{
+	CapTableBasisOutstanding,
+	CapTableBasisFullyDiluted,
+	CapTableBasisAsConverted,
+	CapTableBasisFullyDilutedWithPool,
+}
+
+func (e CapTableBasis) IsValid() bool {
+	switch e {
+	case CapTableBasisOutstanding, CapTableBasisFullyDiluted, CapTableBasisAsConverted, CapTableBasisFullyDilutedWithPool:
+		return true
+	}
+	return false
+}
+
+func (e CapTableBasis) String() string {
+	return string(e)
+}
+
+func (e *CapTableBasis) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CapTableBasis(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CapTableBasis", str)
+	}
+	return nil
+}
+
+func (e CapTableBasis) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CapTableBasis) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CapTableBasis) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Which shares a SAFE's cap price is measured against. OUTSTANDING counts every
+// outstanding grant. FULLY_DILUTED adds promised options and the unissued pool,
+// leaving out the round's pool increase except where promised options exceed
+// the pool before it, as in the post-money SAFE. FULLY_DILUTED_WITH_POOL_INCREASE
+// counts the whole increase, as in the pre-money SAFE.
+type CapitalizationDefinition string
+
+const (
+	CapitalizationDefinitionOutstanding                  CapitalizationDefinition = "OUTSTANDING"
+	CapitalizationDefinitionFullyDiluted                 CapitalizationDefinition = "FULLY_DILUTED"
+	CapitalizationDefinitionFullyDilutedWithPoolIncrease CapitalizationDefinition = "FULLY_DILUTED_WITH_POOL_INCREASE"
+)
+
+var AllCapitalizationDefinition = []CapitalizationDefinition{
+	CapitalizationDefinitionOutstanding,
+	CapitalizationDefinitionFullyDiluted,
+	CapitalizationDefinitionFullyDilutedWithPoolIncrease,
+}
+
+func (e CapitalizationDefinition) IsValid() bool {
+	switch e {
+	case CapitalizationDefinitionOutstanding, CapitalizationDefinitionFullyDiluted, CapitalizationDefinitionFullyDilutedWithPoolIncrease:
+		return true
+	}
+	return false
+}
+
+func (e CapitalizationDefinition) String() string {
+	return string(e)
+}
+
+func (e *CapitalizationDefinition) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CapitalizationDefinition(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CapitalizationDefinition", str)
+	}
+	return nil
+}
+
+func (e CapitalizationDefinition) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CapitalizationDefinition) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CapitalizationDefinition) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DayCount string
+
+const (
+	DayCountActual365 DayCount = "ACTUAL_365"
+	DayCountActual360 DayCount = "ACTUAL_360"
+	// US (NASD) 30/360.
+	DayCountThirty360 DayCount = "THIRTY_360"
+)
+
+var AllDayCount = []DayCount{
+	DayCountActual365,
+	DayCountActual360,
+	DayCountThirty360,
+}
+
+func (e DayCount) IsValid() bool {
+	switch e {
+	case DayCountActual365, DayCountActual360, DayCountThirty360:
+		return true
+	}
+	return false
+}
+
+func (e DayCount) String() string {
+	return string(e)
+}
+
+func (e *DayCount) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DayCount(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DayCount", str)
+	}
+	return nil
+}
+
+func (e DayCount) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DayCount) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DayCount) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ForecastBucket string
+
+const (
+	ForecastBucketMonth   ForecastBucket = "MONTH"
+	ForecastBucketQuarter ForecastBucket = "QUARTER"
+	ForecastBucketYear    ForecastBucket = "YEAR"
+)
+
+var AllForecastBucket = []ForecastBucket{
+	ForecastBucketMonth,
+	ForecastBucketQuarter,
+	ForecastBucketYear,
+}
+
+func (e ForecastBucket) IsValid() bool {
+	switch e {
+	case ForecastBucketMonth, ForecastBucketQuarter, ForecastBucketYear:
+		return true
+	}
+	return false
+}
+
+func (e ForecastBucket) String() string {
+	return string(e)
+}
+
+func (e *ForecastBucket) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ForecastBucket(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ForecastBucket", str)
+	}
+	return nil
+}
+
+func (e ForecastBucket) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ForecastBucket) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ForecastBucket) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// OPTION grants shares on exercise. RSA issues restricted stock outright at
+// grant; the vesting schedule governs when the company's repurchase right lapses.
+type GrantType string
+
+const (
+	GrantTypeOption GrantType = "OPTION"
+	GrantTypeRsa    GrantType = "RSA"
+	// Shares issued outright and fully vested, such as on SAFE conversion.
+	GrantTypeStock GrantType = "STOCK"
+)
+
+var AllGrantType = []GrantType{
+	GrantTypeOption,
+	GrantTypeRsa,
+	GrantTypeStock,
+}
+
+func (e GrantType) IsValid() bool {
+	switch e {
+	case GrantTypeOption, GrantTypeRsa, GrantTypeStock:
+		return true
+	}
+	return false
+}
+
+func (e GrantType) String() string {
+	return string(e)
+}
+
+func (e *GrantType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GrantType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GrantType", str)
+	}
+	return nil
+}
+
+func (e GrantType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *GrantType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e GrantType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Compound interest compounds annually on the issue date's anniversaries, with
+// simple interest for the part year since the last one.
+type InterestType string
+
+const (
+	InterestTypeSimple   InterestType = "SIMPLE"
+	InterestTypeCompound InterestType = "COMPOUND"
+)
+
+var AllInterestType = []InterestType{
+	InterestTypeSimple,
+	InterestTypeCompound,
+}
+
+func (e InterestType) IsValid() bool {
+	switch e {
+	case InterestTypeSimple, InterestTypeCompound:
+		return true
+	}
+	return false
+}
+
+func (e InterestType) String() string {
+	return string(e)
+}
+
+func (e *InterestType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InterestType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InterestType", str)
+	}
+	return nil
+}
+
+func (e InterestType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *InterestType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e InterestType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MilestoneCondition string
+
+const (
+	// Shares vest in full when the milestone is achieved.
+	MilestoneConditionMilestone MilestoneCondition = "MILESTONE"
+	// Shares vest on the grant's time schedule, but only once the milestone is achieved.
+	MilestoneConditionMilestoneAndTime MilestoneCondition = "MILESTONE_AND_TIME"
+)
+
+var AllMilestoneCondition = []MilestoneCondition{
+	MilestoneConditionMilestone,
+	MilestoneConditionMilestoneAndTime,
+}
+
+func (e MilestoneCondition) IsValid() bool {
+	switch e {
+	case MilestoneConditionMilestone, MilestoneConditionMilestoneAndTime:
+		return true
+	}
+	return false
+}
+
+func (e MilestoneCondition) String() string {
+	return string(e)
+}
+
+func (e *MilestoneCondition) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MilestoneCondition(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MilestoneCondition", str)
+	}
+	return nil
+}
+
+func (e MilestoneCondition) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MilestoneCondition) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MilestoneCondition) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type SAFEType string
 
 const (
@@ -397,6 +1237,67 @@ func (e StakeholderRole) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type TerminationReason string
+
+const (
+	TerminationReasonVoluntary   TerminationReason = "VOLUNTARY"
+	TerminationReasonInvoluntary TerminationReason = "INVOLUNTARY"
+	TerminationReasonForCause    TerminationReason = "FOR_CAUSE"
+	TerminationReasonDeath       TerminationReason = "DEATH"
+	TerminationReasonDisability  TerminationReason = "DISABILITY"
+)
+
+var AllTerminationReason = []TerminationReason{
+	TerminationReasonVoluntary,
+	TerminationReasonInvoluntary,
+	TerminationReasonForCause,
+	TerminationReasonDeath,
+	TerminationReasonDisability,
+}
+
+func (e TerminationReason) IsValid() bool {
+	switch e {
+	case TerminationReasonVoluntary, TerminationReasonInvoluntary, TerminationReasonForCause, TerminationReasonDeath, TerminationReasonDisability:
+		return true
+	}
+	return false
+}
+
+func (e TerminationReason) String() string {
+	return string(e)
+}
+
+func (e *TerminationReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TerminationReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TerminationReason", str)
+	}
+	return nil
+}
+
+func (e TerminationReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TerminationReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TerminationReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type VestingFrequency string
 
 const (
@@ -453,3 +1354,63 @@ func (e VestingFrequency) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+// How fractional shares are handled as a schedule vests.
+type VestingRounding string
+
+const (
+	VestingRoundingFractional      VestingRounding = "FRACTIONAL"
+	VestingRoundingCumulativeFloor VestingRounding = "CUMULATIVE_FLOOR"
+	VestingRoundingPerPeriodFloor  VestingRounding = "PER_PERIOD_FLOOR"
+	VestingRoundingBankers         VestingRounding = "BANKERS"
+)
+
+var AllVestingRounding = []VestingRounding{
+	VestingRoundingFractional,
+	VestingRoundingCumulativeFloor,
+	VestingRoundingPerPeriodFloor,
+	VestingRoundingBankers,
+}
+
+func (e VestingRounding) IsValid() bool {
+	switch e {
+	case VestingRoundingFractional, VestingRoundingCumulativeFloor, VestingRoundingPerPeriodFloor, VestingRoundingBankers:
+		return true
+	}
+	return false
+}
+
+func (e VestingRounding) String() string {
+	return string(e)
+}
+
+func (e *VestingRounding) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VestingRounding(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VestingRounding", str)
+	}
+	return nil
+}
+
+func (e VestingRounding) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *VestingRounding) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e VestingRounding) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -14,7 +14,13 @@ type Resolver struct {
 	ShareClasses     *store.ShareClassStore
 	VestingSchedules *store.VestingScheduleStore
 	Grants           *store.GrantStore
+	GrantMilestones  *store.GrantMilestoneStore
+	OptionPools      *store.OptionPoolStore
+	Leaves           *store.LeaveStore
+	GrantExercises   *store.GrantExerciseStore
 	FundingRounds    *store.FundingRoundStore
 	SAFENotes        *store.SAFENoteStore
+	MFNNotices       *store.MFNNoticeStore
+	ConvertibleNotes *store.ConvertibleNoteStore
 	Audit            *audit.Logger
 }
@@ -2,11 +2,15 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/hutfut/vestigo/internal/engine/captable"
 	"github.com/hutfut/vestigo/internal/engine/dilution"
+	noteengine "github.com/hutfut/vestigo/internal/engine/note"
 	safeengine "github.com/hutfut/vestigo/internal/engine/safe"
 	vestingengine "github.com/hutfut/vestigo/internal/engine/vesting"
 	waterfallengine "github.com/hutfut/vestigo/internal/engine/waterfall"
@@ -60,19 +64,89 @@ func (r *mutationResolver) CreateShareClass(ctx context.Context, input model.Cre
 }
 
 func (r *mutationResolver) CreateVestingSchedule(ctx context.Context, input model.CreateVestingScheduleInput) (*model.VestingSchedule, error) {
-	accel := domain.AccelerationNone
-	if input.AccelerationTrigger != nil {
-		accel = convert.GQLAccelToDomain(*input.AccelerationTrigger)
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, &domain.ErrValidation{Field: "name", Message: "must not be empty"}
 	}
-	vs := &domain.VestingSchedule{
-		CliffMonths:         input.CliffMonths,
-		TotalMonths:         input.TotalMonths,
-		Frequency:           convert.GQLFreqToDomain(input.Frequency),
-		AccelerationTrigger: accel,
+	if _, err := r.Companies.GetByID(ctx, input.CompanyID); err != nil {
+		return nil, err
+	}
+	vs, err := vestingScheduleFromInput(input)
+	if err != nil {
+		return nil, err
 	}
+	vs.CompanyID = input.CompanyID
+	vs.Name = input.Name
+
 	if err := r.VestingSchedules.Create(ctx, vs); err != nil {
 		return nil, err
 	}
+	r.Audit.Record(ctx, "vesting_schedule", vs.ID, "create", nil, vs)
+	return convert.ToGQLVestingSchedule(vs), nil
+}
+
+func (r *mutationResolver) UpdateVestingSchedule(ctx context.Context, input model.UpdateVestingScheduleInput) (*model.VestingSchedule, error) {
+	vs, err := r.VestingSchedules.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if vs.ArchivedAt != nil {
+		return nil, &domain.ErrConflict{Message: fmt.Sprintf("vesting schedule %s is archived", vs.ID)}
+	}
+	before := *vs
+	updated := *vs
+
+	if input.Name != nil {
+		if strings.TrimSpace(*input.Name) == "" {
+			return nil, &domain.ErrValidation{Field: "name", Message: "must not be empty"}
+		}
+		updated.Name = *input.Name
+	}
+
+	terms := model.CreateVestingScheduleInput{
+		CliffMonths:              input.CliffMonths,
+		TotalMonths:              input.TotalMonths,
+		Frequency:                input.Frequency,
+		AccelerationTrigger:      input.AccelerationTrigger,
+		AccelerationPercent:      input.AccelerationPercent,
+		AccelerationMonths:       input.AccelerationMonths,
+		AccelerationWindowMonths: input.AccelerationWindowMonths,
+		Tranches:                 input.Tranches,
+		Rounding:                 input.Rounding,
+	}
+	if hasScheduleTerms(terms) {
+		// Grants vest on the schedule as it stands; changing the terms
+		// under them would rewrite vesting that has already happened.
+		inUse, err := r.VestingSchedules.InUse(ctx, vs.ID)
+		if err != nil {
+			return nil, err
+		}
+		if inUse {
+			return nil, &domain.ErrConflict{Message: fmt.Sprintf("vesting schedule %s has grants; archive it and create a new schedule instead", vs.ID)}
+		}
+		merged, err := mergeScheduleTerms(updated, terms)
+		if err != nil {
+			return nil, err
+		}
+		updated = *merged
+	}
+
+	if err := r.VestingSchedules.Update(ctx, &updated); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "vesting_schedule", updated.ID, "update", before, updated)
+	return convert.ToGQLVestingSchedule(&updated), nil
+}
+
+func (r *mutationResolver) ArchiveVestingSchedule(ctx context.Context, id string) (*model.VestingSchedule, error) {
+	vs, err := r.VestingSchedules.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *vs
+	if err := r.VestingSchedules.Archive(ctx, vs); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "vesting_schedule", vs.ID, "archive", before, vs)
 	return convert.ToGQLVestingSchedule(vs), nil
 }
 
@@ -82,11 +156,55 @@ func (r *mutationResolver) IssueGrant(ctx context.Context, input model.IssueGran
 		StakeholderID:     input.StakeholderID,
 		ShareClassID:      input.ShareClassID,
 		VestingScheduleID: input.VestingScheduleID,
+		Type:              domain.GrantTypeOption,
 		Quantity:          decimal.Decimal(input.Quantity),
 		GrantDate:         time.Time(input.GrantDate),
 		ExercisePrice:     convert.DecOrDefault(input.ExercisePrice, decimal.Zero),
 		Notes:             input.Notes,
+
+		EarlyExerciseAllowed: convert.BoolOrDefault(input.EarlyExerciseAllowed, false),
+		Milestones:           convert.GQLGrantMilestonesToDomain(input.Milestones),
+	}
+	if input.Type != nil {
+		g.Type = convert.GQLGrantTypeToDomain(*input.Type)
+	}
+	if g.IssuedAtGrant() && g.EarlyExerciseAllowed {
+		return nil, &domain.ErrValidation{Field: "earlyExerciseAllowed", Message: "shares issued at grant have no options to exercise"}
+	}
+	if g.Type == domain.GrantTypeStock && (g.VestingScheduleID != nil || len(g.Milestones) > 0) {
+		return nil, &domain.ErrValidation{Field: "type", Message: "stock is issued fully vested; use RSA for shares that vest"}
+	}
+	g.VestingCommencementDate = g.GrantDate
+	if input.VestingCommencementDate != nil {
+		g.VestingCommencementDate = time.Time(*input.VestingCommencementDate)
+	}
+	if g.VestingScheduleID != nil {
+		vs, err := r.VestingSchedules.GetByID(ctx, *g.VestingScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		if vs.CompanyID != g.CompanyID {
+			return nil, &domain.ErrValidation{Field: "vestingScheduleID", Message: "schedule belongs to a different company"}
+		}
+		if vs.ArchivedAt != nil {
+			return nil, &domain.ErrValidation{Field: "vestingScheduleID", Message: "schedule is archived"}
+		}
+		g.VestingSchedule = vs
+	}
+	if err := vestingengine.ValidateGrant(*g); err != nil {
+		return nil, err
+	}
+	if input.OptionPoolID != nil {
+		pool, err := r.OptionPools.GetByID(ctx, *input.OptionPoolID)
+		if err != nil {
+			return nil, err
+		}
+		if pool.CompanyID != g.CompanyID || pool.ShareClassID != g.ShareClassID {
+			return nil, &domain.ErrValidation{Field: "optionPoolID", Message: "pool must belong to the grant's company and share class"}
+		}
+		g.OptionPoolID = &pool.ID
 	}
+	// The store checks the pool's available shares under a lock on the pool.
 	if err := r.Grants.Create(ctx, g); err != nil {
 		return nil, err
 	}
@@ -94,6 +212,156 @@ func (r *mutationResolver) IssueGrant(ctx context.Context, input model.IssueGran
 	return convert.ToGQLGrant(g), nil
 }
 
+func (r *mutationResolver) CreateOptionPool(ctx context.Context, input model.CreateOptionPoolInput) (*model.OptionPool, error) {
+	p := &domain.OptionPool{
+		CompanyID:      input.CompanyID,
+		ShareClassID:   input.ShareClassID,
+		Name:           input.Name,
+		ReservedShares: decimal.Decimal(input.ReservedShares),
+	}
+	if !p.ReservedShares.IsPositive() {
+		return nil, &domain.ErrValidation{Field: "reservedShares", Message: "must be positive"}
+	}
+	sc, err := r.ShareClasses.GetByID(ctx, p.ShareClassID)
+	if err != nil {
+		return nil, err
+	}
+	if sc.CompanyID != p.CompanyID {
+		return nil, &domain.ErrValidation{Field: "shareClassID", Message: "share class belongs to a different company"}
+	}
+	if err := r.OptionPools.Create(ctx, p); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "option_pool", p.ID, "create", nil, p)
+	return convert.ToGQLOptionPool(p), nil
+}
+
+func (r *mutationResolver) TerminateStakeholder(ctx context.Context, input model.TerminateStakeholderInput) (*model.Stakeholder, error) {
+	windowDays := convert.IntOrDefault(input.ExerciseWindowDays, vestingengine.DefaultExerciseWindowDays)
+	if windowDays < 0 {
+		return nil, &domain.ErrValidation{Field: "exerciseWindowDays", Message: "must not be negative"}
+	}
+
+	sh, err := r.Stakeholders.GetByID(ctx, input.StakeholderID)
+	if err != nil {
+		return nil, err
+	}
+	if sh.TerminationDate != nil {
+		return nil, &domain.ErrConflict{Message: fmt.Sprintf("stakeholder %s is already terminated", sh.ID)}
+	}
+
+	grants, err := r.Grants.ListByStakeholder(ctx, sh.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.attachSchedules(ctx, grants); err != nil {
+		return nil, err
+	}
+
+	terminationDate := time.Time(input.TerminationDate)
+	before := *sh
+	beforeGrants := append([]domain.Grant(nil), grants...)
+	for i := range grants {
+		grants[i] = vestingengine.Terminate(grants[i], terminationDate, windowDays, convert.GQLDecToDecPtr(input.FairMarketValue))
+	}
+	reason := convert.GQLTerminationReasonToDomain(input.Reason)
+	sh.TerminationDate = &terminationDate
+	sh.TerminationReason = &reason
+
+	if err := r.Stakeholders.Terminate(ctx, sh, grants); err != nil {
+		return nil, err
+	}
+
+	r.Audit.Record(ctx, "stakeholder", sh.ID, "terminate", before, sh)
+	for i := range grants {
+		r.Audit.Record(ctx, "grant", grants[i].ID, "terminate", beforeGrants[i], grants[i])
+	}
+
+	msh := convert.ToGQLStakeholder(sh)
+	for i := range grants {
+		msh.Grants = append(msh.Grants, convert.ToGQLGrant(&grants[i]))
+	}
+	return msh, nil
+}
+
+func (r *mutationResolver) ExerciseGrant(ctx context.Context, input model.ExerciseGrantInput) (*model.Grant, error) {
+	g, err := r.Grants.GetByID(ctx, input.GrantID)
+	if err != nil {
+		return nil, err
+	}
+	grants := []domain.Grant{*g}
+	if err := r.attachSchedules(ctx, grants); err != nil {
+		return nil, err
+	}
+	g = &grants[0]
+
+	e := domain.GrantExercise{
+		Quantity:     decimal.Decimal(input.Quantity),
+		ExerciseDate: time.Time(input.ExerciseDate),
+	}
+	before := *g
+	validate := func(current domain.Grant) error {
+		return vestingengine.ValidateExercise(current, e.Quantity, e.ExerciseDate)
+	}
+	if err := r.GrantExercises.Create(ctx, g, &e, validate); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "grant", g.ID, "exercise", before, g)
+	return convert.ToGQLGrant(g), nil
+}
+
+func (r *mutationResolver) RepurchaseShares(ctx context.Context, input model.RepurchaseSharesInput) (*model.Grant, error) {
+	g, err := r.Grants.GetByID(ctx, input.GrantID)
+	if err != nil {
+		return nil, err
+	}
+	grants := []domain.Grant{*g}
+	if err := r.attachSchedules(ctx, grants); err != nil {
+		return nil, err
+	}
+
+	before := grants[0]
+	repurchased, err := vestingengine.Repurchase(grants[0], time.Time(input.RepurchaseDate))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Grants.Repurchase(ctx, &repurchased); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "grant", repurchased.ID, "repurchase", before, repurchased)
+	return convert.ToGQLGrant(&repurchased), nil
+}
+
+func (r *mutationResolver) RecordLeave(ctx context.Context, input model.RecordLeaveInput) (*model.LeaveOfAbsence, error) {
+	l := &domain.LeaveOfAbsence{
+		StakeholderID: input.StakeholderID,
+		GrantID:       input.GrantID,
+		StartDate:     time.Time(input.StartDate),
+		EndDate:       time.Time(input.EndDate),
+		IsPaid:        convert.BoolOrDefault(input.IsPaid, false),
+	}
+	if !l.EndDate.After(l.StartDate) {
+		return nil, &domain.ErrValidation{Field: "endDate", Message: "must be after startDate"}
+	}
+	if _, err := r.Stakeholders.GetByID(ctx, l.StakeholderID); err != nil {
+		return nil, err
+	}
+	if l.GrantID != nil {
+		g, err := r.Grants.GetByID(ctx, *l.GrantID)
+		if err != nil {
+			return nil, err
+		}
+		if g.StakeholderID != l.StakeholderID {
+			return nil, &domain.ErrValidation{Field: "grantID", Message: "grant belongs to a different stakeholder"}
+		}
+	}
+	if err := r.Leaves.Create(ctx, l); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "leave_of_absence", l.ID, "create", nil, l)
+	return convert.ToGQLLeave(l), nil
+}
+
 func (r *mutationResolver) RecordFundingRound(ctx context.Context, input model.RecordFundingRoundInput) (*model.FundingRound, error) {
 	fr := &domain.FundingRound{
 		CompanyID:     input.CompanyID,
@@ -103,6 +371,15 @@ func (r *mutationResolver) RecordFundingRound(ctx context.Context, input model.R
 		PricePerShare: decimal.Decimal(input.PricePerShare),
 		ShareClassID:  input.ShareClassID,
 		RoundDate:     time.Time(input.RoundDate),
+
+		OptionPoolIncrease: convert.DecOrDefault(input.OptionPoolIncrease, decimal.Zero),
+		PromisedOptions:    convert.DecOrDefault(input.PromisedOptions, decimal.Zero),
+	}
+	if fr.OptionPoolIncrease.IsNegative() {
+		return nil, &domain.ErrValidation{Field: "optionPoolIncrease", Message: "must not be negative"}
+	}
+	if fr.PromisedOptions.IsNegative() {
+		return nil, &domain.ErrValidation{Field: "promisedOptions", Message: "must not be negative"}
 	}
 	if err := r.FundingRounds.Create(ctx, fr); err != nil {
 		return nil, err
@@ -119,12 +396,34 @@ func (r *mutationResolver) IssueSafe(ctx context.Context, input model.IssueSAFEI
 		ValuationCap:     convert.GQLDecToDecPtr(input.ValuationCap),
 		DiscountRate:     convert.GQLDecToDecPtr(input.DiscountRate),
 		SAFEType:         convert.GQLSAFETypeToDomain(input.SafeType),
+		IsMFN:            convert.BoolOrDefault(input.IsMfn, false),
 		IssueDate:        time.Time(input.IssueDate),
+
+		CapitalizationDefinition: convert.GQLCapitalizationDefinitionToDomain(input.CapitalizationDefinition),
+	}
+	if sn.IsMFN && sn.ValuationCap != nil {
+		return nil, &domain.ErrValidation{Field: "valuationCap", Message: "an MFN SAFE is uncapped; it inherits a cap from later SAFEs"}
+	}
+	safes, err := r.SAFENotes.ListByCompany(ctx, sn.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	var notices []domain.MFNNotice
+	for _, mfn := range safeengine.MFNBeneficiaries(append(safes, *sn), *sn) {
+		notices = append(notices, domain.MFNNotice{
+			SAFEID:        mfn.ID,
+			StakeholderID: mfn.StakeholderID,
+			ValuationCap:  sn.ValuationCap,
+			DiscountRate:  sn.DiscountRate,
+		})
 	}
-	if err := r.SAFENotes.Create(ctx, sn); err != nil {
+	if err := r.SAFENotes.CreateWithNotices(ctx, sn, notices); err != nil {
 		return nil, err
 	}
 	r.Audit.Record(ctx, "safe_note", sn.ID, "create", nil, sn)
+	for i := range notices {
+		r.Audit.Record(ctx, "mfn_notice", notices[i].ID, "create", nil, &notices[i])
+	}
 	return convert.ToGQLSAFENote(sn), nil
 }
 
@@ -141,248 +440,689 @@ func (r *mutationResolver) ConvertSafe(ctx context.Context, safeID string, round
 	if err != nil {
 		return nil, err
 	}
+	if round.CompanyID != sn.CompanyID {
+		return nil, &domain.ErrValidation{Field: "roundID", Message: "round belongs to a different company"}
+	}
+	if sn.IssueDate.After(round.RoundDate) {
+		return nil, &domain.ErrValidation{Field: "roundID", Message: fmt.Sprintf("SAFE %s was issued after the round date", safeID)}
+	}
 
-	grants, err := r.Grants.ListByCompany(ctx, sn.CompanyID)
+	caps, err := r.capitalizations(ctx, sn.CompanyID, round)
 	if err != nil {
 		return nil, err
 	}
-
-	preMoneyShares := decimal.Zero
-	for _, g := range grants {
-		preMoneyShares = preMoneyShares.Add(g.Quantity)
+	others, err := r.SAFENotes.ListByCompany(ctx, sn.CompanyID)
+	if err != nil {
+		return nil, err
 	}
 
-	result := safeengine.Convert(*sn, *round, preMoneyShares)
+	result := safeengine.ConvertAt(*sn, others, *round, caps)
 
-	if err := r.SAFENotes.MarkConverted(ctx, safeID, roundID); err != nil {
+	before := *sn
+	sn.Conversion = &result
+	if err := r.SAFENotes.MarkConverted(ctx, sn, round); err != nil {
 		return nil, err
 	}
+	r.Audit.Record(ctx, "safe_note", safeID, "convert", before, sn)
 
-	r.Audit.Record(ctx, "safe_note", safeID, "convert", sn, map[string]interface{}{
-		"round_id":      roundID,
-		"shares_issued": result.SharesIssued.String(),
-		"effective_pps": result.EffectivePPS.String(),
-		"method":        result.ConversionMethod,
-	})
-
-	return &model.SAFEConversionResult{
-		SafeID:           result.SAFEID,
-		SharesIssued:     model.Decimal(result.SharesIssued),
-		EffectivePps:     model.Decimal(result.EffectivePPS),
-		ConversionMethod: result.ConversionMethod,
-	}, nil
+	return convert.ToGQLSAFEConversionResult(sn), nil
 }
 
-// ─── Queries ──────────────────────────────────────────────────────────────────
-
-func (r *queryResolver) Company(ctx context.Context, id string) (*model.Company, error) {
-	c, err := r.Companies.GetByID(ctx, id)
+func (r *mutationResolver) ConvertAllSAFEs(ctx context.Context, roundID string) ([]*model.SAFEConversionResult, error) {
+	round, err := r.FundingRounds.GetByID(ctx, roundID)
 	if err != nil {
 		return nil, err
 	}
 
-	mc := convert.ToGQLCompany(c)
-
-	stakeholders, err := r.Stakeholders.ListByCompany(ctx, id)
+	all, err := r.SAFENotes.ListByCompany(ctx, round.CompanyID)
 	if err != nil {
 		return nil, err
 	}
-	for i := range stakeholders {
-		mc.Stakeholders = append(mc.Stakeholders, convert.ToGQLStakeholder(&stakeholders[i]))
+	var safes []domain.SAFENote
+	for _, sn := range all {
+		if sn.IsConverted || sn.IssueDate.After(round.RoundDate) {
+			continue
+		}
+		safes = append(safes, sn)
 	}
 
-	classes, err := r.ShareClasses.ListByCompany(ctx, id)
+	caps, err := r.capitalizations(ctx, round.CompanyID, round)
 	if err != nil {
 		return nil, err
 	}
-	for i := range classes {
-		mc.ShareClasses = append(mc.ShareClasses, convert.ToGQLShareClass(&classes[i]))
-	}
 
-	grants, err := r.Grants.ListByCompany(ctx, id)
+	results, err := safeengine.ConvertAllAt(safes, all, *round, caps)
 	if err != nil {
 		return nil, err
 	}
-	for i := range grants {
-		mc.Grants = append(mc.Grants, convert.ToGQLGrant(&grants[i]))
+	before := make([]domain.SAFENote, len(safes))
+	copy(before, safes)
+	for i := range safes {
+		safes[i].Conversion = &results[i]
 	}
-
-	rounds, err := r.FundingRounds.ListByCompany(ctx, id)
-	if err != nil {
+	if err := r.SAFENotes.MarkAllConverted(ctx, safes, round); err != nil {
 		return nil, err
 	}
-	for i := range rounds {
-		mc.FundingRounds = append(mc.FundingRounds, convert.ToGQLFundingRound(&rounds[i]))
+
+	out := make([]*model.SAFEConversionResult, len(safes))
+	for i := range safes {
+		r.Audit.Record(ctx, "safe_note", safes[i].ID, "convert", before[i], safes[i])
+		out[i] = convert.ToGQLSAFEConversionResult(&safes[i])
 	}
+	return out, nil
+}
 
-	safes, err := r.SAFENotes.ListByCompany(ctx, id)
-	if err != nil {
+func (r *mutationResolver) IssueConvertibleNote(ctx context.Context, input model.IssueConvertibleNoteInput) (*model.ConvertibleNote, error) {
+	n := &domain.ConvertibleNote{
+		CompanyID:                input.CompanyID,
+		StakeholderID:            input.StakeholderID,
+		Principal:                decimal.Decimal(input.Principal),
+		InterestRate:             decimal.Decimal(input.InterestRate),
+		InterestType:             domain.InterestSimple,
+		DayCount:                 domain.DayCountActual365,
+		IssueDate:                time.Time(input.IssueDate),
+		MaturityDate:             time.Time(input.MaturityDate),
+		ValuationCap:             convert.GQLDecToDecPtr(input.ValuationCap),
+		DiscountRate:             convert.GQLDecToDecPtr(input.DiscountRate),
+		QualifiedFinancingAmount: convert.GQLDecToDecPtr(input.QualifiedFinancingAmount),
+		MaturityValuationCap:     convert.GQLDecToDecPtr(input.MaturityValuationCap),
+	}
+	if input.InterestType != nil {
+		n.InterestType = convert.GQLInterestTypeToDomain(*input.InterestType)
+	}
+	if input.DayCount != nil {
+		n.DayCount = convert.GQLDayCountToDomain(*input.DayCount)
+	}
+	if err := noteengine.Validate(*n); err != nil {
 		return nil, err
 	}
-	for i := range safes {
-		mc.SafeNotes = append(mc.SafeNotes, convert.ToGQLSAFENote(&safes[i]))
+	if err := r.ConvertibleNotes.Create(ctx, n); err != nil {
+		return nil, err
 	}
-
-	return mc, nil
+	r.Audit.Record(ctx, "convertible_note", n.ID, "create", nil, n)
+	return convert.ToGQLConvertibleNote(n), nil
 }
 
-func (r *queryResolver) Stakeholder(ctx context.Context, id string) (*model.Stakeholder, error) {
-	sh, err := r.Stakeholders.GetByID(ctx, id)
+func (r *mutationResolver) ConvertNote(ctx context.Context, noteID string, roundID string) (*model.NoteSettlement, error) {
+	n, err := r.ConvertibleNotes.GetByID(ctx, noteID)
 	if err != nil {
 		return nil, err
 	}
+	if n.Settlement != nil {
+		return nil, &domain.ErrConflict{Message: fmt.Sprintf("convertible note %s is already settled", noteID)}
+	}
+	round, err := r.FundingRounds.GetByID(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	if round.CompanyID != n.CompanyID {
+		return nil, &domain.ErrValidation{Field: "roundID", Message: "round belongs to a different company"}
+	}
 
-	msh := convert.ToGQLStakeholder(sh)
-
-	grants, err := r.Grants.ListByStakeholder(ctx, id)
+	caps, err := r.capitalizations(ctx, n.CompanyID, round)
 	if err != nil {
 		return nil, err
 	}
-	for i := range grants {
-		msh.Grants = append(msh.Grants, convert.ToGQLGrant(&grants[i]))
+	settlement, err := noteengine.Convert(*n, *round, caps[domain.CapitalizationFullyDiluted].Total)
+	if err != nil {
+		return nil, err
 	}
 
-	return msh, nil
+	before := *n
+	n.Settlement = &settlement
+	n.SettledInRound = &round.ID
+	if err := r.ConvertibleNotes.Settle(ctx, n, round.ShareClassID); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "convertible_note", n.ID, "convert", before, n)
+	return convert.ToGQLNoteSettlement(n), nil
 }
 
-func (r *queryResolver) VestingStatus(ctx context.Context, grantID string, asOfDate model.Date) (*model.VestingStatus, error) {
-	g, err := r.Grants.GetByID(ctx, grantID)
+func (r *mutationResolver) SettleNoteAtMaturity(ctx context.Context, input model.SettleNoteAtMaturityInput) (*model.NoteSettlement, error) {
+	n, err := r.ConvertibleNotes.GetByID(ctx, input.NoteID)
 	if err != nil {
 		return nil, err
 	}
+	if n.Settlement != nil {
+		return nil, &domain.ErrConflict{Message: fmt.Sprintf("convertible note %s is already settled", n.ID)}
+	}
 
-	if g.VestingScheduleID != nil {
-		vs, err := r.VestingSchedules.GetByID(ctx, *g.VestingScheduleID)
+	var shareClassID string
+	if n.MaturityValuationCap != nil {
+		if input.ShareClassID == nil {
+			return nil, &domain.ErrValidation{Field: "shareClassID", Message: "required to convert at the maturity cap"}
+		}
+		sc, err := r.ShareClasses.GetByID(ctx, *input.ShareClassID)
 		if err != nil {
 			return nil, err
 		}
-		g.VestingSchedule = vs
-	}
-
-	status := vestingengine.Calculate(*g, time.Time(asOfDate))
-	return convert.ToGQLVestingStatus(&status), nil
-}
-
-func (r *queryResolver) CapTable(ctx context.Context, companyID string) (*model.CapTableSnapshot, error) {
-	grants, err := r.Grants.ListByCompany(ctx, companyID)
-	if err != nil {
-		return nil, err
+		if sc.CompanyID != n.CompanyID {
+			return nil, &domain.ErrValidation{Field: "shareClassID", Message: "share class belongs to a different company"}
+		}
+		shareClassID = sc.ID
 	}
 
-	shIDs, scIDs := collectGrantIDs(grants)
-	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
+	fullyDilutedShares, err := r.fullyDilutedShares(ctx, n.CompanyID)
 	if err != nil {
 		return nil, err
 	}
-	scMap, err := r.ShareClasses.GetByIDs(ctx, scIDs)
+	settlement, err := noteengine.Mature(*n, time.Time(input.SettlementDate), fullyDilutedShares)
 	if err != nil {
 		return nil, err
 	}
 
-	type key struct{ shID, scID string }
-	type entry struct {
-		shName string
-		scName string
-		shares decimal.Decimal
-	}
-	agg := map[key]*entry{}
-	totalShares := decimal.Zero
-
-	for _, g := range grants {
-		k := key{g.StakeholderID, g.ShareClassID}
-		if _, ok := agg[k]; !ok {
-			sh, sc := shMap[g.StakeholderID], scMap[g.ShareClassID]
-			if sh == nil || sc == nil {
-				return nil, fmt.Errorf("missing stakeholder %s or share class %s", g.StakeholderID, g.ShareClassID)
-			}
-			agg[k] = &entry{shName: sh.Name, scName: sc.Name, shares: decimal.Zero}
-		}
-		agg[k].shares = agg[k].shares.Add(g.Quantity)
-		totalShares = totalShares.Add(g.Quantity)
-	}
-
-	hundred := decimal.NewFromInt(100)
-	entries := make([]*model.CapTableEntry, 0, len(agg))
-	for k, e := range agg {
-		pct := decimal.Zero
-		if totalShares.GreaterThan(decimal.Zero) {
-			pct = e.shares.Div(totalShares).Mul(hundred).RoundFloor(4)
-		}
-		shID := k.shID
-		entries = append(entries, &model.CapTableEntry{
-			StakeholderID:   &shID,
-			StakeholderName: e.shName,
-			ShareClassName:  e.scName,
-			Shares:          model.Decimal(e.shares),
-			OwnershipPct:    model.Decimal(pct),
-		})
+	before := *n
+	n.Settlement = &settlement
+	if err := r.ConvertibleNotes.Settle(ctx, n, shareClassID); err != nil {
+		return nil, err
 	}
-
-	cID := companyID
-	return &model.CapTableSnapshot{
-		CompanyID:   &cID,
-		TotalShares: model.Decimal(totalShares),
-		Entries:     entries,
-	}, nil
+	r.Audit.Record(ctx, "convertible_note", n.ID, settlement.Method, before, n)
+	return convert.ToGQLNoteSettlement(n), nil
 }
 
-func (r *queryResolver) ModelDilution(ctx context.Context, input model.DilutionModelInput) (*model.DilutionResult, error) {
-	grants, err := r.Grants.ListByCompany(ctx, input.CompanyID)
+func (r *mutationResolver) RecordMilestoneAchieved(ctx context.Context, milestoneID string, achievedDate model.Date) (*model.GrantMilestone, error) {
+	m, err := r.GrantMilestones.GetByID(ctx, milestoneID)
 	if err != nil {
 		return nil, err
 	}
+	if m.AchievedDate != nil {
+		return nil, &domain.ErrConflict{Message: fmt.Sprintf("milestone %s is already achieved", milestoneID)}
+	}
 
-	shIDs, scIDs := collectGrantIDs(grants)
-	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
+	g, err := r.Grants.GetByID(ctx, m.GrantID)
 	if err != nil {
 		return nil, err
 	}
-	scMap, err := r.ShareClasses.GetByIDs(ctx, scIDs)
-	if err != nil {
-		return nil, err
+	date := time.Time(achievedDate)
+	if date.Before(g.GrantDate) {
+		return nil, &domain.ErrValidation{Field: "achievedDate", Message: "must not be before the grant date"}
 	}
 
-	type key struct{ shID, scID string }
-	type holder struct {
-		shID, shName, scName string
-		shares               decimal.Decimal
+	before := *m
+	m.AchievedDate = &date
+	if err := r.GrantMilestones.MarkAchieved(ctx, m); err != nil {
+		return nil, err
+	}
+	r.Audit.Record(ctx, "grant_milestone", m.ID, "achieve", before, m)
+	return convert.ToGQLGrantMilestone(m), nil
+}
+
+// ─── Queries ──────────────────────────────────────────────────────────────────
+
+func (r *queryResolver) Company(ctx context.Context, id string) (*model.Company, error) {
+	c, err := r.Companies.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mc := convert.ToGQLCompany(c)
+
+	stakeholders, err := r.Stakeholders.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stakeholders {
+		mc.Stakeholders = append(mc.Stakeholders, convert.ToGQLStakeholder(&stakeholders[i]))
+	}
+
+	classes, err := r.ShareClasses.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range classes {
+		mc.ShareClasses = append(mc.ShareClasses, convert.ToGQLShareClass(&classes[i]))
+	}
+
+	grants, err := r.Grants.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range grants {
+		mc.Grants = append(mc.Grants, convert.ToGQLGrant(&grants[i]))
+	}
+
+	rounds, err := r.FundingRounds.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rounds {
+		mc.FundingRounds = append(mc.FundingRounds, convert.ToGQLFundingRound(&rounds[i]))
+	}
+
+	safes, err := r.SAFENotes.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range safes {
+		mc.SafeNotes = append(mc.SafeNotes, convert.ToGQLSAFENote(&safes[i]))
+	}
+
+	notes, err := r.ConvertibleNotes.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range notes {
+		mc.ConvertibleNotes = append(mc.ConvertibleNotes, convert.ToGQLConvertibleNote(&notes[i]))
+	}
+
+	pools, err := r.OptionPools.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pools {
+		mc.OptionPools = append(mc.OptionPools, convert.ToGQLOptionPool(&pools[i]))
+	}
+
+	schedules, err := r.VestingSchedules.ListByCompany(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range schedules {
+		mc.VestingSchedules = append(mc.VestingSchedules, convert.ToGQLVestingSchedule(&schedules[i]))
+	}
+
+	return mc, nil
+}
+
+func (r *queryResolver) Stakeholder(ctx context.Context, id string) (*model.Stakeholder, error) {
+	sh, err := r.Stakeholders.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	msh := convert.ToGQLStakeholder(sh)
+
+	grants, err := r.Grants.ListByStakeholder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range grants {
+		msh.Grants = append(msh.Grants, convert.ToGQLGrant(&grants[i]))
+	}
+
+	leaves, err := r.Leaves.ListByStakeholder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range leaves {
+		msh.Leaves = append(msh.Leaves, convert.ToGQLLeave(&leaves[i]))
+	}
+
+	notices, err := r.MFNNotices.ListByStakeholder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range notices {
+		msh.MfnNotices = append(msh.MfnNotices, convert.ToGQLMFNNotice(&notices[i]))
+	}
+
+	return msh, nil
+}
+
+func (r *queryResolver) VestingStatus(ctx context.Context, grantID string, asOfDate model.Date) (*model.VestingStatus, error) {
+	g, err := r.Grants.GetByID(ctx, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.VestingScheduleID != nil {
+		vs, err := r.VestingSchedules.GetByID(ctx, *g.VestingScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		g.VestingSchedule = vs
+	}
+
+	status := vestingengine.Calculate(*g, time.Time(asOfDate))
+	return convert.ToGQLVestingStatus(&status), nil
+}
+
+func (r *queryResolver) VestingSchedule(ctx context.Context, grantID string) ([]*model.VestEvent, error) {
+	g, err := r.Grants.GetByID(ctx, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.VestingScheduleID != nil {
+		vs, err := r.VestingSchedules.GetByID(ctx, *g.VestingScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		g.VestingSchedule = vs
+	}
+
+	return convert.ToGQLVestEvents(vestingengine.Timeline(*g)), nil
+}
+
+func (r *queryResolver) VestingForecast(ctx context.Context, companyID string, from model.Date, to model.Date, bucket *model.ForecastBucket) ([]*model.VestingForecastPeriod, error) {
+	if time.Time(to).Before(time.Time(from)) {
+		return nil, &domain.ErrValidation{Field: "to", Message: "must not be before from"}
+	}
+
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	shIDs, scIDs := collectGrantIDs(grants)
+	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
+	if err != nil {
+		return nil, err
+	}
+	scMap, err := r.ShareClasses.GetByIDs(ctx, scIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.attachSchedules(ctx, grants); err != nil {
+		return nil, err
+	}
+
+	inputs := make([]vestingengine.ForecastGrant, 0, len(grants))
+	for _, g := range grants {
+		sh, sc := shMap[g.StakeholderID], scMap[g.ShareClassID]
+		if sh == nil || sc == nil {
+			return nil, fmt.Errorf("missing stakeholder %s or share class %s", g.StakeholderID, g.ShareClassID)
+		}
+		inputs = append(inputs, vestingengine.ForecastGrant{
+			Grant:          g,
+			ShareClassName: sc.Name,
+			Role:           sh.Role,
+		})
+	}
+
+	b := domain.BucketMonth
+	if bucket != nil {
+		b = convert.GQLForecastBucketToDomain(*bucket)
+	}
+	periods := vestingengine.Forecast(inputs, time.Time(from), time.Time(to), b)
+	return convert.ToGQLVestingForecast(periods), nil
+}
+
+func (r *queryResolver) VestingStatusWithEvents(ctx context.Context, grantID string, asOfDate model.Date, changeOfControlDate *model.Date, terminationDate *model.Date) (*model.VestingStatus, error) {
+	g, err := r.Grants.GetByID(ctx, grantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.VestingScheduleID != nil {
+		vs, err := r.VestingSchedules.GetByID(ctx, *g.VestingScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		g.VestingSchedule = vs
+	}
+
+	status := vestingengine.CalculateWithEvents(*g, time.Time(asOfDate), vestingengine.AccelerationEvents{
+		ChangeOfControlDate: convert.DatePtrToTimePtr(changeOfControlDate),
+		TerminationDate:     convert.DatePtrToTimePtr(terminationDate),
+	})
+	return convert.ToGQLVestingStatus(&status), nil
+}
+
+func (r *queryResolver) CapTable(ctx context.Context, companyID string, asOfDate *model.Date, basis *model.CapTableBasis) (*model.CapTableSnapshot, error) {
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.attachSchedules(ctx, grants); err != nil {
+		return nil, err
+	}
+	safes, err := r.SAFENotes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	notes, err := r.ConvertibleNotes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := r.OptionPools.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	asOf := time.Now().UTC().Truncate(24 * time.Hour)
+	if asOfDate != nil {
+		asOf = time.Time(*asOfDate)
+	}
+
+	shIDs, scIDs := collectGrantIDs(grants)
+	for _, sn := range safes {
+		shIDs = append(shIDs, sn.StakeholderID)
+	}
+	for _, n := range notes {
+		shIDs = append(shIDs, n.StakeholderID)
+	}
+	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
+	if err != nil {
+		return nil, err
+	}
+	scMap, err := r.ShareClasses.GetByIDs(ctx, scIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// One entry per holder and share class, or per holder and instrument
+	// for SAFEs and notes, in the order first seen.
+	type key struct{ shID, class string }
+	var entries []domain.CapTableEntry
+	index := map[key]int{}
+	entryFor := func(shID, class string) (*domain.CapTableEntry, error) {
+		k := key{shID, class}
+		if i, ok := index[k]; ok {
+			return &entries[i], nil
+		}
+		sh := shMap[shID]
+		if sh == nil {
+			return nil, fmt.Errorf("missing stakeholder %s", shID)
+		}
+		index[k] = len(entries)
+		entries = append(entries, domain.CapTableEntry{StakeholderID: sh.ID, StakeholderName: sh.Name, ShareClassName: class})
+		return &entries[len(entries)-1], nil
+	}
+
+	capitalization := decimal.Zero
+	for _, g := range grants {
+		issued, options := captable.GrantShares(g, asOf)
+		outstanding := issued.Add(options)
+		if outstanding.IsZero() {
+			continue
+		}
+		capitalization = capitalization.Add(outstanding)
+		sc := scMap[g.ShareClassID]
+		if sc == nil {
+			return nil, fmt.Errorf("missing share class %s", g.ShareClassID)
+		}
+		e, err := entryFor(g.StakeholderID, sc.Name)
+		if err != nil {
+			return nil, err
+		}
+		e.IssuedShares = e.IssuedShares.Add(issued)
+		e.OptionShares = e.OptionShares.Add(options)
+		e.ExercisedUnvestedShares = e.ExercisedUnvestedShares.Add(vestingengine.Calculate(g, asOf).RepurchasableShares)
+	}
+
+	for _, sn := range safes {
+		if sn.IsConverted || sn.IssueDate.After(asOf) {
+			continue
+		}
+		e, err := entryFor(sn.StakeholderID, "SAFE")
+		if err != nil {
+			return nil, err
+		}
+		e.ConvertibleShares = e.ConvertibleShares.Add(safeengine.LiquidityShares(sn, capitalization))
+	}
+	for _, n := range notes {
+		if n.Settlement != nil || n.IssueDate.After(asOf) {
+			continue
+		}
+		e, err := entryFor(n.StakeholderID, "Convertible Note")
+		if err != nil {
+			return nil, err
+		}
+		e.ConvertibleShares = e.ConvertibleShares.Add(noteengine.LiquidityShares(n, asOf, capitalization))
+	}
+
+	pool := decimal.Zero
+	for _, p := range pools {
+		pool = pool.Add(p.AvailableShares())
+	}
+
+	snap := captable.Build(entries, pool, convert.GQLCapTableBasisToDomain(basis))
+	snap.CompanyID = companyID
+	snap.AsOfDate = asOf
+	return convert.ToGQLCapTableSnapshot(&snap), nil
+}
+
+func (r *queryResolver) ModelDilution(ctx context.Context, input model.DilutionModelInput) (*model.DilutionResult, error) {
+	existing, existingPool, err := r.dilutionHoldings(ctx, input.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	round, err := r.dilutionRound(ctx, &input)
+	if err != nil {
+		return nil, err
+	}
+	round.ExistingPool = existingPool
+	converters, err := r.roundConverters(ctx, input.CompanyID, []dilution.RoundInput{round})
+	if err != nil {
+		return nil, err
+	}
+	result, err := dilution.ModelWithConversions(existing, round, converters[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.ToGQLDilutionResult(&result), nil
+}
+
+func (r *queryResolver) ModelRoundSequence(ctx context.Context, companyID string, rounds []*model.ModeledRoundInput) (*model.RoundSequenceResult, error) {
+	if len(rounds) == 0 {
+		return nil, &domain.ErrValidation{Field: "rounds", Message: "at least one round is required"}
+	}
+	existing, existingPool, err := r.dilutionHoldings(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]dilution.RoundInput, len(rounds))
+	for i, rd := range rounds {
+		round, err := r.dilutionRound(ctx, &model.DilutionModelInput{
+			CompanyID:         companyID,
+			RoundName:         rd.RoundName,
+			PreMoneyValuation: rd.PreMoneyValuation,
+			AmountRaised:      rd.AmountRaised,
+			NewShareClass:     rd.NewShareClass,
+			InvestorName:      rd.InvestorName,
+			Allocations:       rd.Allocations,
+			TargetPoolPct:     rd.TargetPoolPct,
+		})
+		var ve *domain.ErrValidation
+		if errors.As(err, &ve) {
+			field := fmt.Sprintf("rounds[%d]", i)
+			if ve.Field != "" {
+				field += "." + ve.Field
+			}
+			return nil, &domain.ErrValidation{Field: field, Message: ve.Message}
+		}
+		if err != nil {
+			return nil, err
+		}
+		inputs[i] = round
+	}
+	inputs[0].ExistingPool = existingPool
+
+	converters, err := r.roundConverters(ctx, companyID, inputs)
+	if err != nil {
+		return nil, err
+	}
+	result, err := dilution.ModelSequence(existing, inputs, converters)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToGQLRoundSequenceResult(&result), nil
+}
+
+func (r *queryResolver) PreviewSAFEConversions(ctx context.Context, companyID string, scenarios []*model.SAFEConversionScenarioInput) ([]*model.SAFEConversionPreview, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	all, err := r.SAFENotes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	var safes []domain.SAFENote
+	for _, sn := range all {
+		if sn.IsConverted || sn.IssueDate.After(today) {
+			continue
+		}
+		safes = append(safes, sn)
+	}
+
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
 	}
-	agg := map[key]*holder{}
-
+	pools, err := r.OptionPools.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	preMoneyShares := decimal.Zero
+	existing := map[string]decimal.Decimal{}
 	for _, g := range grants {
-		k := key{g.StakeholderID, g.ShareClassID}
-		if _, ok := agg[k]; !ok {
-			sh, sc := shMap[g.StakeholderID], scMap[g.ShareClassID]
-			if sh == nil || sc == nil {
-				return nil, fmt.Errorf("missing stakeholder %s or share class %s", g.StakeholderID, g.ShareClassID)
-			}
-			agg[k] = &holder{shID: sh.ID, shName: sh.Name, scName: sc.Name, shares: decimal.Zero}
-		}
-		agg[k].shares = agg[k].shares.Add(g.Quantity)
+		preMoneyShares = preMoneyShares.Add(g.OutstandingQuantity())
+		existing[g.StakeholderID] = existing[g.StakeholderID].Add(g.OutstandingQuantity())
 	}
 
-	existing := make([]dilution.StakeholderShares, 0, len(agg))
-	for _, h := range agg {
-		existing = append(existing, dilution.StakeholderShares{
-			StakeholderID:   h.shID,
-			StakeholderName: h.shName,
-			ShareClassName:  h.scName,
-			Shares:          h.shares,
-		})
+	shIDs := make([]string, 0, len(safes))
+	for _, sn := range safes {
+		shIDs = append(shIDs, sn.StakeholderID)
+	}
+	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
+	if err != nil {
+		return nil, err
 	}
 
-	result := dilution.Model(existing, dilution.RoundInput{
-		RoundName:     input.RoundName,
-		PreMoneyVal:   decimal.Decimal(input.PreMoneyValuation),
-		AmountRaised:  decimal.Decimal(input.AmountRaised),
-		NewShareClass: input.NewShareClass,
-		InvestorName:  input.InvestorName,
-	})
+	out := make([]*model.SAFEConversionPreview, len(scenarios))
+	for i, sc := range scenarios {
+		round := domain.FundingRound{
+			CompanyID:    companyID,
+			Name:         sc.Name,
+			PreMoneyVal:  decimal.Decimal(sc.PreMoneyValuation),
+			AmountRaised: decimal.Decimal(sc.AmountRaised),
+			RoundDate:    today,
+		}
+		if sc.PricePerShare != nil {
+			round.PricePerShare = decimal.Decimal(*sc.PricePerShare)
+		} else if preMoneyShares.IsPositive() {
+			round.PricePerShare = round.PreMoneyVal.Div(preMoneyShares)
+		}
 
-	return convert.ToGQLDilutionResult(&result), nil
+		caps := safeengine.Capitalizations(grants, pools, round)
+		preview, err := safeengine.Preview(safes, round, caps, existing)
+		if err != nil {
+			return nil, err
+		}
+		for j := range preview.Holders {
+			sh := shMap[preview.Holders[j].StakeholderID]
+			if sh == nil {
+				return nil, fmt.Errorf("missing stakeholder %s", preview.Holders[j].StakeholderID)
+			}
+			preview.Holders[j].StakeholderName = sh.Name
+		}
+		out[i] = convert.ToGQLSAFEConversionPreview(&preview)
+	}
+	return out, nil
 }
 
-func (r *queryResolver) Waterfall(ctx context.Context, companyID string, exitValuation model.Decimal) (*model.WaterfallResult, error) {
+func (r *queryResolver) NoteAccrual(ctx context.Context, noteID string, asOfDate model.Date) (*model.NoteAccrual, error) {
+	n, err := r.ConvertibleNotes.GetByID(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+	accrual := noteengine.Accrue(*n, time.Time(asOfDate))
+	return convert.ToGQLNoteAccrual(&accrual), nil
+}
+
+func (r *queryResolver) Waterfall(ctx context.Context, companyID string, exitValuation model.Decimal, asOfDate *model.Date) (*model.WaterfallResult, error) {
 	classes, err := r.ShareClasses.ListByCompany(ctx, companyID)
 	if err != nil {
 		return nil, err
@@ -393,17 +1133,39 @@ func (r *queryResolver) Waterfall(ctx context.Context, companyID string, exitVal
 		return nil, err
 	}
 
-	// Build class-to-holders map
+	// Build class-to-holders map, leaving out forfeited shares
 	classGrants := map[string][]domain.Grant{}
 	for _, g := range grants {
+		if g.OutstandingQuantity().IsZero() {
+			continue
+		}
 		classGrants[g.ShareClassID] = append(classGrants[g.ShareClassID], g)
 	}
 
+	asOf := time.Now().UTC().Truncate(24 * time.Hour)
+	if asOfDate != nil {
+		asOf = time.Time(*asOfDate)
+	}
+	claims, err := r.convertibleClaims(ctx, companyID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
 	shIDs, _ := collectGrantIDs(grants)
+	for _, c := range claims {
+		shIDs = append(shIDs, c.StakeholderID)
+	}
 	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
 	if err != nil {
 		return nil, err
 	}
+	for i, c := range claims {
+		sh := shMap[c.StakeholderID]
+		if sh == nil {
+			return nil, fmt.Errorf("missing stakeholder %s", c.StakeholderID)
+		}
+		claims[i].StakeholderName = sh.Name
+	}
 
 	positions := make([]waterfallengine.ShareClassPosition, 0, len(classes))
 	for _, sc := range classes {
@@ -423,9 +1185,9 @@ func (r *queryResolver) Waterfall(ctx context.Context, companyID string, exitVal
 			holders = append(holders, waterfallengine.HolderPosition{
 				StakeholderID:   sh.ID,
 				StakeholderName: sh.Name,
-				Shares:          g.Quantity,
+				Shares:          g.OutstandingQuantity(),
 			})
-			totalShares = totalShares.Add(g.Quantity)
+			totalShares = totalShares.Add(g.OutstandingQuantity())
 		}
 
 		positions = append(positions, waterfallengine.ShareClassPosition{
@@ -435,7 +1197,7 @@ func (r *queryResolver) Waterfall(ctx context.Context, companyID string, exitVal
 		})
 	}
 
-	result := waterfallengine.Calculate(positions, decimal.Decimal(exitValuation))
+	result := waterfallengine.CalculateWithClaims(positions, claims, decimal.Decimal(exitValuation))
 
 	payouts := make([]*model.WaterfallPayout, len(result.Payouts))
 	for i, p := range result.Payouts {
@@ -447,6 +1209,10 @@ func (r *queryResolver) Waterfall(ctx context.Context, companyID string, exitVal
 			Payout:          model.Decimal(p.Payout),
 			PayoutPerShare:  model.Decimal(p.PayoutPerShare),
 		}
+		if p.ClaimElection != "" {
+			election := strings.ToUpper(p.ClaimElection)
+			payouts[i].ClaimElection = &election
+		}
 	}
 
 	return &model.WaterfallResult{
@@ -465,6 +1231,456 @@ func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 
+// outstandingShares totals the outstanding quantity of a company's grants,
+// the share base convertibleClaims values SAFEs and notes against in a
+// liquidation.
+func (r *Resolver) outstandingShares(ctx context.Context, companyID string) (decimal.Decimal, error) {
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	total := decimal.Zero
+	for _, g := range grants {
+		total = total.Add(g.OutstandingQuantity())
+	}
+	return total, nil
+}
+
+// capitalizations builds the company's capitalization under each definition
+// for round, the share bases its SAFEs convert against.
+func (r *Resolver) capitalizations(ctx context.Context, companyID string, round *domain.FundingRound) (map[domain.CapitalizationDefinition]domain.Capitalization, error) {
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := r.OptionPools.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	return safeengine.Capitalizations(grants, pools, *round), nil
+}
+
+// fullyDilutedShares totals the company's fully diluted capitalization
+// outside any round, the share base notes convert against.
+func (r *Resolver) fullyDilutedShares(ctx context.Context, companyID string) (decimal.Decimal, error) {
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	pools, err := r.OptionPools.ListByCompany(ctx, companyID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return safeengine.FullyDiluted(grants, pools).Total, nil
+}
+
+// dilutionHoldings is the company's current cap table as dilution modeling
+// sees it: each stakeholder's outstanding shares per share class, and the
+// shares left in its option pools.
+func (r *Resolver) dilutionHoldings(ctx context.Context, companyID string) ([]dilution.StakeholderShares, decimal.Decimal, error) {
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	shIDs, scIDs := collectGrantIDs(grants)
+	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+	scMap, err := r.ShareClasses.GetByIDs(ctx, scIDs)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	type key struct{ shID, scID string }
+	type holder struct {
+		shID, shName, scName string
+		shares               decimal.Decimal
+	}
+	agg := map[key]*holder{}
+
+	for _, g := range grants {
+		k := key{g.StakeholderID, g.ShareClassID}
+		if _, ok := agg[k]; !ok {
+			sh, sc := shMap[g.StakeholderID], scMap[g.ShareClassID]
+			if sh == nil || sc == nil {
+				return nil, decimal.Zero, fmt.Errorf("missing stakeholder %s or share class %s", g.StakeholderID, g.ShareClassID)
+			}
+			agg[k] = &holder{shID: sh.ID, shName: sh.Name, scName: sc.Name, shares: decimal.Zero}
+		}
+		agg[k].shares = agg[k].shares.Add(g.OutstandingQuantity())
+	}
+
+	existing := make([]dilution.StakeholderShares, 0, len(agg))
+	for _, h := range agg {
+		existing = append(existing, dilution.StakeholderShares{
+			StakeholderID:   h.shID,
+			StakeholderName: h.shName,
+			ShareClassName:  h.scName,
+			Shares:          h.shares,
+		})
+	}
+
+	pools, err := r.OptionPools.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+	existingPool := decimal.Zero
+	for _, p := range pools {
+		existingPool = existingPool.Add(p.AvailableShares())
+	}
+	return existing, existingPool, nil
+}
+
+// dilutionRound builds and validates a modeled round, checking that
+// allocations to existing stakeholders name the company's own.
+func (r *Resolver) dilutionRound(ctx context.Context, input *model.DilutionModelInput) (dilution.RoundInput, error) {
+	round := dilution.RoundInput{
+		RoundName:     input.RoundName,
+		PreMoneyVal:   decimal.Decimal(input.PreMoneyValuation),
+		AmountRaised:  decimal.Decimal(input.AmountRaised),
+		NewShareClass: input.NewShareClass,
+		TargetPoolPct: convert.DecOrDefault(input.TargetPoolPct, decimal.Zero),
+	}
+	if input.InvestorName != nil {
+		round.InvestorName = *input.InvestorName
+	}
+	if len(input.Allocations) == 0 && round.InvestorName == "" {
+		return dilution.RoundInput{}, &domain.ErrValidation{Field: "investorName", Message: "required without allocations"}
+	}
+	var allocShIDs []string
+	for _, a := range input.Allocations {
+		alloc := dilution.Allocation{InvestorName: a.InvestorName, Amount: decimal.Decimal(a.Amount)}
+		if a.StakeholderID != nil {
+			alloc.StakeholderID = *a.StakeholderID
+			allocShIDs = append(allocShIDs, alloc.StakeholderID)
+		}
+		round.Allocations = append(round.Allocations, alloc)
+	}
+	allocSh, err := r.Stakeholders.GetByIDs(ctx, allocShIDs)
+	if err != nil {
+		return dilution.RoundInput{}, err
+	}
+	for _, id := range allocShIDs {
+		if sh := allocSh[id]; sh == nil || sh.CompanyID != input.CompanyID {
+			return dilution.RoundInput{}, &domain.ErrValidation{Field: "allocations", Message: fmt.Sprintf("stakeholder %s is not in the company", id)}
+		}
+	}
+	if err := dilution.Validate(round); err != nil {
+		return dilution.RoundInput{}, err
+	}
+	return round, nil
+}
+
+// roundConverters builds a converter for each of rounds, modeled in order,
+// converting a company's unconverted SAFEs and unsettled notes as ConvertSAFE
+// and ConvertNote would if the rounds were recorded today. The SAFEs convert
+// at the first round and each note at the first round whose amount raised
+// reaches its qualified financing amount; notes no round qualifies stay
+// outstanding. Rounds with nothing to convert get a nil converter. Notes
+// convert against the company's fully diluted capitalization at the first
+// round and against the sequence's pre-round share count after it. Each
+// holder's shares are totalled.
+func (r *Resolver) roundConverters(ctx context.Context, companyID string, rounds []dilution.RoundInput) ([]dilution.Converter, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	all, err := r.SAFENotes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	var safes []domain.SAFENote
+	for _, sn := range all {
+		if !sn.IsConverted && !sn.IssueDate.After(today) {
+			safes = append(safes, sn)
+		}
+	}
+	allNotes, err := r.ConvertibleNotes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	var pending []domain.ConvertibleNote
+	for _, n := range allNotes {
+		if n.Settlement == nil && !n.IssueDate.After(today) {
+			pending = append(pending, n)
+		}
+	}
+	converters := make([]dilution.Converter, len(rounds))
+	if len(safes) == 0 && len(pending) == 0 {
+		return converters, nil
+	}
+
+	grants, err := r.Grants.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := r.OptionPools.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	fullyDilutedShares := safeengine.FullyDiluted(grants, pools).Total
+	shIDs := make([]string, 0, len(safes)+len(pending))
+	for _, sn := range safes {
+		shIDs = append(shIDs, sn.StakeholderID)
+	}
+	for _, n := range pending {
+		shIDs = append(shIDs, n.StakeholderID)
+	}
+	shMap, err := r.Stakeholders.GetByIDs(ctx, shIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range shIDs {
+		if shMap[id] == nil {
+			return nil, fmt.Errorf("missing stakeholder %s", id)
+		}
+	}
+
+	for i, input := range rounds {
+		var roundSafes []domain.SAFENote
+		if i == 0 {
+			roundSafes = safes
+		}
+		var notes, later []domain.ConvertibleNote
+		for _, n := range pending {
+			if n.QualifiedFinancingAmount != nil && input.AmountRaised.LessThan(*n.QualifiedFinancingAmount) {
+				later = append(later, n)
+				continue
+			}
+			notes = append(notes, n)
+		}
+		pending = later
+		if len(roundSafes) == 0 && len(notes) == 0 {
+			continue
+		}
+
+		first := i == 0
+		converters[i] = func(pps, poolIncrease, preRoundShares decimal.Decimal) ([]dilution.Conversion, error) {
+			round := domain.FundingRound{
+				CompanyID:          companyID,
+				Name:               input.RoundName,
+				PreMoneyVal:        input.PreMoneyVal,
+				AmountRaised:       input.AmountRaised,
+				PricePerShare:      pps,
+				RoundDate:          today,
+				OptionPoolIncrease: poolIncrease,
+			}
+
+			var out []dilution.Conversion
+			index := map[string]int{}
+			add := func(shID string, shares decimal.Decimal) {
+				i, ok := index[shID]
+				if !ok {
+					i = len(out)
+					index[shID] = i
+					out = append(out, dilution.Conversion{StakeholderID: shID, StakeholderName: shMap[shID].Name})
+				}
+				out[i].Shares = out[i].Shares.Add(shares)
+			}
+
+			if len(roundSafes) > 0 {
+				caps := safeengine.Capitalizations(grants, pools, round)
+				results, err := safeengine.ConvertAllAt(roundSafes, all, round, caps)
+				if err != nil {
+					return nil, err
+				}
+				for i, res := range results {
+					add(roundSafes[i].StakeholderID, res.SharesIssued)
+				}
+			}
+			noteShares := preRoundShares
+			if first {
+				noteShares = fullyDilutedShares
+			}
+			for _, n := range notes {
+				settlement, err := noteengine.Convert(n, round, noteShares)
+				if err != nil {
+					return nil, err
+				}
+				add(n.StakeholderID, settlement.SharesIssued)
+			}
+			return out, nil
+		}
+	}
+	return converters, nil
+}
+
+// convertibleClaims builds waterfall claims for a company's unconverted SAFEs
+// and unsettled notes, with note interest accrued to asOf. Both convert
+// against the outstanding shares at their valuation caps.
+func (r *Resolver) convertibleClaims(ctx context.Context, companyID string, asOf time.Time) ([]waterfallengine.ConvertibleClaim, error) {
+	safes, err := r.SAFENotes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	notes, err := r.ConvertibleNotes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	capitalization, err := r.outstandingShares(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims []waterfallengine.ConvertibleClaim
+	for _, sn := range safes {
+		if sn.IsConverted || sn.IssueDate.After(asOf) {
+			continue
+		}
+		claims = append(claims, waterfallengine.ConvertibleClaim{
+			StakeholderID:    sn.StakeholderID,
+			Instrument:       "SAFE",
+			CashOut:          sn.InvestmentAmount,
+			ConversionShares: safeengine.LiquidityShares(sn, capitalization),
+		})
+	}
+	for _, n := range notes {
+		if n.Settlement != nil || n.IssueDate.After(asOf) {
+			continue
+		}
+		claims = append(claims, waterfallengine.ConvertibleClaim{
+			StakeholderID:    n.StakeholderID,
+			Instrument:       "Convertible Note",
+			IsDebt:           true,
+			CashOut:          noteengine.Accrue(n, asOf).Balance,
+			ConversionShares: noteengine.LiquidityShares(n, asOf, capitalization),
+		})
+	}
+	return claims, nil
+}
+
+// attachSchedules batch-loads and attaches the vesting schedules of grants.
+func (r *Resolver) attachSchedules(ctx context.Context, grants []domain.Grant) error {
+	var ids []string
+	seen := make(map[string]struct{}, len(grants))
+	for _, g := range grants {
+		if g.VestingScheduleID == nil {
+			continue
+		}
+		if _, ok := seen[*g.VestingScheduleID]; !ok {
+			seen[*g.VestingScheduleID] = struct{}{}
+			ids = append(ids, *g.VestingScheduleID)
+		}
+	}
+	schedules, err := r.VestingSchedules.GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range grants {
+		if id := grants[i].VestingScheduleID; id != nil {
+			if grants[i].VestingSchedule = schedules[*id]; grants[i].VestingSchedule == nil {
+				return fmt.Errorf("missing vesting schedule %s", *id)
+			}
+		}
+	}
+	return nil
+}
+
+// vestingScheduleFromInput builds and validates a schedule's terms. A
+// schedule is either a list of tranches or a uniform cliff/frequency schedule.
+func vestingScheduleFromInput(input model.CreateVestingScheduleInput) (*domain.VestingSchedule, error) {
+	accel := domain.AccelerationNone
+	if input.AccelerationTrigger != nil {
+		accel = convert.GQLAccelToDomain(*input.AccelerationTrigger)
+	}
+	vs := &domain.VestingSchedule{
+		Frequency:                domain.FrequencyMonthly,
+		AccelerationTrigger:      accel,
+		AccelerationPercent:      convert.GQLDecToDecPtr(input.AccelerationPercent),
+		AccelerationMonths:       input.AccelerationMonths,
+		AccelerationWindowMonths: convert.IntOrDefault(input.AccelerationWindowMonths, 12),
+		Tranches:                 convert.GQLVestingTranchesToDomain(input.Tranches),
+		Rounding:                 domain.RoundingFractional,
+	}
+	if input.Rounding != nil {
+		vs.Rounding = convert.GQLRoundingToDomain(*input.Rounding)
+	}
+
+	if len(vs.Tranches) > 0 {
+		if input.CliffMonths != nil || input.TotalMonths != nil || input.Frequency != nil {
+			return nil, &domain.ErrValidation{Field: "tranches", Message: "cannot be combined with cliffMonths, totalMonths or frequency"}
+		}
+	} else {
+		if input.CliffMonths == nil || input.TotalMonths == nil || input.Frequency == nil {
+			return nil, &domain.ErrValidation{Message: "cliffMonths, totalMonths and frequency are required without tranches"}
+		}
+		vs.CliffMonths = *input.CliffMonths
+		vs.TotalMonths = *input.TotalMonths
+		vs.Frequency = convert.GQLFreqToDomain(*input.Frequency)
+	}
+
+	if err := vestingengine.ValidateSchedule(*vs); err != nil {
+		return nil, err
+	}
+	if len(vs.Tranches) > 0 {
+		vs.CliffMonths = vs.Tranches[0].OffsetMonths
+		vs.TotalMonths = vs.Tranches[len(vs.Tranches)-1].OffsetMonths
+	}
+	return vs, nil
+}
+
+// mergeScheduleTerms applies the terms supplied in input over vs's stored
+// terms; terms left out keep their stored values. Tranches replace the
+// schedule's cliff, total and frequency, so the two cannot be supplied
+// together, and turning a tranche schedule back into a cliff schedule needs
+// all three.
+func mergeScheduleTerms(vs domain.VestingSchedule, input model.CreateVestingScheduleInput) (*domain.VestingSchedule, error) {
+	cliffTerms := input.CliffMonths != nil || input.TotalMonths != nil || input.Frequency != nil
+	switch {
+	case len(input.Tranches) > 0:
+		if cliffTerms {
+			return nil, &domain.ErrValidation{Field: "tranches", Message: "cannot be combined with cliffMonths, totalMonths or frequency"}
+		}
+		vs.Tranches = convert.GQLVestingTranchesToDomain(input.Tranches)
+	case input.Tranches != nil || cliffTerms:
+		if len(vs.Tranches) > 0 && (input.CliffMonths == nil || input.TotalMonths == nil || input.Frequency == nil) {
+			return nil, &domain.ErrValidation{Message: "cliffMonths, totalMonths and frequency are required to replace tranches"}
+		}
+		vs.Tranches = nil
+	}
+
+	if input.CliffMonths != nil {
+		vs.CliffMonths = *input.CliffMonths
+	}
+	if input.TotalMonths != nil {
+		vs.TotalMonths = *input.TotalMonths
+	}
+	if input.Frequency != nil {
+		vs.Frequency = convert.GQLFreqToDomain(*input.Frequency)
+	}
+	if input.AccelerationTrigger != nil {
+		vs.AccelerationTrigger = convert.GQLAccelToDomain(*input.AccelerationTrigger)
+	}
+	if input.AccelerationPercent != nil {
+		vs.AccelerationPercent = convert.GQLDecToDecPtr(input.AccelerationPercent)
+	}
+	if input.AccelerationMonths != nil {
+		vs.AccelerationMonths = input.AccelerationMonths
+	}
+	if input.AccelerationWindowMonths != nil {
+		vs.AccelerationWindowMonths = *input.AccelerationWindowMonths
+	}
+	if input.Rounding != nil {
+		vs.Rounding = convert.GQLRoundingToDomain(*input.Rounding)
+	}
+
+	if err := vestingengine.ValidateSchedule(vs); err != nil {
+		return nil, err
+	}
+	if len(vs.Tranches) > 0 {
+		vs.CliffMonths = vs.Tranches[0].OffsetMonths
+		vs.TotalMonths = vs.Tranches[len(vs.Tranches)-1].OffsetMonths
+	}
+	return &vs, nil
+}
+
+// hasScheduleTerms reports whether any schedule term is set on input.
+func hasScheduleTerms(input model.CreateVestingScheduleInput) bool {
+	return input.CliffMonths != nil || input.TotalMonths != nil || input.Frequency != nil ||
+		input.AccelerationTrigger != nil || input.AccelerationPercent != nil || input.AccelerationMonths != nil ||
+		input.AccelerationWindowMonths != nil || input.Tranches != nil || input.Rounding != nil
+}
+
 // collectGrantIDs returns deduplicated stakeholder and share-class IDs from a
 // slice of grants, suitable for batch-fetching.
 func collectGrantIDs(grants []domain.Grant) (stakeholderIDs, shareClassIDs []string) {
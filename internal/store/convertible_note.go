@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+type ConvertibleNoteStore struct {
+	db *sql.DB
+}
+
+func NewConvertibleNoteStore(db *sql.DB) *ConvertibleNoteStore {
+	return &ConvertibleNoteStore{db: db}
+}
+
+// convertibleNoteColumns is the select list scanned by scanConvertibleNote.
+const convertibleNoteColumns = `id, company_id, stakeholder_id, principal, interest_rate, interest_type, day_count,
+	issue_date, maturity_date, valuation_cap, discount_rate, qualified_financing_amount, maturity_valuation_cap,
+	settlement_date, settlement_method, settlement_interest, settlement_shares, settlement_price, repayment_amount,
+	settled_in_round, settlement_grant_id,
+	created_at, updated_at, deleted_at`
+
+func scanConvertibleNote(row rowScanner, n *domain.ConvertibleNote) error {
+	var valCap, discRate, qualified, maturityCap sql.NullString
+	var method, interest, shares, price, repayment sql.NullString
+	var settlementDate sql.NullTime
+	err := row.Scan(&n.ID, &n.CompanyID, &n.StakeholderID, &n.Principal, &n.InterestRate, &n.InterestType, &n.DayCount,
+		&n.IssueDate, &n.MaturityDate, &valCap, &discRate, &qualified, &maturityCap,
+		&settlementDate, &method, &interest, &shares, &price, &repayment,
+		&n.SettledInRound, &n.SettlementGrantID,
+		&n.CreatedAt, &n.UpdatedAt, &n.DeletedAt)
+	if err != nil {
+		return err
+	}
+	n.ValuationCap = nullStringToDecimalPtr(valCap)
+	n.DiscountRate = nullStringToDecimalPtr(discRate)
+	n.QualifiedFinancingAmount = nullStringToDecimalPtr(qualified)
+	n.MaturityValuationCap = nullStringToDecimalPtr(maturityCap)
+	if settlementDate.Valid {
+		n.Settlement = &domain.NoteSettlement{
+			NoteID:          n.ID,
+			Date:            settlementDate.Time,
+			Principal:       n.Principal,
+			AccruedInterest: *nullStringToDecimalPtr(interest),
+			SharesIssued:    *nullStringToDecimalPtr(shares),
+			EffectivePPS:    *nullStringToDecimalPtr(price),
+			RepaymentAmount: *nullStringToDecimalPtr(repayment),
+			Method:          method.String,
+		}
+	}
+	return nil
+}
+
+func (s *ConvertibleNoteStore) Create(ctx context.Context, n *domain.ConvertibleNote) error {
+	if n.InterestType == "" {
+		n.InterestType = domain.InterestSimple
+	}
+	if n.DayCount == "" {
+		n.DayCount = domain.DayCountActual365
+	}
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO convertible_notes
+		 (company_id, stakeholder_id, principal, interest_rate, interest_type, day_count, issue_date, maturity_date,
+		  valuation_cap, discount_rate, qualified_financing_amount, maturity_valuation_cap)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		 RETURNING id, created_at, updated_at`,
+		n.CompanyID, n.StakeholderID, n.Principal, n.InterestRate, n.InterestType, n.DayCount, n.IssueDate, n.MaturityDate,
+		decimalPtrToNullString(n.ValuationCap), decimalPtrToNullString(n.DiscountRate),
+		decimalPtrToNullString(n.QualifiedFinancingAmount), decimalPtrToNullString(n.MaturityValuationCap),
+	).Scan(&n.ID, &n.CreatedAt, &n.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("creating convertible note: %w", err)
+	}
+	return nil
+}
+
+func (s *ConvertibleNoteStore) GetByID(ctx context.Context, id string) (*domain.ConvertibleNote, error) {
+	n := &domain.ConvertibleNote{}
+	err := scanConvertibleNote(s.db.QueryRowContext(ctx,
+		`SELECT `+convertibleNoteColumns+`
+		 FROM convertible_notes WHERE id = $1 AND deleted_at IS NULL`, id,
+	), n)
+	if err == sql.ErrNoRows {
+		return nil, &domain.ErrNotFound{Entity: "convertible_note", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting convertible note: %w", err)
+	}
+	return n, nil
+}
+
+func (s *ConvertibleNoteStore) ListByCompany(ctx context.Context, companyID string) ([]domain.ConvertibleNote, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+convertibleNoteColumns+`
+		 FROM convertible_notes WHERE company_id = $1 AND deleted_at IS NULL
+		 ORDER BY issue_date`, companyID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing convertible notes: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.ConvertibleNote
+	for rows.Next() {
+		var n domain.ConvertibleNote
+		if err := scanConvertibleNote(rows, &n); err != nil {
+			return nil, fmt.Errorf("scanning convertible note: %w", err)
+		}
+		result = append(result, n)
+	}
+	return result, rows.Err()
+}
+
+// Settle records the note's settlement, in one transaction: shares it
+// converts into are issued as a stock grant in shareClassID, and the note is
+// marked settled. n.Settlement must be set; shareClassID is ignored for a
+// repayment.
+func (s *ConvertibleNoteStore) Settle(ctx context.Context, n *domain.ConvertibleNote, shareClassID string) error {
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		var grantID *string
+		if n.Settlement.SharesIssued.IsPositive() {
+			issuance := n.Issuance(shareClassID)
+			if err := insertGrant(ctx, tx, &issuance); err != nil {
+				return err
+			}
+			grantID = &issuance.ID
+		}
+		st := n.Settlement
+		err := tx.QueryRowContext(ctx,
+			`UPDATE convertible_notes
+			 SET settlement_date = $2, settlement_method = $3, settlement_interest = $4, settlement_shares = $5,
+			     settlement_price = $6, repayment_amount = $7, settled_in_round = $8, settlement_grant_id = $9
+			 WHERE id = $1 AND settlement_date IS NULL AND deleted_at IS NULL
+			 RETURNING updated_at`,
+			n.ID, st.Date, st.Method, st.AccruedInterest, st.SharesIssued,
+			st.EffectivePPS, st.RepaymentAmount, n.SettledInRound, grantID,
+		).Scan(&n.UpdatedAt)
+		if err == sql.ErrNoRows {
+			return &domain.ErrConflict{Message: fmt.Sprintf("convertible note %s is already settled", n.ID)}
+		}
+		if err != nil {
+			return fmt.Errorf("settling convertible note: %w", err)
+		}
+		n.SettlementGrantID = grantID
+		return nil
+	})
+}
@@ -19,10 +19,12 @@ func NewFundingRoundStore(db *sql.DB) *FundingRoundStore {
 func (s *FundingRoundStore) Create(ctx context.Context, fr *domain.FundingRound) error {
 	err := s.db.QueryRowContext(ctx,
 		`INSERT INTO funding_rounds
-		 (company_id, name, pre_money_valuation, amount_raised, price_per_share, share_class_id, round_date)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 (company_id, name, pre_money_valuation, amount_raised, price_per_share, share_class_id, round_date,
+		  option_pool_increase, promised_options)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		 RETURNING id, created_at, updated_at`,
 		fr.CompanyID, fr.Name, fr.PreMoneyVal, fr.AmountRaised, fr.PricePerShare, fr.ShareClassID, fr.RoundDate,
+		fr.OptionPoolIncrease, fr.PromisedOptions,
 	).Scan(&fr.ID, &fr.CreatedAt, &fr.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("creating funding round: %w", err)
@@ -34,10 +36,10 @@ func (s *FundingRoundStore) GetByID(ctx context.Context, id string) (*domain.Fun
 	fr := &domain.FundingRound{}
 	err := s.db.QueryRowContext(ctx,
 		`SELECT id, company_id, name, pre_money_valuation, amount_raised, price_per_share,
-		        share_class_id, round_date, created_at, updated_at, deleted_at
+		        share_class_id, round_date, option_pool_increase, promised_options, created_at, updated_at, deleted_at
 		 FROM funding_rounds WHERE id = $1 AND deleted_at IS NULL`, id,
 	).Scan(&fr.ID, &fr.CompanyID, &fr.Name, &fr.PreMoneyVal, &fr.AmountRaised, &fr.PricePerShare,
-		&fr.ShareClassID, &fr.RoundDate, &fr.CreatedAt, &fr.UpdatedAt, &fr.DeletedAt)
+		&fr.ShareClassID, &fr.RoundDate, &fr.OptionPoolIncrease, &fr.PromisedOptions, &fr.CreatedAt, &fr.UpdatedAt, &fr.DeletedAt)
 	if err == sql.ErrNoRows {
 		return nil, &domain.ErrNotFound{Entity: "funding_round", ID: id}
 	}
@@ -50,7 +52,7 @@ func (s *FundingRoundStore) GetByID(ctx context.Context, id string) (*domain.Fun
 func (s *FundingRoundStore) ListByCompany(ctx context.Context, companyID string) ([]domain.FundingRound, error) {
 	rows, err := s.db.QueryContext(ctx,
 		`SELECT id, company_id, name, pre_money_valuation, amount_raised, price_per_share,
-		        share_class_id, round_date, created_at, updated_at, deleted_at
+		        share_class_id, round_date, option_pool_increase, promised_options, created_at, updated_at, deleted_at
 		 FROM funding_rounds WHERE company_id = $1 AND deleted_at IS NULL
 		 ORDER BY round_date`, companyID,
 	)
@@ -63,7 +65,8 @@ func (s *FundingRoundStore) ListByCompany(ctx context.Context, companyID string)
 	for rows.Next() {
 		var fr domain.FundingRound
 		if err := rows.Scan(&fr.ID, &fr.CompanyID, &fr.Name, &fr.PreMoneyVal, &fr.AmountRaised,
-			&fr.PricePerShare, &fr.ShareClassID, &fr.RoundDate, &fr.CreatedAt, &fr.UpdatedAt, &fr.DeletedAt); err != nil {
+			&fr.PricePerShare, &fr.ShareClassID, &fr.RoundDate, &fr.OptionPoolIncrease, &fr.PromisedOptions,
+			&fr.CreatedAt, &fr.UpdatedAt, &fr.DeletedAt); err != nil {
 			return nil, fmt.Errorf("scanning funding round: %w", err)
 		}
 		result = append(result, fr)
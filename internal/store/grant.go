@@ -16,88 +16,168 @@ func NewGrantStore(db *sql.DB) *GrantStore {
 	return &GrantStore{db: db}
 }
 
+// grantExpiredQuantity computes the options of grant g that expired: those
+// still unexercised once its exercise deadline has passed. Expired options
+// stop counting as outstanding and flow back into the grant's pool.
+const grantExpiredQuantity = `CASE WHEN g.grant_type = 'option' AND g.exercise_deadline < CURRENT_DATE
+	THEN GREATEST(g.quantity - g.forfeited_quantity
+	              - COALESCE((SELECT SUM(e.quantity) FROM grant_exercises e WHERE e.grant_id = g.id), 0), 0)
+	ELSE 0 END`
+
+// grantColumns is the select list scanned by scanGrant.
+const grantColumns = `g.id, g.company_id, g.stakeholder_id, g.share_class_id, g.vesting_schedule_id, g.option_pool_id,
+	g.grant_type, g.quantity, g.grant_date, g.vesting_commencement_date, g.exercise_price, g.is_exercised, g.early_exercise_allowed, g.notes,
+	g.termination_date, g.forfeited_quantity, g.exercise_deadline, ` + grantExpiredQuantity + `, g.repurchase_price,
+	g.repurchased_quantity, g.repurchase_date,
+	g.created_at, g.updated_at, g.deleted_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanGrant(row rowScanner, g *domain.Grant) error {
+	var repurchasePrice sql.NullString
+	err := row.Scan(&g.ID, &g.CompanyID, &g.StakeholderID, &g.ShareClassID, &g.VestingScheduleID, &g.OptionPoolID,
+		&g.Type, &g.Quantity, &g.GrantDate, &g.VestingCommencementDate, &g.ExercisePrice, &g.IsExercised, &g.EarlyExerciseAllowed, &g.Notes,
+		&g.TerminationDate, &g.ForfeitedQuantity, &g.ExerciseDeadline, &g.ExpiredQuantity, &repurchasePrice,
+		&g.RepurchasedQuantity, &g.RepurchaseDate,
+		&g.CreatedAt, &g.UpdatedAt, &g.DeletedAt)
+	if err != nil {
+		return err
+	}
+	g.RepurchasePrice = nullStringToDecimalPtr(repurchasePrice)
+	return nil
+}
+
+// Create inserts g and its milestones. A grant from an option pool locks the
+// pool row and recomputes its granted shares first, so concurrent grants
+// cannot together overdraw the pool.
 func (s *GrantStore) Create(ctx context.Context, g *domain.Grant) error {
-	err := s.db.QueryRowContext(ctx,
+	if g.Type == "" {
+		g.Type = domain.GrantTypeOption
+	}
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		if g.OptionPoolID != nil {
+			pool, err := lockOptionPool(ctx, tx, *g.OptionPoolID)
+			if err != nil {
+				return err
+			}
+			if g.Quantity.GreaterThan(pool.AvailableShares()) {
+				return &domain.ErrConflict{Message: fmt.Sprintf("option pool %s has only %s shares available", pool.ID, pool.AvailableShares())}
+			}
+		}
+		return insertGrant(ctx, tx, g)
+	})
+}
+
+// insertGrant inserts a grant and its milestones within tx.
+func insertGrant(ctx context.Context, tx *sql.Tx, g *domain.Grant) error {
+	err := tx.QueryRowContext(ctx,
 		`INSERT INTO grants
-		 (company_id, stakeholder_id, share_class_id, vesting_schedule_id, quantity, grant_date, exercise_price, notes)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 (company_id, stakeholder_id, share_class_id, vesting_schedule_id, option_pool_id, grant_type, quantity, grant_date,
+		  vesting_commencement_date, exercise_price, early_exercise_allowed, notes)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		 RETURNING id, created_at, updated_at`,
-		g.CompanyID, g.StakeholderID, g.ShareClassID, g.VestingScheduleID,
-		g.Quantity, g.GrantDate, g.ExercisePrice, g.Notes,
+		g.CompanyID, g.StakeholderID, g.ShareClassID, g.VestingScheduleID, g.OptionPoolID, g.Type,
+		g.Quantity, g.GrantDate, g.VestingCommencementDate, g.ExercisePrice, g.EarlyExerciseAllowed, g.Notes,
 	).Scan(&g.ID, &g.CreatedAt, &g.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("creating grant: %w", err)
 	}
-	return nil
+	return insertMilestones(ctx, tx, g.ID, g.Milestones)
 }
 
 func (s *GrantStore) GetByID(ctx context.Context, id string) (*domain.Grant, error) {
 	g := &domain.Grant{}
-	err := s.db.QueryRowContext(ctx,
-		`SELECT g.id, g.company_id, g.stakeholder_id, g.share_class_id, g.vesting_schedule_id,
-		        g.quantity, g.grant_date, g.exercise_price, g.is_exercised, g.notes,
-		        g.created_at, g.updated_at, g.deleted_at
+	err := scanGrant(s.db.QueryRowContext(ctx,
+		`SELECT `+grantColumns+`
 		 FROM grants g WHERE g.id = $1 AND g.deleted_at IS NULL`, id,
-	).Scan(&g.ID, &g.CompanyID, &g.StakeholderID, &g.ShareClassID, &g.VestingScheduleID,
-		&g.Quantity, &g.GrantDate, &g.ExercisePrice, &g.IsExercised, &g.Notes,
-		&g.CreatedAt, &g.UpdatedAt, &g.DeletedAt)
+	), g)
 	if err == sql.ErrNoRows {
 		return nil, &domain.ErrNotFound{Entity: "grant", ID: id}
 	}
 	if err != nil {
 		return nil, fmt.Errorf("getting grant: %w", err)
 	}
-	return g, nil
+	grants := []domain.Grant{*g}
+	if err := s.attach(ctx, grants); err != nil {
+		return nil, err
+	}
+	return &grants[0], nil
 }
 
 func (s *GrantStore) ListByCompany(ctx context.Context, companyID string) ([]domain.Grant, error) {
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT g.id, g.company_id, g.stakeholder_id, g.share_class_id, g.vesting_schedule_id,
-		        g.quantity, g.grant_date, g.exercise_price, g.is_exercised, g.notes,
-		        g.created_at, g.updated_at, g.deleted_at
+		`SELECT `+grantColumns+`
 		 FROM grants g WHERE g.company_id = $1 AND g.deleted_at IS NULL
 		 ORDER BY g.grant_date`, companyID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("listing grants: %w", err)
 	}
-	defer rows.Close()
-
-	var result []domain.Grant
-	for rows.Next() {
-		var g domain.Grant
-		if err := rows.Scan(&g.ID, &g.CompanyID, &g.StakeholderID, &g.ShareClassID, &g.VestingScheduleID,
-			&g.Quantity, &g.GrantDate, &g.ExercisePrice, &g.IsExercised, &g.Notes,
-			&g.CreatedAt, &g.UpdatedAt, &g.DeletedAt); err != nil {
-			return nil, fmt.Errorf("scanning grant: %w", err)
-		}
-		result = append(result, g)
-	}
-	return result, rows.Err()
+	return s.collect(ctx, rows)
 }
 
 func (s *GrantStore) ListByStakeholder(ctx context.Context, stakeholderID string) ([]domain.Grant, error) {
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT g.id, g.company_id, g.stakeholder_id, g.share_class_id, g.vesting_schedule_id,
-		        g.quantity, g.grant_date, g.exercise_price, g.is_exercised, g.notes,
-		        g.created_at, g.updated_at, g.deleted_at
+		`SELECT `+grantColumns+`
 		 FROM grants g WHERE g.stakeholder_id = $1 AND g.deleted_at IS NULL
 		 ORDER BY g.grant_date`, stakeholderID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("listing grants by stakeholder: %w", err)
 	}
+	return s.collect(ctx, rows)
+}
+
+// Repurchase records the company's buy-back of a grant's unvested shares
+// into treasury. RepurchasedQuantity, RepurchaseDate and RepurchasePrice must
+// already be set.
+func (s *GrantStore) Repurchase(ctx context.Context, g *domain.Grant) error {
+	err := s.db.QueryRowContext(ctx,
+		`UPDATE grants SET repurchased_quantity = $2, repurchase_date = $3, repurchase_price = $4
+		 WHERE id = $1 AND repurchase_date IS NULL AND deleted_at IS NULL
+		 RETURNING updated_at`,
+		g.ID, g.RepurchasedQuantity, g.RepurchaseDate, decimalPtrToNullString(g.RepurchasePrice),
+	).Scan(&g.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return &domain.ErrConflict{Message: fmt.Sprintf("grant %s has already been repurchased", g.ID)}
+	}
+	if err != nil {
+		return fmt.Errorf("repurchasing grant: %w", err)
+	}
+	return nil
+}
+
+// collect scans grant rows and attaches their milestones, leaves and exercises.
+func (s *GrantStore) collect(ctx context.Context, rows *sql.Rows) ([]domain.Grant, error) {
 	defer rows.Close()
 
 	var result []domain.Grant
 	for rows.Next() {
 		var g domain.Grant
-		if err := rows.Scan(&g.ID, &g.CompanyID, &g.StakeholderID, &g.ShareClassID, &g.VestingScheduleID,
-			&g.Quantity, &g.GrantDate, &g.ExercisePrice, &g.IsExercised, &g.Notes,
-			&g.CreatedAt, &g.UpdatedAt, &g.DeletedAt); err != nil {
+		if err := scanGrant(rows, &g); err != nil {
 			return nil, fmt.Errorf("scanning grant: %w", err)
 		}
 		result = append(result, g)
 	}
-	return result, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := s.attach(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// attach loads the milestones, leaves and exercises that vesting
+// calculations need.
+func (s *GrantStore) attach(ctx context.Context, grants []domain.Grant) error {
+	if err := attachMilestones(ctx, s.db, grants); err != nil {
+		return err
+	}
+	if err := attachLeaves(ctx, s.db, grants); err != nil {
+		return err
+	}
+	return attachExercises(ctx, s.db, grants)
 }
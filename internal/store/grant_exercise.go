@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/lib/pq"
+)
+
+type GrantExerciseStore struct {
+	db *sql.DB
+}
+
+func NewGrantExerciseStore(db *sql.DB) *GrantExerciseStore {
+	return &GrantExerciseStore{db: db}
+}
+
+// Create records an exercise of g's options and updates g.IsExercised in one
+// transaction. The grant row is locked and its exercises reloaded first, and
+// validate checks the exercise against that current grant, so concurrent
+// exercises cannot together exceed what is exercisable. g's vesting
+// schedule, milestones and leaves are carried over for validate; on success
+// g holds the current grant with the new exercise.
+func (s *GrantExerciseStore) Create(ctx context.Context, g *domain.Grant, e *domain.GrantExercise, validate func(domain.Grant) error) error {
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		locked := domain.Grant{}
+		err := scanGrant(tx.QueryRowContext(ctx,
+			`SELECT `+grantColumns+`
+			 FROM grants g WHERE g.id = $1 AND g.deleted_at IS NULL
+			 FOR UPDATE`, g.ID,
+		), &locked)
+		if err == sql.ErrNoRows {
+			return &domain.ErrNotFound{Entity: "grant", ID: g.ID}
+		}
+		if err != nil {
+			return fmt.Errorf("locking grant %s: %w", g.ID, err)
+		}
+		grants := []domain.Grant{locked}
+		if err := attachExercises(ctx, tx, grants); err != nil {
+			return err
+		}
+		locked = grants[0]
+		locked.VestingSchedule, locked.Milestones, locked.Leaves = g.VestingSchedule, g.Milestones, g.Leaves
+
+		if err := validate(locked); err != nil {
+			return err
+		}
+		locked.IsExercised = locked.TotalExercised().Add(e.Quantity).GreaterThanOrEqual(locked.OutstandingQuantity())
+
+		e.GrantID = g.ID
+		err = tx.QueryRowContext(ctx,
+			`INSERT INTO grant_exercises (grant_id, quantity, exercise_date)
+			 VALUES ($1, $2, $3)
+			 RETURNING id, created_at`,
+			e.GrantID, e.Quantity, e.ExerciseDate,
+		).Scan(&e.ID, &e.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("creating grant exercise: %w", err)
+		}
+
+		err = tx.QueryRowContext(ctx,
+			`UPDATE grants SET is_exercised = $2
+			 WHERE id = $1
+			 RETURNING updated_at`, g.ID, locked.IsExercised,
+		).Scan(&locked.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("updating grant %s: %w", g.ID, err)
+		}
+		locked.Exercises = append(locked.Exercises, *e)
+		*g = locked
+		return nil
+	})
+}
+
+// attachExercises batch-loads exercises for the given grants in one query.
+func attachExercises(ctx context.Context, db querier, grants []domain.Grant) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	ids := make([]string, len(grants))
+	for i, g := range grants {
+		ids[i] = g.ID
+	}
+
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, grant_id, quantity, exercise_date, created_at
+		 FROM grant_exercises WHERE grant_id = ANY($1)
+		 ORDER BY exercise_date, created_at`, pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("listing grant exercises: %w", err)
+	}
+	defer rows.Close()
+
+	byGrant := map[string][]domain.GrantExercise{}
+	for rows.Next() {
+		var e domain.GrantExercise
+		if err := rows.Scan(&e.ID, &e.GrantID, &e.Quantity, &e.ExerciseDate, &e.CreatedAt); err != nil {
+			return fmt.Errorf("scanning grant exercise: %w", err)
+		}
+		byGrant[e.GrantID] = append(byGrant[e.GrantID], e)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range grants {
+		grants[i].Exercises = byGrant[grants[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/lib/pq"
+)
+
+type GrantMilestoneStore struct {
+	db *sql.DB
+}
+
+func NewGrantMilestoneStore(db *sql.DB) *GrantMilestoneStore {
+	return &GrantMilestoneStore{db: db}
+}
+
+func (s *GrantMilestoneStore) GetByID(ctx context.Context, id string) (*domain.GrantMilestone, error) {
+	m := &domain.GrantMilestone{}
+	err := s.db.QueryRowContext(ctx,
+		`SELECT id, grant_id, name, shares, condition, achieved_date, created_at, updated_at
+		 FROM grant_milestones WHERE id = $1`, id,
+	).Scan(&m.ID, &m.GrantID, &m.Name, &m.Shares, &m.Condition, &m.AchievedDate, &m.CreatedAt, &m.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, &domain.ErrNotFound{Entity: "grant_milestone", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting grant milestone: %w", err)
+	}
+	return m, nil
+}
+
+// MarkAchieved stamps the achievement date on a milestone that has not yet
+// been achieved.
+func (s *GrantMilestoneStore) MarkAchieved(ctx context.Context, m *domain.GrantMilestone) error {
+	err := s.db.QueryRowContext(ctx,
+		`UPDATE grant_milestones SET achieved_date = $2
+		 WHERE id = $1 AND achieved_date IS NULL
+		 RETURNING updated_at`, m.ID, m.AchievedDate,
+	).Scan(&m.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return &domain.ErrConflict{Message: fmt.Sprintf("milestone %s is already achieved", m.ID)}
+	}
+	if err != nil {
+		return fmt.Errorf("marking milestone achieved: %w", err)
+	}
+	return nil
+}
+
+func insertMilestones(ctx context.Context, tx *sql.Tx, grantID string, milestones []domain.GrantMilestone) error {
+	for i := range milestones {
+		m := &milestones[i]
+		m.GrantID = grantID
+		err := tx.QueryRowContext(ctx,
+			`INSERT INTO grant_milestones (grant_id, name, shares, condition, achieved_date)
+			 VALUES ($1, $2, $3, $4, $5)
+			 RETURNING id, created_at, updated_at`,
+			m.GrantID, m.Name, m.Shares, m.Condition, m.AchievedDate,
+		).Scan(&m.ID, &m.CreatedAt, &m.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("creating grant milestone: %w", err)
+		}
+	}
+	return nil
+}
+
+// attachMilestones batch-loads milestones for the given grants in one query.
+func attachMilestones(ctx context.Context, db *sql.DB, grants []domain.Grant) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	ids := make([]string, len(grants))
+	for i, g := range grants {
+		ids[i] = g.ID
+	}
+
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, grant_id, name, shares, condition, achieved_date, created_at, updated_at
+		 FROM grant_milestones WHERE grant_id = ANY($1)
+		 ORDER BY created_at`, pq.Array(ids),
+	)
+	if err != nil {
+		return fmt.Errorf("listing grant milestones: %w", err)
+	}
+	defer rows.Close()
+
+	byGrant := map[string][]domain.GrantMilestone{}
+	for rows.Next() {
+		var m domain.GrantMilestone
+		if err := rows.Scan(&m.ID, &m.GrantID, &m.Name, &m.Shares, &m.Condition, &m.AchievedDate,
+			&m.CreatedAt, &m.UpdatedAt); err != nil {
+			return fmt.Errorf("scanning grant milestone: %w", err)
+		}
+		byGrant[m.GrantID] = append(byGrant[m.GrantID], m)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range grants {
+		grants[i].Milestones = byGrant[grants[i].ID]
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package store_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -178,42 +179,955 @@ func TestGrantStore_CreateAndList(t *testing.T) {
 	}
 
 	vs := &domain.VestingSchedule{
+		CompanyID:           company.ID,
+		Name:                "Standard 4y/1y",
 		CliffMonths:         12,
 		TotalMonths:         48,
 		Frequency:           domain.FrequencyMonthly,
 		AccelerationTrigger: domain.AccelerationNone,
 	}
-	vss := store.NewVestingScheduleStore(db)
-	if err := vss.Create(ctx, vs); err != nil {
+	vss := store.NewVestingScheduleStore(db)
+	if err := vss.Create(ctx, vs); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := store.NewGrantStore(db)
+	g := &domain.Grant{
+		CompanyID:               company.ID,
+		StakeholderID:           sh.ID,
+		ShareClassID:            sc.ID,
+		VestingScheduleID:       &vs.ID,
+		Quantity:                decimal.NewFromInt(48000),
+		GrantDate:               time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+		VestingCommencementDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExercisePrice:           decimal.NewFromFloat(0.10),
+	}
+	if err := gs.Create(ctx, g); err != nil {
+		t.Fatal(err)
+	}
+	if g.ID == "" {
+		t.Fatal("expected grant ID to be set")
+	}
+
+	grants, err := gs.ListByCompany(ctx, company.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(grants) != 1 {
+		t.Fatalf("expected 1 grant, got %d", len(grants))
+	}
+	if !grants[0].Quantity.Equal(decimal.NewFromInt(48000)) {
+		t.Errorf("Quantity = %s, want 48000", grants[0].Quantity)
+	}
+	if !grants[0].VestingCommencementDate.Equal(g.VestingCommencementDate) {
+		t.Errorf("VestingCommencementDate = %v, want %v", grants[0].VestingCommencementDate, g.VestingCommencementDate)
+	}
+}
+
+func TestVestingScheduleStore_Tranches(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	pct := func(v string) *decimal.Decimal {
+		d, _ := decimal.NewFromString(v)
+		return &d
+	}
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "TrancheCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	vss := store.NewVestingScheduleStore(db)
+	vs := &domain.VestingSchedule{
+		CompanyID:           company.ID,
+		Name:                "Back-weighted 10/20/30/40",
+		CliffMonths:         12,
+		TotalMonths:         48,
+		Frequency:           domain.FrequencyMonthly,
+		AccelerationTrigger: domain.AccelerationDoubleTrigger,
+		AccelerationPercent: pct("50"),
+		Rounding:            domain.RoundingPerPeriodFloor,
+		Tranches: []domain.VestingTranche{
+			{OffsetMonths: 12, Percent: pct("10")},
+			{OffsetMonths: 24, Percent: pct("20")},
+			{OffsetMonths: 36, Percent: pct("30")},
+			{OffsetMonths: 48, Percent: pct("40")},
+		},
+	}
+	if err := vss.Create(ctx, vs); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	got, err := vss.GetByID(ctx, vs.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.AccelerationPercent == nil || !got.AccelerationPercent.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("AccelerationPercent = %v, want 50", got.AccelerationPercent)
+	}
+	if got.Rounding != domain.RoundingPerPeriodFloor {
+		t.Errorf("Rounding = %q, want %q", got.Rounding, domain.RoundingPerPeriodFloor)
+	}
+	if len(got.Tranches) != 4 {
+		t.Fatalf("expected 4 tranches, got %d", len(got.Tranches))
+	}
+	if got.Tranches[3].OffsetMonths != 48 || !got.Tranches[3].Percent.Equal(decimal.NewFromInt(40)) {
+		t.Errorf("last tranche = %d months / %v%%, want 48 months / 40%%", got.Tranches[3].OffsetMonths, got.Tranches[3].Percent)
+	}
+
+	byID, err := vss.GetByIDs(ctx, []string{vs.ID})
+	if err != nil {
+		t.Fatalf("GetByIDs: %v", err)
+	}
+	if batched := byID[vs.ID]; batched == nil || len(batched.Tranches) != 4 {
+		t.Errorf("GetByIDs did not return the schedule with its 4 tranches: %+v", batched)
+	}
+}
+
+func TestVestingScheduleStore_ListUpdateArchive(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "TemplateCo"}
+	other := &domain.Company{Name: "OtherCo"}
+	for _, c := range []*domain.Company{company, other} {
+		if err := cs.Create(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	vss := store.NewVestingScheduleStore(db)
+	standard := &domain.VestingSchedule{CompanyID: company.ID, Name: "Standard 4y/1y", CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly}
+	advisor := &domain.VestingSchedule{CompanyID: company.ID, Name: "Advisor 2y monthly", TotalMonths: 24, Frequency: domain.FrequencyMonthly}
+	elsewhere := &domain.VestingSchedule{CompanyID: other.ID, Name: "Standard 4y/1y", CliffMonths: 12, TotalMonths: 48, Frequency: domain.FrequencyMonthly}
+	for _, vs := range []*domain.VestingSchedule{standard, advisor, elsewhere} {
+		if err := vss.Create(ctx, vs); err != nil {
+			t.Fatalf("Create %s: %v", vs.Name, err)
+		}
+	}
+
+	duplicate := &domain.VestingSchedule{CompanyID: company.ID, Name: "Standard 4y/1y", TotalMonths: 36, Frequency: domain.FrequencyMonthly}
+	if err := vss.Create(ctx, duplicate); err == nil {
+		t.Error("expected unique constraint violation for duplicate schedule name")
+	}
+
+	list, err := vss.ListByCompany(ctx, company.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Name != "Advisor 2y monthly" || list[1].Name != "Standard 4y/1y" {
+		t.Fatalf("ListByCompany = %+v, want the two TemplateCo schedules by name", list)
+	}
+
+	advisor.Name = "Advisor 2y quarterly"
+	advisor.Frequency = domain.FrequencyQuarterly
+	if err := vss.Update(ctx, advisor); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	got, err := vss.GetByID(ctx, advisor.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Advisor 2y quarterly" || got.Frequency != domain.FrequencyQuarterly {
+		t.Errorf("after Update: name %q, frequency %s", got.Name, got.Frequency)
+	}
+
+	if err := vss.Archive(ctx, advisor); err != nil {
+		t.Fatalf("Archive: %v", err)
+	}
+	if advisor.ArchivedAt == nil {
+		t.Error("ArchivedAt not set")
+	}
+	var conflict *domain.ErrConflict
+	if err := vss.Archive(ctx, advisor); !errors.As(err, &conflict) {
+		t.Errorf("second Archive: expected ErrConflict, got %v", err)
+	}
+	if err := vss.Update(ctx, advisor); !errors.As(err, &conflict) {
+		t.Errorf("Update after Archive: expected ErrConflict, got %v", err)
+	}
+
+	list, err = vss.ListByCompany(ctx, company.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ID != standard.ID {
+		t.Errorf("ListByCompany after archive = %+v, want only the standard schedule", list)
+	}
+
+	inUse, err := vss.InUse(ctx, standard.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inUse {
+		t.Error("InUse = true for a schedule with no grants")
+	}
+}
+
+func TestGrantMilestoneStore_MarkAchieved(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "MilestoneCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Common",
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(10000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	sh := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Carol",
+		Email:     "carol@milestoneco.com",
+		Role:      domain.RoleEmployee,
+	}
+	if err := ss.Create(ctx, sh); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := store.NewGrantStore(db)
+	g := &domain.Grant{
+		CompanyID:               company.ID,
+		StakeholderID:           sh.ID,
+		ShareClassID:            sc.ID,
+		Quantity:                decimal.NewFromInt(10000),
+		GrantDate:               time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		VestingCommencementDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExercisePrice:           decimal.NewFromFloat(0.10),
+		Milestones: []domain.GrantMilestone{
+			{Name: "FDA approval", Shares: decimal.NewFromInt(10000), Condition: domain.MilestoneOnly},
+		},
+	}
+	if err := gs.Create(ctx, g); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := gs.GetByID(ctx, g.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Milestones) != 1 {
+		t.Fatalf("expected 1 milestone, got %d", len(got.Milestones))
+	}
+
+	ms := store.NewGrantMilestoneStore(db)
+	m := &got.Milestones[0]
+	achieved := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	m.AchievedDate = &achieved
+	if err := ms.MarkAchieved(ctx, m); err != nil {
+		t.Fatalf("MarkAchieved: %v", err)
+	}
+
+	err = ms.MarkAchieved(ctx, m)
+	var conflict *domain.ErrConflict
+	if !errors.As(err, &conflict) {
+		t.Errorf("second MarkAchieved err = %v, want ErrConflict", err)
+	}
+
+	reloaded, err := ms.GetByID(ctx, m.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.AchievedDate == nil || !reloaded.AchievedDate.Equal(achieved) {
+		t.Errorf("AchievedDate = %v, want %v", reloaded.AchievedDate, achieved)
+	}
+}
+
+func TestStakeholderStore_Terminate(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "LeaverCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Common",
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(10000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ps := store.NewOptionPoolStore(db)
+	pool := &domain.OptionPool{
+		CompanyID:      company.ID,
+		ShareClassID:   sc.ID,
+		Name:           "2024 Plan",
+		ReservedShares: decimal.NewFromInt(100000),
+	}
+	if err := ps.Create(ctx, pool); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	sh := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Dana",
+		Email:     "dana@leaverco.com",
+		Role:      domain.RoleEmployee,
+	}
+	if err := ss.Create(ctx, sh); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := store.NewGrantStore(db)
+	g := &domain.Grant{
+		CompanyID:               company.ID,
+		StakeholderID:           sh.ID,
+		ShareClassID:            sc.ID,
+		OptionPoolID:            &pool.ID,
+		Quantity:                decimal.NewFromInt(48000),
+		GrantDate:               time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		VestingCommencementDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExercisePrice:           decimal.NewFromFloat(0.10),
+	}
+	if err := gs.Create(ctx, g); err != nil {
+		t.Fatal(err)
+	}
+
+	// Terminated today, so the vested options are still exercisable.
+	termination := time.Now().UTC().Truncate(24 * time.Hour)
+	deadline := termination.AddDate(0, 0, 90)
+	reason := domain.TerminationVoluntary
+	sh.TerminationDate = &termination
+	sh.TerminationReason = &reason
+	g.TerminationDate = &termination
+	g.ForfeitedQuantity = decimal.NewFromInt(24000)
+	g.ExerciseDeadline = &deadline
+	if err := ss.Terminate(ctx, sh, []domain.Grant{*g}); err != nil {
+		t.Fatalf("Terminate: %v", err)
+	}
+
+	var conflict *domain.ErrConflict
+	if err := ss.Terminate(ctx, sh, nil); !errors.As(err, &conflict) {
+		t.Errorf("second Terminate err = %v, want ErrConflict", err)
+	}
+
+	got, err := gs.GetByID(ctx, g.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.OutstandingQuantity().Equal(decimal.NewFromInt(24000)) {
+		t.Errorf("OutstandingQuantity = %s, want 24000", got.OutstandingQuantity())
+	}
+	if got.ExerciseDeadline == nil || !got.ExerciseDeadline.Equal(deadline) {
+		t.Errorf("ExerciseDeadline = %v, want %v", got.ExerciseDeadline, deadline)
+	}
+
+	reloaded, err := ps.GetByID(ctx, pool.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded.AvailableShares().Equal(decimal.NewFromInt(76000)) {
+		t.Errorf("pool AvailableShares = %s, want 76000", reloaded.AvailableShares())
+	}
+}
+
+func TestGrantStore_ExpiredOptions(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "LapseCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Common",
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(10000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ps := store.NewOptionPoolStore(db)
+	pool := &domain.OptionPool{CompanyID: company.ID, ShareClassID: sc.ID, Name: "2022 Plan", ReservedShares: decimal.NewFromInt(100000)}
+	if err := ps.Create(ctx, pool); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	sh := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Hal",
+		Email:     "hal@lapseco.com",
+		Role:      domain.RoleEmployee,
+	}
+	if err := ss.Create(ctx, sh); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := store.NewGrantStore(db)
+	g := &domain.Grant{
+		CompanyID:               company.ID,
+		StakeholderID:           sh.ID,
+		ShareClassID:            sc.ID,
+		OptionPoolID:            &pool.ID,
+		Quantity:                decimal.NewFromInt(48000),
+		GrantDate:               time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		VestingCommencementDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExercisePrice:           decimal.NewFromFloat(0.10),
+	}
+	if err := gs.Create(ctx, g); err != nil {
+		t.Fatal(err)
+	}
+	es := store.NewGrantExerciseStore(db)
+	e := &domain.GrantExercise{Quantity: decimal.NewFromInt(10000), ExerciseDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	if err := es.Create(ctx, g, e, func(domain.Grant) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	// Half vested at termination; the 14000 vested options left unexercised
+	// expire at the deadline.
+	termination := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deadline := termination.AddDate(0, 0, 90)
+	reason := domain.TerminationVoluntary
+	sh.TerminationDate, sh.TerminationReason = &termination, &reason
+	g.TerminationDate, g.ForfeitedQuantity, g.ExerciseDeadline = &termination, decimal.NewFromInt(24000), &deadline
+	if err := ss.Terminate(ctx, sh, []domain.Grant{*g}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := gs.GetByID(ctx, g.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.ExpiredQuantity.Equal(decimal.NewFromInt(14000)) || !got.OutstandingQuantity().Equal(decimal.NewFromInt(10000)) {
+		t.Errorf("ExpiredQuantity, OutstandingQuantity = %s, %s, want 14000, 10000", got.ExpiredQuantity, got.OutstandingQuantity())
+	}
+	reloaded, err := ps.GetByID(ctx, pool.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded.AvailableShares().Equal(decimal.NewFromInt(90000)) {
+		t.Errorf("pool AvailableShares = %s, want 90000 with the forfeited and expired options returned", reloaded.AvailableShares())
+	}
+}
+
+func TestLeaveStore_AttachedToGrants(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "LeaveCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Common",
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(10000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	sh := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Erin",
+		Email:     "erin@leaveco.com",
+		Role:      domain.RoleEmployee,
+	}
+	if err := ss.Create(ctx, sh); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := store.NewGrantStore(db)
+	var grants [2]*domain.Grant
+	for i := range grants {
+		grants[i] = &domain.Grant{
+			CompanyID:               company.ID,
+			StakeholderID:           sh.ID,
+			ShareClassID:            sc.ID,
+			Quantity:                decimal.NewFromInt(1000),
+			GrantDate:               time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			VestingCommencementDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		if err := gs.Create(ctx, grants[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ls := store.NewLeaveStore(db)
+	leaves := []*domain.LeaveOfAbsence{
+		{StakeholderID: sh.ID, StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{StakeholderID: sh.ID, GrantID: &grants[0].ID, StartDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, l := range leaves {
+		if err := ls.Create(ctx, l); err != nil {
+			t.Fatalf("Create leave: %v", err)
+		}
+	}
+
+	first, err := gs.GetByID(ctx, grants[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Leaves) != 2 {
+		t.Errorf("first grant has %d leaves, want 2", len(first.Leaves))
+	}
+	second, err := gs.GetByID(ctx, grants[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second.Leaves) != 1 {
+		t.Errorf("second grant has %d leaves, want 1", len(second.Leaves))
+	}
+}
+
+func TestGrantStore_CreateFromPool(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "PoolCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Common",
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(10000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	sh := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Gina",
+		Email:     "gina@poolco.com",
+		Role:      domain.RoleEmployee,
+	}
+	if err := ss.Create(ctx, sh); err != nil {
+		t.Fatal(err)
+	}
+
+	ps := store.NewOptionPoolStore(db)
+	pool := &domain.OptionPool{CompanyID: company.ID, ShareClassID: sc.ID, Name: "2024 Plan", ReservedShares: decimal.NewFromInt(1000)}
+	if err := ps.Create(ctx, pool); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := store.NewGrantStore(db)
+	grant := func(quantity int64) *domain.Grant {
+		return &domain.Grant{
+			CompanyID:               company.ID,
+			StakeholderID:           sh.ID,
+			ShareClassID:            sc.ID,
+			OptionPoolID:            &pool.ID,
+			Quantity:                decimal.NewFromInt(quantity),
+			GrantDate:               time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			VestingCommencementDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			ExercisePrice:           decimal.RequireFromString("0.50"),
+		}
+	}
+	if err := gs.Create(ctx, grant(800)); err != nil {
+		t.Fatalf("Create within the pool: %v", err)
+	}
+
+	// The pool is rechecked with the first grant counted.
+	var ce *domain.ErrConflict
+	if err := gs.Create(ctx, grant(300)); !errors.As(err, &ce) {
+		t.Fatalf("Create overdrawing the pool error = %v, want ErrConflict", err)
+	}
+	got, err := ps.GetByID(ctx, pool.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.AvailableShares().Equal(decimal.NewFromInt(200)) {
+		t.Errorf("AvailableShares = %s, want 200", got.AvailableShares())
+	}
+}
+
+func TestGrantExerciseStore_Create(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "ExerciseCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Common",
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(10000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	sh := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Frank",
+		Email:     "frank@exerciseco.com",
+		Role:      domain.RoleEmployee,
+	}
+	if err := ss.Create(ctx, sh); err != nil {
 		t.Fatal(err)
 	}
 
 	gs := store.NewGrantStore(db)
 	g := &domain.Grant{
-		CompanyID:         company.ID,
-		StakeholderID:     sh.ID,
-		ShareClassID:      sc.ID,
-		VestingScheduleID: &vs.ID,
-		Quantity:          decimal.NewFromInt(48000),
-		GrantDate:         time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
-		ExercisePrice:     decimal.NewFromFloat(0.10),
+		CompanyID:               company.ID,
+		StakeholderID:           sh.ID,
+		ShareClassID:            sc.ID,
+		Quantity:                decimal.NewFromInt(1000),
+		GrantDate:               time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		VestingCommencementDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExercisePrice:           decimal.RequireFromString("0.50"),
+		EarlyExerciseAllowed:    true,
 	}
 	if err := gs.Create(ctx, g); err != nil {
 		t.Fatal(err)
 	}
-	if g.ID == "" {
-		t.Fatal("expected grant ID to be set")
+
+	es := store.NewGrantExerciseStore(db)
+	e := &domain.GrantExercise{Quantity: decimal.NewFromInt(1000), ExerciseDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}
+	noCheck := func(domain.Grant) error { return nil }
+	if err := es.Create(ctx, g, e, noCheck); err != nil {
+		t.Fatalf("Create exercise: %v", err)
 	}
 
-	grants, err := gs.ListByCompany(ctx, company.ID)
+	// The second exercise is validated against the grant with the first
+	// already recorded.
+	again := &domain.GrantExercise{Quantity: decimal.NewFromInt(1), ExerciseDate: time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)}
+	err := es.Create(ctx, g, again, func(current domain.Grant) error {
+		if len(current.Exercises) != 1 {
+			t.Errorf("validate saw %d exercises, want 1", len(current.Exercises))
+		}
+		return &domain.ErrValidation{Field: "quantity", Message: "exceeds the 0 exercisable options"}
+	})
+	var ve *domain.ErrValidation
+	if !errors.As(err, &ve) {
+		t.Fatalf("Create over-exercise error = %v, want ErrValidation", err)
+	}
+
+	got, err := gs.GetByID(ctx, g.ID)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(grants) != 1 {
-		t.Fatalf("expected 1 grant, got %d", len(grants))
+	if !got.EarlyExerciseAllowed || !got.IsExercised {
+		t.Errorf("EarlyExerciseAllowed = %v, IsExercised = %v, want both true", got.EarlyExerciseAllowed, got.IsExercised)
 	}
-	if !grants[0].Quantity.Equal(decimal.NewFromInt(48000)) {
-		t.Errorf("Quantity = %s, want 48000", grants[0].Quantity)
+	if len(got.Exercises) != 1 || !got.Exercises[0].Quantity.Equal(decimal.NewFromInt(1000)) {
+		t.Fatalf("Exercises = %+v, want one exercise of 1000", got.Exercises)
+	}
+
+	// Terminating persists the repurchase price of the unvested shares.
+	termination := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	price := decimal.RequireFromString("0.25")
+	reason := domain.TerminationVoluntary
+	sh.TerminationDate, sh.TerminationReason = &termination, &reason
+	got.TerminationDate, got.RepurchasePrice = &termination, &price
+	if err := ss.Terminate(ctx, sh, []domain.Grant{*got}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = gs.GetByID(ctx, g.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.RepurchasePrice == nil || !got.RepurchasePrice.Equal(price) {
+		t.Errorf("RepurchasePrice = %v, want %s", got.RepurchasePrice, price)
+	}
+}
+
+func TestGrantStore_Repurchase(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "FounderCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Common",
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(10000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	sh := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Grace",
+		Email:     "grace@founderco.com",
+		Role:      domain.RoleFounder,
+	}
+	if err := ss.Create(ctx, sh); err != nil {
+		t.Fatal(err)
+	}
+
+	gs := store.NewGrantStore(db)
+	g := &domain.Grant{
+		CompanyID:               company.ID,
+		StakeholderID:           sh.ID,
+		ShareClassID:            sc.ID,
+		Type:                    domain.GrantTypeRSA,
+		Quantity:                decimal.NewFromInt(4000000),
+		GrantDate:               time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		VestingCommencementDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExercisePrice:           decimal.RequireFromString("0.0001"),
+	}
+	if err := gs.Create(ctx, g); err != nil {
+		t.Fatal(err)
+	}
+
+	repurchaseDate := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	g.RepurchasedQuantity = decimal.NewFromInt(2000000)
+	g.RepurchaseDate = &repurchaseDate
+	g.RepurchasePrice = &g.ExercisePrice
+	if err := gs.Repurchase(ctx, g); err != nil {
+		t.Fatalf("Repurchase: %v", err)
+	}
+	var conflict *domain.ErrConflict
+	if err := gs.Repurchase(ctx, g); !errors.As(err, &conflict) {
+		t.Errorf("second Repurchase: expected ErrConflict, got %v", err)
+	}
+
+	got, err := gs.GetByID(ctx, g.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != domain.GrantTypeRSA {
+		t.Errorf("Type = %q, want %q", got.Type, domain.GrantTypeRSA)
+	}
+	if !got.OutstandingQuantity().Equal(decimal.NewFromInt(2000000)) {
+		t.Errorf("OutstandingQuantity = %s, want 2000000", got.OutstandingQuantity())
+	}
+	if got.RepurchaseDate == nil || !got.RepurchaseDate.Equal(repurchaseDate) {
+		t.Errorf("RepurchaseDate = %v, want %v", got.RepurchaseDate, repurchaseDate)
+	}
+}
+
+func TestMFNNoticeStore_CreateAndList(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "MFNCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	early := &domain.Stakeholder{CompanyID: company.ID, Name: "Early Angel", Email: "early@mfn.co", Role: domain.RoleInvestor}
+	late := &domain.Stakeholder{CompanyID: company.ID, Name: "Late Angel", Email: "late@mfn.co", Role: domain.RoleInvestor}
+	for _, sh := range []*domain.Stakeholder{early, late} {
+		if err := ss.Create(ctx, sh); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sns := store.NewSAFENoteStore(db)
+	mfn := &domain.SAFENote{
+		CompanyID:        company.ID,
+		StakeholderID:    early.ID,
+		InvestmentAmount: decimal.NewFromInt(100000),
+		SAFEType:         domain.SAFEPostMoney,
+		IsMFN:            true,
+		IssueDate:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	cap := decimal.NewFromInt(6000000)
+	source := &domain.SAFENote{
+		CompanyID:        company.ID,
+		StakeholderID:    late.ID,
+		InvestmentAmount: decimal.NewFromInt(250000),
+		ValuationCap:     &cap,
+		SAFEType:         domain.SAFEPostMoney,
+		IssueDate:        time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if err := sns.Create(ctx, mfn); err != nil {
+		t.Fatal(err)
+	}
+	// The source SAFE is created together with the notice it sends.
+	if err := sns.CreateWithNotices(ctx, source, []domain.MFNNotice{{
+		SAFEID:        mfn.ID,
+		StakeholderID: early.ID,
+		ValuationCap:  &cap,
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := sns.GetByID(ctx, mfn.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.IsMFN {
+		t.Error("expected IsMFN to round-trip")
+	}
+
+	ms := store.NewMFNNoticeStore(db)
+	notices, err := ms.ListByStakeholder(ctx, early.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notices) != 1 || notices[0].SourceSAFEID != source.ID ||
+		notices[0].ValuationCap == nil || !notices[0].ValuationCap.Equal(cap) || notices[0].DiscountRate != nil {
+		t.Errorf("unexpected notices: %+v", notices)
+	}
+	if others, err := ms.ListByStakeholder(ctx, late.ID); err != nil || len(others) != 0 {
+		t.Errorf("expected no notices for the source holder, got %v (err %v)", others, err)
+	}
+}
+
+func TestConvertibleNoteStore_CreateAndSettle(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "NoteCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	investor := &domain.Stakeholder{CompanyID: company.ID, Name: "Lender", Email: "lender@noteco.com", Role: domain.RoleInvestor}
+	if err := ss.Create(ctx, investor); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Series Seed",
+		IsPreferred:         true,
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(5000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	ns := store.NewConvertibleNoteStore(db)
+	cap := decimal.NewFromInt(8000000)
+	converting := &domain.ConvertibleNote{
+		CompanyID:     company.ID,
+		StakeholderID: investor.ID,
+		Principal:     decimal.NewFromInt(100000),
+		InterestRate:  decimal.NewFromFloat(0.08),
+		InterestType:  domain.InterestCompound,
+		DayCount:      domain.DayCount30360,
+		IssueDate:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaturityDate:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ValuationCap:  &cap,
+	}
+	repaid := &domain.ConvertibleNote{
+		CompanyID:     company.ID,
+		StakeholderID: investor.ID,
+		Principal:     decimal.NewFromInt(50000),
+		InterestRate:  decimal.NewFromFloat(0.05),
+		IssueDate:     time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		MaturityDate:  time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, n := range []*domain.ConvertibleNote{converting, repaid} {
+		if err := ns.Create(ctx, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := ns.GetByID(ctx, converting.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.InterestType != domain.InterestCompound || got.DayCount != domain.DayCount30360 ||
+		got.ValuationCap == nil || !got.ValuationCap.Equal(cap) || got.Settlement != nil {
+		t.Errorf("unexpected note: %+v", got)
+	}
+
+	converting.Settlement = &domain.NoteSettlement{
+		Date:            time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Principal:       converting.Principal,
+		AccruedInterest: decimal.NewFromInt(8000),
+		SharesIssued:    decimal.NewFromInt(108000),
+		EffectivePPS:    decimal.NewFromInt(1),
+		RepaymentAmount: decimal.Zero,
+		Method:          "cap",
+	}
+	if err := ns.Settle(ctx, converting, sc.ID); err != nil {
+		t.Fatal(err)
+	}
+	if converting.SettlementGrantID == nil {
+		t.Fatal("expected a stock grant for the converted shares")
+	}
+	issuance, err := store.NewGrantStore(db).GetByID(ctx, *converting.SettlementGrantID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issuance.Type != domain.GrantTypeStock || issuance.ShareClassID != sc.ID || !issuance.Quantity.Equal(decimal.NewFromInt(108000)) {
+		t.Errorf("issuance = %s %s in %s, want 108000 stock in %s", issuance.Quantity, issuance.Type, issuance.ShareClassID, sc.ID)
+	}
+
+	repaid.Settlement = &domain.NoteSettlement{
+		Date:            repaid.MaturityDate,
+		Principal:       repaid.Principal,
+		AccruedInterest: decimal.NewFromInt(2500),
+		SharesIssued:    decimal.Zero,
+		EffectivePPS:    decimal.Zero,
+		RepaymentAmount: decimal.NewFromInt(52500),
+		Method:          "repayment",
+	}
+	if err := ns.Settle(ctx, repaid, ""); err != nil {
+		t.Fatal(err)
+	}
+	if repaid.SettlementGrantID != nil {
+		t.Error("expected no grant for a repaid note")
+	}
+
+	notes, err := ns.ListByCompany(ctx, company.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notes) != 2 || notes[0].Settlement == nil || notes[0].Settlement.Method != "cap" ||
+		notes[1].Settlement == nil || !notes[1].Settlement.RepaymentAmount.Equal(decimal.NewFromInt(52500)) {
+		t.Errorf("unexpected settled notes: %+v", notes)
+	}
+
+	err = ns.Settle(ctx, repaid, "")
+	var conflict *domain.ErrConflict
+	if !errors.As(err, &conflict) {
+		t.Errorf("settling twice: expected ErrConflict, got %v", err)
 	}
 }
 
@@ -309,12 +1223,27 @@ func TestSAFENoteStore_CreateAndMarkConverted(t *testing.T) {
 		ValuationCap:     &cap,
 		SAFEType:         domain.SAFEPostMoney,
 		IssueDate:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+
+		CapitalizationDefinition: domain.CapitalizationFullyDiluted,
 	}
 	if err := sns.Create(ctx, safe); err != nil {
 		t.Fatal(err)
 	}
 
-	if err := sns.MarkConverted(ctx, safe.ID, round.ID); err != nil {
+	safe.Conversion = &domain.SAFEConversionResult{
+		SAFEID:           safe.ID,
+		SharesIssued:     decimal.NewFromInt(333333),
+		EffectivePPS:     decimal.NewFromFloat(1.20),
+		ConversionMethod: "cap",
+		Capitalization: &domain.Capitalization{
+			Definition:    domain.CapitalizationFullyDiluted,
+			IssuedShares:  decimal.NewFromInt(6000000),
+			IssuedOptions: decimal.NewFromInt(500000),
+			UnissuedPool:  decimal.NewFromInt(166667),
+			Total:         decimal.NewFromInt(6666667),
+		},
+	}
+	if err := sns.MarkConverted(ctx, safe, round); err != nil {
 		t.Fatal(err)
 	}
 
@@ -328,6 +1257,157 @@ func TestSAFENoteStore_CreateAndMarkConverted(t *testing.T) {
 	if got.ConvertedInRound == nil || *got.ConvertedInRound != round.ID {
 		t.Error("expected ConvertedInRound to be set")
 	}
+	if got.Conversion == nil || got.Conversion.ConversionMethod != "cap" ||
+		!got.Conversion.SharesIssued.Equal(decimal.NewFromInt(333333)) || !got.Conversion.EffectivePPS.Equal(decimal.NewFromFloat(1.20)) {
+		t.Errorf("Conversion = %+v, want 333333 shares at 1.20 by cap", got.Conversion)
+	}
+	if c := got.Conversion.Capitalization; c == nil || c.Definition != domain.CapitalizationFullyDiluted ||
+		!c.UnissuedPool.Equal(decimal.NewFromInt(166667)) || !c.Total.Equal(decimal.NewFromInt(6666667)) {
+		t.Errorf("Capitalization = %+v, want fully diluted totalling 6666667", c)
+	}
+	if got.ConversionGrantID == nil {
+		t.Fatal("expected ConversionGrantID to be set")
+	}
+
+	issuance, err := store.NewGrantStore(db).GetByID(ctx, *got.ConversionGrantID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issuance.Type != domain.GrantTypeStock || issuance.StakeholderID != investor.ID {
+		t.Errorf("issuance = %s grant to %s, want stock to %s", issuance.Type, issuance.StakeholderID, investor.ID)
+	}
+	if !issuance.Quantity.Equal(decimal.NewFromInt(333333)) || !issuance.ExercisePrice.Equal(decimal.NewFromFloat(1.20)) {
+		t.Errorf("issuance = %s shares at %s, want 333333 at 1.20", issuance.Quantity, issuance.ExercisePrice)
+	}
+
+	// Converting at the cap issues the shares in a shadow series priced at 1.20.
+	shadow, err := scs.GetByID(ctx, issuance.ShareClassID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shadow.Name != "Series A-1" || shadow.ParentShareClassID == nil || *shadow.ParentShareClassID != sc.ID {
+		t.Errorf("shadow series = %s under %v, want Series A-1 under %s", shadow.Name, shadow.ParentShareClassID, sc.ID)
+	}
+	if shadow.PricePerShare == nil || !shadow.PricePerShare.Equal(decimal.NewFromFloat(1.20)) ||
+		!shadow.IsPreferred || shadow.Seniority != sc.Seniority || !shadow.AuthorizedShares.Equal(decimal.NewFromInt(333333)) {
+		t.Errorf("shadow series = %+v, want preferred at 1.20 with the parent's seniority and 333333 authorized", shadow)
+	}
+
+	if err := sns.MarkConverted(ctx, safe, round); err == nil {
+		t.Error("expected converting a SAFE twice to fail")
+	}
+}
+
+func TestSAFENoteStore_MarkAllConverted(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	cs := store.NewCompanyStore(db)
+	company := &domain.Company{Name: "BatchSafeCo"}
+	if err := cs.Create(ctx, company); err != nil {
+		t.Fatal(err)
+	}
+
+	ss := store.NewStakeholderStore(db)
+	investor := &domain.Stakeholder{
+		CompanyID: company.ID,
+		Name:      "Investor",
+		Email:     "inv@batchsafe.co",
+		Role:      domain.RoleInvestor,
+	}
+	if err := ss.Create(ctx, investor); err != nil {
+		t.Fatal(err)
+	}
+
+	scs := store.NewShareClassStore(db)
+	sc := &domain.ShareClass{
+		CompanyID:           company.ID,
+		Name:                "Series Seed",
+		IsPreferred:         true,
+		LiquidationMultiple: decimal.NewFromInt(1),
+		AuthorizedShares:    decimal.NewFromInt(5000000),
+	}
+	if err := scs.Create(ctx, sc); err != nil {
+		t.Fatal(err)
+	}
+
+	frs := store.NewFundingRoundStore(db)
+	round := &domain.FundingRound{
+		CompanyID:     company.ID,
+		Name:          "Seed",
+		PreMoneyVal:   decimal.NewFromInt(10000000),
+		AmountRaised:  decimal.NewFromInt(2000000),
+		PricePerShare: decimal.NewFromInt(1),
+		ShareClassID:  sc.ID,
+		RoundDate:     time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if err := frs.Create(ctx, round); err != nil {
+		t.Fatal(err)
+	}
+
+	sns := store.NewSAFENoteStore(db)
+	var safes []domain.SAFENote
+	for i := 0; i < 2; i++ {
+		cap := decimal.NewFromInt(8000000)
+		safe := &domain.SAFENote{
+			CompanyID:        company.ID,
+			StakeholderID:    investor.ID,
+			InvestmentAmount: decimal.NewFromInt(250000),
+			ValuationCap:     &cap,
+			SAFEType:         domain.SAFEPostMoney,
+			IssueDate:        time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC),
+		}
+		if err := sns.Create(ctx, safe); err != nil {
+			t.Fatal(err)
+		}
+		safe.Conversion = &domain.SAFEConversionResult{
+			SAFEID:           safe.ID,
+			SharesIssued:     decimal.NewFromInt(250000),
+			EffectivePPS:     decimal.NewFromInt(1),
+			ConversionMethod: "round_price",
+		}
+		safes = append(safes, *safe)
+	}
+
+	first := safes[0]
+	if err := sns.MarkConverted(ctx, &first, round); err != nil {
+		t.Fatal(err)
+	}
+	err := sns.MarkAllConverted(ctx, safes, round)
+	var conflict *domain.ErrConflict
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	got, err := sns.GetByID(ctx, safes[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.IsConverted {
+		t.Error("expected failed batch to leave SAFE unconverted")
+	}
+
+	gs := store.NewGrantStore(db)
+	grants, err := gs.ListByCompany(ctx, company.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(grants) != 1 {
+		t.Errorf("got %d grants, want only the first SAFE's issuance", len(grants))
+	}
+
+	if err := sns.MarkAllConverted(ctx, safes[1:], round); err != nil {
+		t.Fatal(err)
+	}
+	got, err = sns.GetByID(ctx, safes[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.IsConverted || got.ConvertedInRound == nil || *got.ConvertedInRound != round.ID {
+		t.Error("expected SAFE to be converted in round")
+	}
+	if got.ConversionGrantID == nil || safes[1].ConversionGrantID == nil || *got.ConversionGrantID != *safes[1].ConversionGrantID {
+		t.Error("expected ConversionGrantID to be set on the SAFE and the stored row")
+	}
 }
 
 func TestStakeholderStore_GetByIDs(t *testing.T) {
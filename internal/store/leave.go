@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/lib/pq"
+)
+
+type LeaveStore struct {
+	db *sql.DB
+}
+
+func NewLeaveStore(db *sql.DB) *LeaveStore {
+	return &LeaveStore{db: db}
+}
+
+func (s *LeaveStore) Create(ctx context.Context, l *domain.LeaveOfAbsence) error {
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO leaves_of_absence (stakeholder_id, grant_id, start_date, end_date, is_paid)
+		 VALUES ($1, $2, $3, $4, $5)
+		 RETURNING id, created_at`,
+		l.StakeholderID, l.GrantID, l.StartDate, l.EndDate, l.IsPaid,
+	).Scan(&l.ID, &l.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("creating leave of absence: %w", err)
+	}
+	return nil
+}
+
+func (s *LeaveStore) ListByStakeholder(ctx context.Context, stakeholderID string) ([]domain.LeaveOfAbsence, error) {
+	return listLeaves(ctx, s.db, []string{stakeholderID})
+}
+
+func listLeaves(ctx context.Context, db *sql.DB, stakeholderIDs []string) ([]domain.LeaveOfAbsence, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, stakeholder_id, grant_id, start_date, end_date, is_paid, created_at
+		 FROM leaves_of_absence WHERE stakeholder_id = ANY($1)
+		 ORDER BY start_date`, pq.Array(stakeholderIDs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing leaves of absence: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.LeaveOfAbsence
+	for rows.Next() {
+		var l domain.LeaveOfAbsence
+		if err := rows.Scan(&l.ID, &l.StakeholderID, &l.GrantID, &l.StartDate, &l.EndDate, &l.IsPaid, &l.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning leave of absence: %w", err)
+		}
+		result = append(result, l)
+	}
+	return result, rows.Err()
+}
+
+// attachLeaves batch-loads each grant's leaves: those recorded against the
+// grant itself and those recorded against its stakeholder as a whole.
+func attachLeaves(ctx context.Context, db *sql.DB, grants []domain.Grant) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(grants))
+	var ids []string
+	for _, g := range grants {
+		if _, ok := seen[g.StakeholderID]; !ok {
+			seen[g.StakeholderID] = struct{}{}
+			ids = append(ids, g.StakeholderID)
+		}
+	}
+
+	leaves, err := listLeaves(ctx, db, ids)
+	if err != nil {
+		return err
+	}
+	for i := range grants {
+		g := &grants[i]
+		g.Leaves = nil
+		for _, l := range leaves {
+			if l.StakeholderID == g.StakeholderID && (l.GrantID == nil || *l.GrantID == g.ID) {
+				g.Leaves = append(g.Leaves, l)
+			}
+		}
+	}
+	return nil
+}
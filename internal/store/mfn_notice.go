@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+type MFNNoticeStore struct {
+	db *sql.DB
+}
+
+func NewMFNNoticeStore(db *sql.DB) *MFNNoticeStore {
+	return &MFNNoticeStore{db: db}
+}
+
+func (s *MFNNoticeStore) Create(ctx context.Context, n *domain.MFNNotice) error {
+	return insertMFNNotice(ctx, s.db, n)
+}
+
+func insertMFNNotice(ctx context.Context, q queryRower, n *domain.MFNNotice) error {
+	err := q.QueryRowContext(ctx,
+		`INSERT INTO mfn_notices (safe_note_id, source_safe_note_id, stakeholder_id, valuation_cap, discount_rate)
+		 VALUES ($1, $2, $3, $4, $5)
+		 RETURNING id, created_at`,
+		n.SAFEID, n.SourceSAFEID, n.StakeholderID,
+		decimalPtrToNullString(n.ValuationCap), decimalPtrToNullString(n.DiscountRate),
+	).Scan(&n.ID, &n.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("creating MFN notice: %w", err)
+	}
+	return nil
+}
+
+// ListByStakeholder returns the notices sent to a holder, newest first.
+func (s *MFNNoticeStore) ListByStakeholder(ctx context.Context, stakeholderID string) ([]domain.MFNNotice, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, safe_note_id, source_safe_note_id, stakeholder_id, valuation_cap, discount_rate, created_at
+		 FROM mfn_notices WHERE stakeholder_id = $1
+		 ORDER BY created_at DESC`, stakeholderID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing MFN notices: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.MFNNotice
+	for rows.Next() {
+		var n domain.MFNNotice
+		var valCap, discRate sql.NullString
+		if err := rows.Scan(&n.ID, &n.SAFEID, &n.SourceSAFEID, &n.StakeholderID, &valCap, &discRate, &n.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning MFN notice: %w", err)
+		}
+		n.ValuationCap = nullStringToDecimalPtr(valCap)
+		n.DiscountRate = nullStringToDecimalPtr(discRate)
+		result = append(result, n)
+	}
+	return result, rows.Err()
+}
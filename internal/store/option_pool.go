@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hutfut/vestigo/internal/domain"
+)
+
+type OptionPoolStore struct {
+	db *sql.DB
+}
+
+func NewOptionPoolStore(db *sql.DB) *OptionPoolStore {
+	return &OptionPoolStore{db: db}
+}
+
+// optionPoolColumns computes granted shares as the quantity of the pool's
+// grants net of forfeitures and expired options, so both flow back into
+// availability. Repurchased shares go to treasury, not back to the pool.
+const optionPoolColumns = `p.id, p.company_id, p.share_class_id, p.name, p.reserved_shares,
+	COALESCE((SELECT SUM(g.quantity - g.forfeited_quantity - ` + grantExpiredQuantity + `) FROM grants g
+	          WHERE g.option_pool_id = p.id AND g.deleted_at IS NULL), 0),
+	p.created_at, p.updated_at, p.deleted_at`
+
+func scanOptionPool(row rowScanner, p *domain.OptionPool) error {
+	return row.Scan(&p.ID, &p.CompanyID, &p.ShareClassID, &p.Name, &p.ReservedShares, &p.GrantedShares,
+		&p.CreatedAt, &p.UpdatedAt, &p.DeletedAt)
+}
+
+func (s *OptionPoolStore) Create(ctx context.Context, p *domain.OptionPool) error {
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO option_pools (company_id, share_class_id, name, reserved_shares)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id, created_at, updated_at`,
+		p.CompanyID, p.ShareClassID, p.Name, p.ReservedShares,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("creating option pool: %w", err)
+	}
+	return nil
+}
+
+func (s *OptionPoolStore) GetByID(ctx context.Context, id string) (*domain.OptionPool, error) {
+	p := &domain.OptionPool{}
+	err := scanOptionPool(s.db.QueryRowContext(ctx,
+		`SELECT `+optionPoolColumns+`
+		 FROM option_pools p WHERE p.id = $1 AND p.deleted_at IS NULL`, id,
+	), p)
+	if err == sql.ErrNoRows {
+		return nil, &domain.ErrNotFound{Entity: "option_pool", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting option pool: %w", err)
+	}
+	return p, nil
+}
+
+// lockOptionPool locks the pool row within tx and then reads the pool, so
+// its granted shares include every grant committed before the lock.
+func lockOptionPool(ctx context.Context, tx *sql.Tx, id string) (*domain.OptionPool, error) {
+	var locked string
+	err := tx.QueryRowContext(ctx,
+		`SELECT id FROM option_pools WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, id,
+	).Scan(&locked)
+	if err == sql.ErrNoRows {
+		return nil, &domain.ErrNotFound{Entity: "option_pool", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("locking option pool %s: %w", id, err)
+	}
+
+	p := &domain.OptionPool{}
+	err = scanOptionPool(tx.QueryRowContext(ctx,
+		`SELECT `+optionPoolColumns+`
+		 FROM option_pools p WHERE p.id = $1`, id,
+	), p)
+	if err != nil {
+		return nil, fmt.Errorf("getting option pool %s: %w", id, err)
+	}
+	return p, nil
+}
+
+func (s *OptionPoolStore) ListByCompany(ctx context.Context, companyID string) ([]domain.OptionPool, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+optionPoolColumns+`
+		 FROM option_pools p WHERE p.company_id = $1 AND p.deleted_at IS NULL
+		 ORDER BY p.created_at`, companyID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing option pools: %w", err)
+	}
+	defer rows.Close()
+
+	var result []domain.OptionPool
+	for rows.Next() {
+		var p domain.OptionPool
+		if err := scanOptionPool(rows, &p); err != nil {
+			return nil, fmt.Errorf("scanning option pool: %w", err)
+		}
+		result = append(result, p)
+	}
+	return result, rows.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/shopspring/decimal"
 )
 
 type SAFENoteStore struct {
@@ -16,15 +17,84 @@ func NewSAFENoteStore(db *sql.DB) *SAFENoteStore {
 	return &SAFENoteStore{db: db}
 }
 
+// safeNoteColumns is the select list scanned by scanSAFENote.
+const safeNoteColumns = `id, company_id, stakeholder_id, investment_amount, valuation_cap, discount_rate,
+	safe_type, is_mfn, capitalization_definition, is_converted, converted_in_round, issue_date, inherited_terms_from,
+	conversion_method, conversion_price, conversion_shares, conversion_grant_id,
+	cap_issued_shares, cap_issued_options, cap_promised_options, cap_unissued_pool, cap_pool_increase,
+	created_at, updated_at, deleted_at`
+
+func scanSAFENote(row rowScanner, sn *domain.SAFENote) error {
+	var valCap, discRate, convPrice, convShares, convMethod sql.NullString
+	var capShares, capOptions, capPromised, capPool, capIncrease sql.NullString
+	err := row.Scan(&sn.ID, &sn.CompanyID, &sn.StakeholderID, &sn.InvestmentAmount, &valCap, &discRate,
+		&sn.SAFEType, &sn.IsMFN, &sn.CapitalizationDefinition, &sn.IsConverted, &sn.ConvertedInRound, &sn.IssueDate, &sn.InheritedTermsFrom,
+		&convMethod, &convPrice, &convShares, &sn.ConversionGrantID,
+		&capShares, &capOptions, &capPromised, &capPool, &capIncrease,
+		&sn.CreatedAt, &sn.UpdatedAt, &sn.DeletedAt)
+	if err != nil {
+		return err
+	}
+	sn.ValuationCap = nullStringToDecimalPtr(valCap)
+	sn.DiscountRate = nullStringToDecimalPtr(discRate)
+	if convMethod.Valid {
+		sn.Conversion = &domain.SAFEConversionResult{
+			SAFEID:             sn.ID,
+			SharesIssued:       *nullStringToDecimalPtr(convShares),
+			EffectivePPS:       *nullStringToDecimalPtr(convPrice),
+			ConversionMethod:   convMethod.String,
+			InheritedTermsFrom: sn.InheritedTermsFrom,
+		}
+		if capShares.Valid {
+			c := domain.Capitalization{
+				Definition:      sn.CapitalizationDefinition,
+				IssuedShares:    *nullStringToDecimalPtr(capShares),
+				IssuedOptions:   *nullStringToDecimalPtr(capOptions),
+				PromisedOptions: *nullStringToDecimalPtr(capPromised),
+				UnissuedPool:    *nullStringToDecimalPtr(capPool),
+				PoolIncrease:    *nullStringToDecimalPtr(capIncrease),
+			}
+			c.Total = c.IssuedShares.Add(c.IssuedOptions).Add(c.PromisedOptions).Add(c.UnissuedPool).Add(c.PoolIncrease)
+			sn.Conversion.Capitalization = &c
+		}
+	}
+	return nil
+}
+
 func (s *SAFENoteStore) Create(ctx context.Context, sn *domain.SAFENote) error {
-	err := s.db.QueryRowContext(ctx,
+	return s.CreateWithNotices(ctx, sn, nil)
+}
+
+// CreateWithNotices inserts the SAFE and the MFN notices its issue sends, in
+// one transaction, setting each notice's source to the new SAFE.
+func (s *SAFENoteStore) CreateWithNotices(ctx context.Context, sn *domain.SAFENote, notices []domain.MFNNotice) error {
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := insertSAFENote(ctx, tx, sn); err != nil {
+			return err
+		}
+		for i := range notices {
+			notices[i].SourceSAFEID = sn.ID
+			if err := insertMFNNotice(ctx, tx, &notices[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func insertSAFENote(ctx context.Context, q queryRower, sn *domain.SAFENote) error {
+	if sn.CapitalizationDefinition == "" {
+		sn.CapitalizationDefinition = domain.CapitalizationOutstanding
+	}
+	err := q.QueryRowContext(ctx,
 		`INSERT INTO safe_notes
-		 (company_id, stakeholder_id, investment_amount, valuation_cap, discount_rate, safe_type, issue_date)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 (company_id, stakeholder_id, investment_amount, valuation_cap, discount_rate, safe_type, is_mfn,
+		  capitalization_definition, issue_date)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		 RETURNING id, created_at, updated_at`,
 		sn.CompanyID, sn.StakeholderID, sn.InvestmentAmount,
 		decimalPtrToNullString(sn.ValuationCap), decimalPtrToNullString(sn.DiscountRate),
-		sn.SAFEType, sn.IssueDate,
+		sn.SAFEType, sn.IsMFN, sn.CapitalizationDefinition, sn.IssueDate,
 	).Scan(&sn.ID, &sn.CreatedAt, &sn.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("creating SAFE note: %w", err)
@@ -34,31 +104,22 @@ func (s *SAFENoteStore) Create(ctx context.Context, sn *domain.SAFENote) error {
 
 func (s *SAFENoteStore) GetByID(ctx context.Context, id string) (*domain.SAFENote, error) {
 	sn := &domain.SAFENote{}
-	var valCap, discRate sql.NullString
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, company_id, stakeholder_id, investment_amount, valuation_cap, discount_rate,
-		        safe_type, is_converted, converted_in_round, issue_date,
-		        created_at, updated_at, deleted_at
+	err := scanSAFENote(s.db.QueryRowContext(ctx,
+		`SELECT `+safeNoteColumns+`
 		 FROM safe_notes WHERE id = $1 AND deleted_at IS NULL`, id,
-	).Scan(&sn.ID, &sn.CompanyID, &sn.StakeholderID, &sn.InvestmentAmount, &valCap, &discRate,
-		&sn.SAFEType, &sn.IsConverted, &sn.ConvertedInRound, &sn.IssueDate,
-		&sn.CreatedAt, &sn.UpdatedAt, &sn.DeletedAt)
+	), sn)
 	if err == sql.ErrNoRows {
 		return nil, &domain.ErrNotFound{Entity: "safe_note", ID: id}
 	}
 	if err != nil {
 		return nil, fmt.Errorf("getting SAFE note: %w", err)
 	}
-	sn.ValuationCap = nullStringToDecimalPtr(valCap)
-	sn.DiscountRate = nullStringToDecimalPtr(discRate)
 	return sn, nil
 }
 
 func (s *SAFENoteStore) ListByCompany(ctx context.Context, companyID string) ([]domain.SAFENote, error) {
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT id, company_id, stakeholder_id, investment_amount, valuation_cap, discount_rate,
-		        safe_type, is_converted, converted_in_round, issue_date,
-		        created_at, updated_at, deleted_at
+		`SELECT `+safeNoteColumns+`
 		 FROM safe_notes WHERE company_id = $1 AND deleted_at IS NULL
 		 ORDER BY issue_date`, companyID,
 	)
@@ -70,30 +131,78 @@ func (s *SAFENoteStore) ListByCompany(ctx context.Context, companyID string) ([]
 	var result []domain.SAFENote
 	for rows.Next() {
 		var sn domain.SAFENote
-		var valCap, discRate sql.NullString
-		if err := rows.Scan(&sn.ID, &sn.CompanyID, &sn.StakeholderID, &sn.InvestmentAmount, &valCap, &discRate,
-			&sn.SAFEType, &sn.IsConverted, &sn.ConvertedInRound, &sn.IssueDate,
-			&sn.CreatedAt, &sn.UpdatedAt, &sn.DeletedAt); err != nil {
+		if err := scanSAFENote(rows, &sn); err != nil {
 			return nil, fmt.Errorf("scanning SAFE note: %w", err)
 		}
-		sn.ValuationCap = nullStringToDecimalPtr(valCap)
-		sn.DiscountRate = nullStringToDecimalPtr(discRate)
 		result = append(result, sn)
 	}
 	return result, rows.Err()
 }
 
-func (s *SAFENoteStore) MarkConverted(ctx context.Context, id string, roundID string) error {
-	res, err := s.db.ExecContext(ctx,
-		`UPDATE safe_notes SET is_converted = true, converted_in_round = $2
-		 WHERE id = $1 AND deleted_at IS NULL`, id, roundID,
-	)
-	if err != nil {
-		return fmt.Errorf("marking SAFE as converted: %w", err)
+// MarkConverted records the SAFE's conversion in round: it issues the
+// converted shares as a stock grant and marks the SAFE converted with its
+// result, in one transaction. Shares converted at the cap or discount are
+// issued in a shadow series of the round's class at the conversion price,
+// created on first use. sn.Conversion must be set.
+func (s *SAFENoteStore) MarkConverted(ctx context.Context, sn *domain.SAFENote, round *domain.FundingRound) error {
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		return markConverted(ctx, tx, sn, round)
+	})
+}
+
+// MarkAllConverted records the conversion of every SAFE in safes, as
+// MarkConverted does, in one transaction. It fails without changes if any of
+// them is already converted.
+func (s *SAFENoteStore) MarkAllConverted(ctx context.Context, safes []domain.SAFENote, round *domain.FundingRound) error {
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		for i := range safes {
+			if err := markConverted(ctx, tx, &safes[i], round); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func markConverted(ctx context.Context, tx *sql.Tx, sn *domain.SAFENote, round *domain.FundingRound) error {
+	shareClassID := round.ShareClassID
+	if sn.Conversion.BelowRoundPrice() {
+		id, err := shadowSeries(ctx, tx, round.ShareClassID, sn.Conversion.EffectivePPS, sn.Conversion.SharesIssued)
+		if err != nil {
+			return err
+		}
+		shareClassID = id
+	}
+	issuance := sn.Issuance(*round, shareClassID)
+	if err := insertGrant(ctx, tx, &issuance); err != nil {
+		return err
 	}
-	n, _ := res.RowsAffected()
-	if n == 0 {
-		return &domain.ErrNotFound{Entity: "safe_note", ID: id}
+	var capShares, capOptions, capPromised, capPool, capIncrease *decimal.Decimal
+	if c := sn.Conversion.Capitalization; c != nil {
+		capShares, capOptions, capPromised, capPool, capIncrease = &c.IssuedShares, &c.IssuedOptions, &c.PromisedOptions, &c.UnissuedPool, &c.PoolIncrease
+	}
+	err := tx.QueryRowContext(ctx,
+		`UPDATE safe_notes SET is_converted = true, converted_in_round = $2,
+		        conversion_method = $3, conversion_price = $4, conversion_shares = $5, conversion_grant_id = $6,
+		        inherited_terms_from = $7,
+		        cap_issued_shares = $8, cap_issued_options = $9, cap_promised_options = $10,
+		        cap_unissued_pool = $11, cap_pool_increase = $12
+		 WHERE id = $1 AND is_converted = false AND deleted_at IS NULL
+		 RETURNING updated_at`,
+		sn.ID, round.ID, sn.Conversion.ConversionMethod, sn.Conversion.EffectivePPS, sn.Conversion.SharesIssued, issuance.ID,
+		sn.Conversion.InheritedTermsFrom,
+		decimalPtrToNullString(capShares), decimalPtrToNullString(capOptions), decimalPtrToNullString(capPromised),
+		decimalPtrToNullString(capPool), decimalPtrToNullString(capIncrease),
+	).Scan(&sn.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return &domain.ErrConflict{Message: fmt.Sprintf("SAFE %s is already converted", sn.ID)}
+	}
+	if err != nil {
+		return fmt.Errorf("marking SAFE %s as converted: %w", sn.ID, err)
 	}
+	sn.IsConverted = true
+	sn.ConvertedInRound = &round.ID
+	sn.ConversionGrantID = &issuance.ID
+	sn.InheritedTermsFrom = sn.Conversion.InheritedTermsFrom
 	return nil
 }
@@ -18,16 +18,48 @@ func NewShareClassStore(db *sql.DB) *ShareClassStore {
 	return &ShareClassStore{db: db}
 }
 
+// shareClassColumns is the select list scanned by scanShareClass.
+const shareClassColumns = `id, company_id, name, is_preferred, liquidation_multiple, is_participating,
+	participation_cap, price_per_share, seniority, authorized_shares, parent_share_class_id,
+	created_at, updated_at, deleted_at`
+
+func scanShareClass(row rowScanner, sc *domain.ShareClass) error {
+	var participationCap, pricePerShare sql.NullString
+	err := row.Scan(&sc.ID, &sc.CompanyID, &sc.Name, &sc.IsPreferred, &sc.LiquidationMultiple,
+		&sc.IsParticipating, &participationCap, &pricePerShare, &sc.Seniority,
+		&sc.AuthorizedShares, &sc.ParentShareClassID, &sc.CreatedAt, &sc.UpdatedAt, &sc.DeletedAt)
+	if err != nil {
+		return err
+	}
+	sc.ParticipationCap = nullStringToDecimalPtr(participationCap)
+	sc.PricePerShare = nullStringToDecimalPtr(pricePerShare)
+	return nil
+}
+
 func (s *ShareClassStore) Create(ctx context.Context, sc *domain.ShareClass) error {
-	err := s.db.QueryRowContext(ctx,
+	return insertShareClass(ctx, s.db, sc)
+}
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// querier is satisfied by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func insertShareClass(ctx context.Context, q queryRower, sc *domain.ShareClass) error {
+	err := q.QueryRowContext(ctx,
 		`INSERT INTO share_classes
 		 (company_id, name, is_preferred, liquidation_multiple, is_participating,
-		  participation_cap, price_per_share, seniority, authorized_shares)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		  participation_cap, price_per_share, seniority, authorized_shares, parent_share_class_id)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		 RETURNING id, created_at, updated_at`,
 		sc.CompanyID, sc.Name, sc.IsPreferred, sc.LiquidationMultiple, sc.IsParticipating,
 		decimalPtrToNullString(sc.ParticipationCap), decimalPtrToNullString(sc.PricePerShare),
-		sc.Seniority, sc.AuthorizedShares,
+		sc.Seniority, sc.AuthorizedShares, sc.ParentShareClassID,
 	).Scan(&sc.ID, &sc.CreatedAt, &sc.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("creating share class: %w", err)
@@ -37,23 +69,16 @@ func (s *ShareClassStore) Create(ctx context.Context, sc *domain.ShareClass) err
 
 func (s *ShareClassStore) GetByID(ctx context.Context, id string) (*domain.ShareClass, error) {
 	sc := &domain.ShareClass{}
-	var participationCap, pricePerShare sql.NullString
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, company_id, name, is_preferred, liquidation_multiple, is_participating,
-		        participation_cap, price_per_share, seniority, authorized_shares,
-		        created_at, updated_at, deleted_at
+	err := scanShareClass(s.db.QueryRowContext(ctx,
+		`SELECT `+shareClassColumns+`
 		 FROM share_classes WHERE id = $1 AND deleted_at IS NULL`, id,
-	).Scan(&sc.ID, &sc.CompanyID, &sc.Name, &sc.IsPreferred, &sc.LiquidationMultiple,
-		&sc.IsParticipating, &participationCap, &pricePerShare, &sc.Seniority,
-		&sc.AuthorizedShares, &sc.CreatedAt, &sc.UpdatedAt, &sc.DeletedAt)
+	), sc)
 	if err == sql.ErrNoRows {
 		return nil, &domain.ErrNotFound{Entity: "share_class", ID: id}
 	}
 	if err != nil {
 		return nil, fmt.Errorf("getting share class: %w", err)
 	}
-	sc.ParticipationCap = nullStringToDecimalPtr(participationCap)
-	sc.PricePerShare = nullStringToDecimalPtr(pricePerShare)
 	return sc, nil
 }
 
@@ -62,9 +87,7 @@ func (s *ShareClassStore) GetByIDs(ctx context.Context, ids []string) (map[strin
 		return map[string]*domain.ShareClass{}, nil
 	}
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT id, company_id, name, is_preferred, liquidation_multiple, is_participating,
-		        participation_cap, price_per_share, seniority, authorized_shares,
-		        created_at, updated_at, deleted_at
+		`SELECT `+shareClassColumns+`
 		 FROM share_classes WHERE id = ANY($1) AND deleted_at IS NULL`, pq.Array(ids),
 	)
 	if err != nil {
@@ -75,14 +98,9 @@ func (s *ShareClassStore) GetByIDs(ctx context.Context, ids []string) (map[strin
 	result := make(map[string]*domain.ShareClass, len(ids))
 	for rows.Next() {
 		sc := &domain.ShareClass{}
-		var participationCap, pricePerShare sql.NullString
-		if err := rows.Scan(&sc.ID, &sc.CompanyID, &sc.Name, &sc.IsPreferred, &sc.LiquidationMultiple,
-			&sc.IsParticipating, &participationCap, &pricePerShare, &sc.Seniority,
-			&sc.AuthorizedShares, &sc.CreatedAt, &sc.UpdatedAt, &sc.DeletedAt); err != nil {
+		if err := scanShareClass(rows, sc); err != nil {
 			return nil, fmt.Errorf("scanning share class: %w", err)
 		}
-		sc.ParticipationCap = nullStringToDecimalPtr(participationCap)
-		sc.PricePerShare = nullStringToDecimalPtr(pricePerShare)
 		result[sc.ID] = sc
 	}
 	return result, rows.Err()
@@ -90,9 +108,7 @@ func (s *ShareClassStore) GetByIDs(ctx context.Context, ids []string) (map[strin
 
 func (s *ShareClassStore) ListByCompany(ctx context.Context, companyID string) ([]domain.ShareClass, error) {
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT id, company_id, name, is_preferred, liquidation_multiple, is_participating,
-		        participation_cap, price_per_share, seniority, authorized_shares,
-		        created_at, updated_at, deleted_at
+		`SELECT `+shareClassColumns+`
 		 FROM share_classes WHERE company_id = $1 AND deleted_at IS NULL
 		 ORDER BY seniority, created_at`, companyID,
 	)
@@ -104,19 +120,60 @@ func (s *ShareClassStore) ListByCompany(ctx context.Context, companyID string) (
 	var result []domain.ShareClass
 	for rows.Next() {
 		var sc domain.ShareClass
-		var participationCap, pricePerShare sql.NullString
-		if err := rows.Scan(&sc.ID, &sc.CompanyID, &sc.Name, &sc.IsPreferred, &sc.LiquidationMultiple,
-			&sc.IsParticipating, &participationCap, &pricePerShare, &sc.Seniority,
-			&sc.AuthorizedShares, &sc.CreatedAt, &sc.UpdatedAt, &sc.DeletedAt); err != nil {
+		if err := scanShareClass(rows, &sc); err != nil {
 			return nil, fmt.Errorf("scanning share class: %w", err)
 		}
-		sc.ParticipationCap = nullStringToDecimalPtr(participationCap)
-		sc.PricePerShare = nullStringToDecimalPtr(pricePerShare)
 		result = append(result, sc)
 	}
 	return result, rows.Err()
 }
 
+// shadowSeries returns the shadow series of parentID priced at pps, with its
+// authorized shares raised by shares, creating it as the parent's next
+// numbered sub-series if there is none. The parent row is locked so
+// concurrent conversions agree on the numbering.
+func shadowSeries(ctx context.Context, tx *sql.Tx, parentID string, pps, shares decimal.Decimal) (string, error) {
+	parent := &domain.ShareClass{}
+	err := scanShareClass(tx.QueryRowContext(ctx,
+		`SELECT `+shareClassColumns+`
+		 FROM share_classes WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, parentID,
+	), parent)
+	if err == sql.ErrNoRows {
+		return "", &domain.ErrNotFound{Entity: "share_class", ID: parentID}
+	}
+	if err != nil {
+		return "", fmt.Errorf("locking share class: %w", err)
+	}
+
+	// price_per_share keeps ten decimal places.
+	pps = pps.Round(10)
+	var id string
+	err = tx.QueryRowContext(ctx,
+		`UPDATE share_classes SET authorized_shares = authorized_shares + $3
+		 WHERE parent_share_class_id = $1 AND price_per_share = $2 AND deleted_at IS NULL
+		 RETURNING id`, parentID, pps, shares,
+	).Scan(&id)
+	if err == nil {
+		return id, nil
+	}
+	if err != sql.ErrNoRows {
+		return "", fmt.Errorf("updating shadow series: %w", err)
+	}
+
+	var n int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT count(*) FROM share_classes WHERE parent_share_class_id = $1`, parentID,
+	).Scan(&n); err != nil {
+		return "", fmt.Errorf("counting shadow series: %w", err)
+	}
+	series := parent.ShadowSeries(n+1, pps)
+	series.AuthorizedShares = shares
+	if err := insertShareClass(ctx, tx, &series); err != nil {
+		return "", err
+	}
+	return series.ID, nil
+}
+
 func decimalPtrToNullString(d *decimal.Decimal) sql.NullString {
 	if d == nil {
 		return sql.NullString{}
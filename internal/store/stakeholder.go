@@ -33,9 +33,10 @@ func (s *StakeholderStore) Create(ctx context.Context, sh *domain.Stakeholder) e
 func (s *StakeholderStore) GetByID(ctx context.Context, id string) (*domain.Stakeholder, error) {
 	sh := &domain.Stakeholder{}
 	err := s.db.QueryRowContext(ctx,
-		`SELECT id, company_id, name, email, role, created_at, updated_at, deleted_at
+		`SELECT id, company_id, name, email, role, termination_date, termination_reason, created_at, updated_at, deleted_at
 		 FROM stakeholders WHERE id = $1 AND deleted_at IS NULL`, id,
-	).Scan(&sh.ID, &sh.CompanyID, &sh.Name, &sh.Email, &sh.Role, &sh.CreatedAt, &sh.UpdatedAt, &sh.DeletedAt)
+	).Scan(&sh.ID, &sh.CompanyID, &sh.Name, &sh.Email, &sh.Role, &sh.TerminationDate, &sh.TerminationReason,
+		&sh.CreatedAt, &sh.UpdatedAt, &sh.DeletedAt)
 	if err == sql.ErrNoRows {
 		return nil, &domain.ErrNotFound{Entity: "stakeholder", ID: id}
 	}
@@ -50,7 +51,7 @@ func (s *StakeholderStore) GetByIDs(ctx context.Context, ids []string) (map[stri
 		return map[string]*domain.Stakeholder{}, nil
 	}
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT id, company_id, name, email, role, created_at, updated_at, deleted_at
+		`SELECT id, company_id, name, email, role, termination_date, termination_reason, created_at, updated_at, deleted_at
 		 FROM stakeholders WHERE id = ANY($1) AND deleted_at IS NULL`, pq.Array(ids),
 	)
 	if err != nil {
@@ -61,7 +62,8 @@ func (s *StakeholderStore) GetByIDs(ctx context.Context, ids []string) (map[stri
 	result := make(map[string]*domain.Stakeholder, len(ids))
 	for rows.Next() {
 		sh := &domain.Stakeholder{}
-		if err := rows.Scan(&sh.ID, &sh.CompanyID, &sh.Name, &sh.Email, &sh.Role, &sh.CreatedAt, &sh.UpdatedAt, &sh.DeletedAt); err != nil {
+		if err := rows.Scan(&sh.ID, &sh.CompanyID, &sh.Name, &sh.Email, &sh.Role, &sh.TerminationDate, &sh.TerminationReason,
+			&sh.CreatedAt, &sh.UpdatedAt, &sh.DeletedAt); err != nil {
 			return nil, fmt.Errorf("scanning stakeholder: %w", err)
 		}
 		result[sh.ID] = sh
@@ -71,7 +73,7 @@ func (s *StakeholderStore) GetByIDs(ctx context.Context, ids []string) (map[stri
 
 func (s *StakeholderStore) ListByCompany(ctx context.Context, companyID string) ([]domain.Stakeholder, error) {
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT id, company_id, name, email, role, created_at, updated_at, deleted_at
+		`SELECT id, company_id, name, email, role, termination_date, termination_reason, created_at, updated_at, deleted_at
 		 FROM stakeholders WHERE company_id = $1 AND deleted_at IS NULL
 		 ORDER BY created_at`, companyID,
 	)
@@ -83,10 +85,47 @@ func (s *StakeholderStore) ListByCompany(ctx context.Context, companyID string)
 	var result []domain.Stakeholder
 	for rows.Next() {
 		var sh domain.Stakeholder
-		if err := rows.Scan(&sh.ID, &sh.CompanyID, &sh.Name, &sh.Email, &sh.Role, &sh.CreatedAt, &sh.UpdatedAt, &sh.DeletedAt); err != nil {
+		if err := rows.Scan(&sh.ID, &sh.CompanyID, &sh.Name, &sh.Email, &sh.Role, &sh.TerminationDate, &sh.TerminationReason,
+			&sh.CreatedAt, &sh.UpdatedAt, &sh.DeletedAt); err != nil {
 			return nil, fmt.Errorf("scanning stakeholder: %w", err)
 		}
 		result = append(result, sh)
 	}
 	return result, rows.Err()
 }
+
+// Terminate records a stakeholder's termination and freezes their grants in
+// one transaction. Each grant's TerminationDate, ForfeitedQuantity,
+// ExerciseDeadline and RepurchasePrice must already be set.
+func (s *StakeholderStore) Terminate(ctx context.Context, sh *domain.Stakeholder, grants []domain.Grant) error {
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			`UPDATE stakeholders SET termination_date = $2, termination_reason = $3
+			 WHERE id = $1 AND termination_date IS NULL AND deleted_at IS NULL
+			 RETURNING updated_at`,
+			sh.ID, sh.TerminationDate, sh.TerminationReason,
+		).Scan(&sh.UpdatedAt)
+		if err == sql.ErrNoRows {
+			return &domain.ErrConflict{Message: fmt.Sprintf("stakeholder %s is already terminated", sh.ID)}
+		}
+		if err != nil {
+			return fmt.Errorf("terminating stakeholder: %w", err)
+		}
+
+		for i := range grants {
+			g := &grants[i]
+			err := tx.QueryRowContext(ctx,
+				`UPDATE grants SET termination_date = $2, forfeited_quantity = $3, exercise_deadline = $4,
+				        repurchase_price = $6
+				 WHERE id = $1 AND stakeholder_id = $5
+				 RETURNING updated_at`,
+				g.ID, g.TerminationDate, g.ForfeitedQuantity, g.ExerciseDeadline, sh.ID,
+				decimalPtrToNullString(g.RepurchasePrice),
+			).Scan(&g.UpdatedAt)
+			if err != nil {
+				return fmt.Errorf("terminating grant %s: %w", g.ID, err)
+			}
+		}
+		return nil
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/hutfut/vestigo/internal/domain"
+	"github.com/lib/pq"
 )
 
 type VestingScheduleStore struct {
@@ -16,30 +17,230 @@ func NewVestingScheduleStore(db *sql.DB) *VestingScheduleStore {
 	return &VestingScheduleStore{db: db}
 }
 
-func (s *VestingScheduleStore) Create(ctx context.Context, vs *domain.VestingSchedule) error {
-	err := s.db.QueryRowContext(ctx,
-		`INSERT INTO vesting_schedules (cliff_months, total_months, frequency, acceleration_trigger)
-		 VALUES ($1, $2, $3, $4)
-		 RETURNING id, created_at`,
-		vs.CliffMonths, vs.TotalMonths, vs.Frequency, vs.AccelerationTrigger,
-	).Scan(&vs.ID, &vs.CreatedAt)
+// vestingScheduleColumns is the select list scanned by scanVestingSchedule.
+const vestingScheduleColumns = `id, company_id, name, cliff_months, total_months, frequency, acceleration_trigger,
+	acceleration_percent, acceleration_months, acceleration_window_months, rounding,
+	created_at, updated_at, archived_at`
+
+func scanVestingSchedule(row rowScanner, vs *domain.VestingSchedule) error {
+	var companyID, accelPct sql.NullString
+	err := row.Scan(&vs.ID, &companyID, &vs.Name, &vs.CliffMonths, &vs.TotalMonths, &vs.Frequency, &vs.AccelerationTrigger,
+		&accelPct, &vs.AccelerationMonths, &vs.AccelerationWindowMonths, &vs.Rounding,
+		&vs.CreatedAt, &vs.UpdatedAt, &vs.ArchivedAt)
 	if err != nil {
-		return fmt.Errorf("creating vesting schedule: %w", err)
+		return err
 	}
+	vs.CompanyID = companyID.String // empty for a schedule no grant used before schedules had owners
+	vs.AccelerationPercent = nullStringToDecimalPtr(accelPct)
 	return nil
 }
 
+func (s *VestingScheduleStore) Create(ctx context.Context, vs *domain.VestingSchedule) error {
+	if vs.Rounding == "" {
+		vs.Rounding = domain.RoundingFractional
+	}
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			`INSERT INTO vesting_schedules
+			 (company_id, name, cliff_months, total_months, frequency, acceleration_trigger,
+			  acceleration_percent, acceleration_months, acceleration_window_months, rounding)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			 RETURNING id, created_at, updated_at`,
+			vs.CompanyID, vs.Name, vs.CliffMonths, vs.TotalMonths, vs.Frequency, vs.AccelerationTrigger,
+			decimalPtrToNullString(vs.AccelerationPercent), vs.AccelerationMonths, vs.AccelerationWindowMonths, vs.Rounding,
+		).Scan(&vs.ID, &vs.CreatedAt, &vs.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("creating vesting schedule: %w", err)
+		}
+		return insertTranches(ctx, tx, vs)
+	})
+}
+
 func (s *VestingScheduleStore) GetByID(ctx context.Context, id string) (*domain.VestingSchedule, error) {
 	vs := &domain.VestingSchedule{}
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, cliff_months, total_months, frequency, acceleration_trigger, created_at
+	err := scanVestingSchedule(s.db.QueryRowContext(ctx,
+		`SELECT `+vestingScheduleColumns+`
 		 FROM vesting_schedules WHERE id = $1`, id,
-	).Scan(&vs.ID, &vs.CliffMonths, &vs.TotalMonths, &vs.Frequency, &vs.AccelerationTrigger, &vs.CreatedAt)
+	), vs)
 	if err == sql.ErrNoRows {
 		return nil, &domain.ErrNotFound{Entity: "vesting_schedule", ID: id}
 	}
 	if err != nil {
 		return nil, fmt.Errorf("getting vesting schedule: %w", err)
 	}
+
+	tranches, err := s.listTranches(ctx, []string{vs.ID})
+	if err != nil {
+		return nil, err
+	}
+	vs.Tranches = tranches[vs.ID]
 	return vs, nil
 }
+
+// GetByIDs batch-fetches schedules and their tranches, keyed by schedule ID.
+// Archived schedules are included, since grants may still vest on them.
+func (s *VestingScheduleStore) GetByIDs(ctx context.Context, ids []string) (map[string]*domain.VestingSchedule, error) {
+	if len(ids) == 0 {
+		return map[string]*domain.VestingSchedule{}, nil
+	}
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+vestingScheduleColumns+`
+		 FROM vesting_schedules WHERE id = ANY($1)`, pq.Array(ids),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("batch-fetching vesting schedules: %w", err)
+	}
+	schedules, err := s.collect(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*domain.VestingSchedule, len(schedules))
+	for i := range schedules {
+		result[schedules[i].ID] = &schedules[i]
+	}
+	return result, nil
+}
+
+// ListByCompany returns the company's active schedule templates by name.
+func (s *VestingScheduleStore) ListByCompany(ctx context.Context, companyID string) ([]domain.VestingSchedule, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+vestingScheduleColumns+`
+		 FROM vesting_schedules WHERE company_id = $1 AND archived_at IS NULL
+		 ORDER BY name`, companyID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing vesting schedules: %w", err)
+	}
+	return s.collect(ctx, rows)
+}
+
+// Update overwrites an active schedule's name and terms, replacing its
+// tranches, in one transaction.
+func (s *VestingScheduleStore) Update(ctx context.Context, vs *domain.VestingSchedule) error {
+	return withTx(ctx, s.db, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx,
+			`UPDATE vesting_schedules
+			 SET name = $2, cliff_months = $3, total_months = $4, frequency = $5, acceleration_trigger = $6,
+			     acceleration_percent = $7, acceleration_months = $8, acceleration_window_months = $9, rounding = $10
+			 WHERE id = $1 AND archived_at IS NULL
+			 RETURNING updated_at`,
+			vs.ID, vs.Name, vs.CliffMonths, vs.TotalMonths, vs.Frequency, vs.AccelerationTrigger,
+			decimalPtrToNullString(vs.AccelerationPercent), vs.AccelerationMonths, vs.AccelerationWindowMonths, vs.Rounding,
+		).Scan(&vs.UpdatedAt)
+		if err == sql.ErrNoRows {
+			return &domain.ErrConflict{Message: fmt.Sprintf("vesting schedule %s is archived", vs.ID)}
+		}
+		if err != nil {
+			return fmt.Errorf("updating vesting schedule: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM vesting_schedule_tranches WHERE vesting_schedule_id = $1`, vs.ID,
+		); err != nil {
+			return fmt.Errorf("clearing vesting tranches: %w", err)
+		}
+		return insertTranches(ctx, tx, vs)
+	})
+}
+
+// Archive hides a schedule from its company's template list. Grants already
+// issued on it are unaffected.
+func (s *VestingScheduleStore) Archive(ctx context.Context, vs *domain.VestingSchedule) error {
+	err := s.db.QueryRowContext(ctx,
+		`UPDATE vesting_schedules SET archived_at = now()
+		 WHERE id = $1 AND archived_at IS NULL
+		 RETURNING archived_at, updated_at`, vs.ID,
+	).Scan(&vs.ArchivedAt, &vs.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return &domain.ErrConflict{Message: fmt.Sprintf("vesting schedule %s is already archived", vs.ID)}
+	}
+	if err != nil {
+		return fmt.Errorf("archiving vesting schedule: %w", err)
+	}
+	return nil
+}
+
+// InUse reports whether any grant vests on the schedule.
+func (s *VestingScheduleStore) InUse(ctx context.Context, id string) (bool, error) {
+	var inUse bool
+	err := s.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM grants WHERE vesting_schedule_id = $1 AND deleted_at IS NULL)`, id,
+	).Scan(&inUse)
+	if err != nil {
+		return false, fmt.Errorf("checking vesting schedule usage: %w", err)
+	}
+	return inUse, nil
+}
+
+// collect scans schedule rows and attaches their tranches.
+func (s *VestingScheduleStore) collect(ctx context.Context, rows *sql.Rows) ([]domain.VestingSchedule, error) {
+	defer rows.Close()
+
+	var result []domain.VestingSchedule
+	var ids []string
+	for rows.Next() {
+		var vs domain.VestingSchedule
+		if err := scanVestingSchedule(rows, &vs); err != nil {
+			return nil, fmt.Errorf("scanning vesting schedule: %w", err)
+		}
+		result = append(result, vs)
+		ids = append(ids, vs.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	tranches, err := s.listTranches(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result {
+		result[i].Tranches = tranches[result[i].ID]
+	}
+	return result, nil
+}
+
+func insertTranches(ctx context.Context, tx *sql.Tx, vs *domain.VestingSchedule) error {
+	for _, t := range vs.Tranches {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO vesting_schedule_tranches (vesting_schedule_id, offset_months, percentage, shares)
+			 VALUES ($1, $2, $3, $4)`,
+			vs.ID, t.OffsetMonths, decimalPtrToNullString(t.Percent), decimalPtrToNullString(t.Shares),
+		); err != nil {
+			return fmt.Errorf("creating vesting tranche: %w", err)
+		}
+	}
+	return nil
+}
+
+// listTranches loads the tranches of the given schedules, keyed by schedule
+// ID and ordered by offset.
+func (s *VestingScheduleStore) listTranches(ctx context.Context, scheduleIDs []string) (map[string][]domain.VestingTranche, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT vesting_schedule_id, offset_months, percentage, shares
+		 FROM vesting_schedule_tranches WHERE vesting_schedule_id = ANY($1)
+		 ORDER BY vesting_schedule_id, offset_months`, pq.Array(scheduleIDs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing vesting tranches: %w", err)
+	}
+	defer rows.Close()
+
+	result := map[string][]domain.VestingTranche{}
+	for rows.Next() {
+		var scheduleID string
+		var t domain.VestingTranche
+		var pct, shares sql.NullString
+		if err := rows.Scan(&scheduleID, &t.OffsetMonths, &pct, &shares); err != nil {
+			return nil, fmt.Errorf("scanning vesting tranche: %w", err)
+		}
+		t.Percent = nullStringToDecimalPtr(pct)
+		t.Shares = nullStringToDecimalPtr(shares)
+		result[scheduleID] = append(result[scheduleID], t)
+	}
+	return result, rows.Err()
+}
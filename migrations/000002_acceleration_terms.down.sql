@@ -0,0 +1,8 @@
+ALTER TABLE vesting_schedules
+    DROP CONSTRAINT IF EXISTS chk_acceleration_window,
+    DROP CONSTRAINT IF EXISTS chk_acceleration_amount,
+    DROP CONSTRAINT IF EXISTS chk_acceleration_months,
+    DROP CONSTRAINT IF EXISTS chk_acceleration_percent,
+    DROP COLUMN IF EXISTS acceleration_window_months,
+    DROP COLUMN IF EXISTS acceleration_months,
+    DROP COLUMN IF EXISTS acceleration_percent;
@@ -0,0 +1,9 @@
+-- Partial and double-trigger acceleration terms on vesting schedules.
+ALTER TABLE vesting_schedules
+    ADD COLUMN acceleration_percent        NUMERIC(7, 4),  -- share of unvested that accelerates; NULL with no months means 100%
+    ADD COLUMN acceleration_months         INTEGER,        -- months of additional vesting credited on trigger
+    ADD COLUMN acceleration_window_months  INTEGER NOT NULL DEFAULT 12,
+    ADD CONSTRAINT chk_acceleration_percent CHECK (acceleration_percent IS NULL OR (acceleration_percent > 0 AND acceleration_percent <= 100)),
+    ADD CONSTRAINT chk_acceleration_months CHECK (acceleration_months IS NULL OR acceleration_months > 0),
+    ADD CONSTRAINT chk_acceleration_amount CHECK (acceleration_percent IS NULL OR acceleration_months IS NULL),
+    ADD CONSTRAINT chk_acceleration_window CHECK (acceleration_window_months >= 0);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS vesting_schedule_tranches;
@@ -0,0 +1,17 @@
+-- Explicit tranches for back-weighted and irregular vesting schedules. A
+-- schedule with tranches vests exactly these amounts at these offsets and
+-- ignores cliff_months/frequency, which are kept in sync for display.
+CREATE TABLE vesting_schedule_tranches (
+    id                  UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    vesting_schedule_id UUID NOT NULL REFERENCES vesting_schedules(id) ON DELETE CASCADE,
+    offset_months       INTEGER NOT NULL,
+    percentage          NUMERIC(7, 4),   -- 25 = 25% of the grant
+    shares              NUMERIC(20, 4),
+    UNIQUE (vesting_schedule_id, offset_months),
+    CONSTRAINT chk_tranche_offset CHECK (offset_months >= 0),
+    CONSTRAINT chk_tranche_amount CHECK ((percentage IS NULL) <> (shares IS NULL)),
+    CONSTRAINT chk_tranche_percentage CHECK (percentage IS NULL OR (percentage > 0 AND percentage <= 100)),
+    CONSTRAINT chk_tranche_shares CHECK (shares IS NULL OR shares > 0)
+);
+
+CREATE INDEX idx_vesting_schedule_tranches_schedule ON vesting_schedule_tranches(vesting_schedule_id);
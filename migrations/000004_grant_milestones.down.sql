@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_grant_milestones_updated_at ON grant_milestones;
+DROP TABLE IF EXISTS grant_milestones;
+DROP TYPE IF EXISTS milestone_condition;
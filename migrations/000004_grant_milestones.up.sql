@@ -0,0 +1,19 @@
+CREATE TYPE milestone_condition AS ENUM ('milestone', 'milestone_and_time');
+
+-- Performance conditions carved out of a grant. Shares not allocated to a
+-- milestone vest on the grant's time-based schedule.
+CREATE TABLE grant_milestones (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    grant_id        UUID NOT NULL REFERENCES grants(id),
+    name            TEXT NOT NULL,
+    shares          NUMERIC(20, 4) NOT NULL,
+    condition       milestone_condition NOT NULL DEFAULT 'milestone',
+    achieved_date   DATE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_milestone_shares CHECK (shares > 0)
+);
+
+CREATE INDEX idx_grant_milestones_grant ON grant_milestones(grant_id);
+
+CREATE TRIGGER trg_grant_milestones_updated_at BEFORE UPDATE ON grant_milestones FOR EACH ROW EXECUTE FUNCTION update_updated_at();
@@ -0,0 +1 @@
+ALTER TABLE grants DROP COLUMN IF EXISTS vesting_commencement_date;
@@ -0,0 +1,6 @@
+-- Vesting anchor date, distinct from the board grant date.
+ALTER TABLE grants ADD COLUMN vesting_commencement_date DATE;
+
+UPDATE grants SET vesting_commencement_date = grant_date;
+
+ALTER TABLE grants ALTER COLUMN vesting_commencement_date SET NOT NULL;
@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS idx_grants_option_pool;
+
+ALTER TABLE grants
+    DROP CONSTRAINT IF EXISTS chk_forfeited_quantity,
+    DROP COLUMN IF EXISTS exercise_deadline,
+    DROP COLUMN IF EXISTS forfeited_quantity,
+    DROP COLUMN IF EXISTS termination_date,
+    DROP COLUMN IF EXISTS option_pool_id;
+
+ALTER TABLE stakeholders
+    DROP CONSTRAINT IF EXISTS chk_termination,
+    DROP COLUMN IF EXISTS termination_reason,
+    DROP COLUMN IF EXISTS termination_date;
+
+DROP TRIGGER IF EXISTS trg_option_pools_updated_at ON option_pools;
+DROP TABLE IF EXISTS option_pools;
+DROP TYPE IF EXISTS termination_reason;
@@ -0,0 +1,34 @@
+CREATE TYPE termination_reason AS ENUM ('voluntary', 'involuntary', 'for_cause', 'death', 'disability');
+
+-- Shares reserved for equity incentive grants. Granted shares are the
+-- outstanding (non-forfeited) quantity of grants drawn from the pool.
+CREATE TABLE option_pools (
+    id                  UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    company_id          UUID NOT NULL REFERENCES companies(id),
+    share_class_id      UUID NOT NULL REFERENCES share_classes(id),
+    name                TEXT NOT NULL,
+    reserved_shares     NUMERIC(20, 4) NOT NULL,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at          TIMESTAMPTZ,
+    UNIQUE (company_id, name),
+    CONSTRAINT chk_reserved_shares CHECK (reserved_shares > 0)
+);
+
+CREATE INDEX idx_option_pools_company ON option_pools(company_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_option_pools_updated_at BEFORE UPDATE ON option_pools FOR EACH ROW EXECUTE FUNCTION update_updated_at();
+
+ALTER TABLE stakeholders
+    ADD COLUMN termination_date    DATE,
+    ADD COLUMN termination_reason  termination_reason,
+    ADD CONSTRAINT chk_termination CHECK ((termination_date IS NULL) = (termination_reason IS NULL));
+
+ALTER TABLE grants
+    ADD COLUMN option_pool_id      UUID REFERENCES option_pools(id),
+    ADD COLUMN termination_date    DATE,                                -- vesting stops on this date
+    ADD COLUMN forfeited_quantity  NUMERIC(20, 4) NOT NULL DEFAULT 0,   -- unvested at termination; returned to the pool
+    ADD COLUMN exercise_deadline   DATE,                                -- last day to exercise vested options after termination
+    ADD CONSTRAINT chk_forfeited_quantity CHECK (forfeited_quantity >= 0 AND forfeited_quantity <= quantity);
+
+CREATE INDEX idx_grants_option_pool ON grants(option_pool_id) WHERE deleted_at IS NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS leaves_of_absence;
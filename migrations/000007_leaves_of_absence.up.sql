@@ -0,0 +1,14 @@
+-- Leaves recorded against a stakeholder (grant_id NULL) or a single grant.
+-- Unpaid leaves over the policy threshold toll vesting.
+CREATE TABLE leaves_of_absence (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    stakeholder_id  UUID NOT NULL REFERENCES stakeholders(id),
+    grant_id        UUID REFERENCES grants(id),
+    start_date      DATE NOT NULL,
+    end_date        DATE NOT NULL,  -- first day back
+    is_paid         BOOLEAN NOT NULL DEFAULT false,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_leave_dates CHECK (end_date > start_date)
+);
+
+CREATE INDEX idx_leaves_of_absence_stakeholder ON leaves_of_absence(stakeholder_id);
@@ -0,0 +1,2 @@
+ALTER TABLE vesting_schedules DROP COLUMN IF EXISTS rounding;
+DROP TYPE IF EXISTS vesting_rounding;
@@ -0,0 +1,3 @@
+CREATE TYPE vesting_rounding AS ENUM ('fractional', 'cumulative_floor', 'per_period_floor', 'bankers');
+
+ALTER TABLE vesting_schedules ADD COLUMN rounding vesting_rounding NOT NULL DEFAULT 'fractional';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS grant_exercises;
+
+ALTER TABLE grants
+    DROP COLUMN IF EXISTS repurchase_price,
+    DROP COLUMN IF EXISTS early_exercise_allowed;
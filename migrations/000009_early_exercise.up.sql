@@ -0,0 +1,17 @@
+ALTER TABLE grants
+    ADD COLUMN early_exercise_allowed  BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN repurchase_price        NUMERIC(20, 4);  -- per share; lower of cost or FMV at termination
+
+-- Each exercise converts options into shares. Shares exercised before they
+-- vest stay subject to the grant's vesting schedule and the company's
+-- repurchase right.
+CREATE TABLE grant_exercises (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    grant_id        UUID NOT NULL REFERENCES grants(id),
+    quantity        NUMERIC(20, 4) NOT NULL,
+    exercise_date   DATE NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_exercise_quantity CHECK (quantity > 0)
+);
+
+CREATE INDEX idx_grant_exercises_grant ON grant_exercises(grant_id);
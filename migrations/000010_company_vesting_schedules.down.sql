@@ -0,0 +1,18 @@
+DROP TRIGGER IF EXISTS trg_vesting_schedules_updated_at ON vesting_schedules;
+DROP INDEX IF EXISTS idx_vesting_schedules_company_name;
+
+-- Move grants back onto the shared schedules their company's copy was made
+-- from, and drop the copies with their tranches.
+UPDATE grants g SET vesting_schedule_id = vs.copied_from
+FROM vesting_schedules vs
+WHERE g.vesting_schedule_id = vs.id AND vs.copied_from IS NOT NULL;
+
+DELETE FROM vesting_schedules WHERE copied_from IS NOT NULL;
+
+ALTER TABLE vesting_schedules
+    DROP CONSTRAINT IF EXISTS vesting_schedules_owned,
+    DROP COLUMN IF EXISTS copied_from,
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS name,
+    DROP COLUMN IF EXISTS company_id;
@@ -0,0 +1,59 @@
+-- Vesting schedules become named templates owned by a company.
+ALTER TABLE vesting_schedules
+    ADD COLUMN company_id   UUID REFERENCES companies(id),
+    ADD COLUMN name         TEXT,
+    ADD COLUMN updated_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN archived_at  TIMESTAMPTZ,  -- hidden from the template list; existing grants keep vesting on it
+    ADD COLUMN copied_from  UUID REFERENCES vesting_schedules(id);  -- the shared schedule a per-company copy was made from
+
+-- Each schedule goes to the company of its earliest grant.
+UPDATE vesting_schedules vs SET company_id = (
+    SELECT g.company_id FROM grants g
+    WHERE g.vesting_schedule_id = vs.id
+    ORDER BY g.created_at
+    LIMIT 1
+);
+
+-- A schedule shared by grants in several companies is copied once for every
+-- other company, and that company's grants are moved onto the copy.
+DO $$
+DECLARE
+    shared  RECORD;
+    copy_id UUID;
+BEGIN
+    FOR shared IN
+        SELECT DISTINCT g.vesting_schedule_id AS schedule_id, g.company_id
+        FROM grants g
+        JOIN vesting_schedules vs ON vs.id = g.vesting_schedule_id
+        WHERE g.company_id <> vs.company_id
+    LOOP
+        INSERT INTO vesting_schedules
+            (company_id, cliff_months, total_months, frequency, acceleration_trigger,
+             acceleration_percent, acceleration_months, acceleration_window_months, rounding, created_at, copied_from)
+        SELECT shared.company_id, cliff_months, total_months, frequency, acceleration_trigger,
+               acceleration_percent, acceleration_months, acceleration_window_months, rounding, created_at, id
+        FROM vesting_schedules WHERE id = shared.schedule_id
+        RETURNING id INTO copy_id;
+
+        INSERT INTO vesting_schedule_tranches (vesting_schedule_id, offset_months, percentage, shares)
+        SELECT copy_id, offset_months, percentage, shares
+        FROM vesting_schedule_tranches WHERE vesting_schedule_id = shared.schedule_id;
+
+        UPDATE grants SET vesting_schedule_id = copy_id
+        WHERE vesting_schedule_id = shared.schedule_id AND company_id = shared.company_id;
+    END LOOP;
+END $$;
+
+-- Schedules no grant ever used cannot be attributed to a company. They are
+-- kept, ownerless and archived, rather than deleted.
+UPDATE vesting_schedules SET archived_at = now() WHERE company_id IS NULL;
+
+UPDATE vesting_schedules SET name = format('%s/%s %s (%s)', total_months, cliff_months, frequency, left(id::text, 8));
+
+ALTER TABLE vesting_schedules
+    ALTER COLUMN name SET NOT NULL,
+    ADD CONSTRAINT vesting_schedules_owned CHECK (company_id IS NOT NULL OR archived_at IS NOT NULL);
+
+CREATE UNIQUE INDEX idx_vesting_schedules_company_name ON vesting_schedules(company_id, name) WHERE archived_at IS NULL;
+
+CREATE TRIGGER trg_vesting_schedules_updated_at BEFORE UPDATE ON vesting_schedules FOR EACH ROW EXECUTE FUNCTION update_updated_at();
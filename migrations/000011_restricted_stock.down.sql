@@ -0,0 +1,8 @@
+ALTER TABLE grants
+    DROP CONSTRAINT IF EXISTS chk_rsa_early_exercise,
+    DROP CONSTRAINT IF EXISTS chk_repurchased_quantity,
+    DROP COLUMN IF EXISTS repurchase_date,
+    DROP COLUMN IF EXISTS repurchased_quantity,
+    DROP COLUMN IF EXISTS grant_type;
+
+DROP TYPE IF EXISTS grant_type;
@@ -0,0 +1,11 @@
+CREATE TYPE grant_type AS ENUM ('option', 'rsa');
+
+-- Restricted stock is issued outright at grant; its vesting schedule governs
+-- when the company's repurchase right lapses. Repurchased shares go to
+-- treasury and are no longer outstanding.
+ALTER TABLE grants
+    ADD COLUMN grant_type            grant_type NOT NULL DEFAULT 'option',
+    ADD COLUMN repurchased_quantity  NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN repurchase_date       DATE,
+    ADD CONSTRAINT chk_repurchased_quantity CHECK (repurchased_quantity >= 0 AND forfeited_quantity + repurchased_quantity <= quantity),
+    ADD CONSTRAINT chk_rsa_early_exercise CHECK (grant_type = 'option' OR NOT early_exercise_allowed);
@@ -0,0 +1,19 @@
+ALTER TABLE safe_notes
+    DROP CONSTRAINT IF EXISTS chk_conversion,
+    DROP COLUMN IF EXISTS conversion_grant_id,
+    DROP COLUMN IF EXISTS conversion_shares,
+    DROP COLUMN IF EXISTS conversion_price,
+    DROP COLUMN IF EXISTS conversion_method;
+
+-- Postgres cannot drop an enum value, so rebuild the type without 'stock'.
+DELETE FROM grants WHERE grant_type = 'stock';
+ALTER TABLE grants
+    DROP CONSTRAINT IF EXISTS chk_rsa_early_exercise,
+    ALTER COLUMN grant_type DROP DEFAULT;
+ALTER TYPE grant_type RENAME TO grant_type_old;
+CREATE TYPE grant_type AS ENUM ('option', 'rsa');
+ALTER TABLE grants
+    ALTER COLUMN grant_type TYPE grant_type USING grant_type::text::grant_type,
+    ALTER COLUMN grant_type SET DEFAULT 'option',
+    ADD CONSTRAINT chk_rsa_early_exercise CHECK (grant_type = 'option' OR NOT early_exercise_allowed);
+DROP TYPE grant_type_old;
@@ -0,0 +1,15 @@
+-- Stock is issued outright and fully vested, such as the preferred shares a
+-- SAFE converts into. Its exercise_price is the price paid per share.
+ALTER TYPE grant_type ADD VALUE 'stock';
+
+-- A converted SAFE records its conversion result and the stock grant that
+-- issued its shares.
+ALTER TABLE safe_notes
+    ADD COLUMN conversion_method    TEXT,
+    ADD COLUMN conversion_price     NUMERIC(20, 10),
+    ADD COLUMN conversion_shares    NUMERIC(20, 4),
+    ADD COLUMN conversion_grant_id  UUID REFERENCES grants(id),
+    ADD CONSTRAINT chk_conversion CHECK (
+        conversion_grant_id IS NULL
+        OR (is_converted AND conversion_method IS NOT NULL AND conversion_price IS NOT NULL AND conversion_shares IS NOT NULL)
+    );
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS convertible_notes;
+DROP TYPE IF EXISTS day_count;
+DROP TYPE IF EXISTS interest_type;
@@ -0,0 +1,49 @@
+CREATE TYPE interest_type AS ENUM ('simple', 'compound');
+CREATE TYPE day_count AS ENUM ('actual_365', 'actual_360', '30_360');
+
+-- A convertible note converts its principal plus accrued interest at a
+-- qualified financing. Unconverted at maturity, it converts at the maturity
+-- cap when it has one and is repaid otherwise. Settlement columns record the
+-- outcome once it converts or is repaid.
+CREATE TABLE convertible_notes (
+    id                          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    company_id                  UUID NOT NULL REFERENCES companies(id),
+    stakeholder_id              UUID NOT NULL REFERENCES stakeholders(id),
+    principal                   NUMERIC(20, 4) NOT NULL,
+    interest_rate               NUMERIC(7, 6) NOT NULL,   -- annual; 0.08 = 8%
+    interest_type               interest_type NOT NULL DEFAULT 'simple',
+    day_count                   day_count NOT NULL DEFAULT 'actual_365',
+    issue_date                  DATE NOT NULL,
+    maturity_date               DATE NOT NULL,
+    valuation_cap               NUMERIC(20, 4),           -- NULL means no cap
+    discount_rate               NUMERIC(5, 4),            -- NULL means no discount
+    qualified_financing_amount  NUMERIC(20, 4),           -- NULL means any priced round qualifies
+    maturity_valuation_cap      NUMERIC(20, 4),           -- NULL means repaid at maturity
+    settlement_date             DATE,
+    settlement_method           TEXT,
+    settlement_interest         NUMERIC(20, 4),
+    settlement_shares           NUMERIC(20, 4),
+    settlement_price            NUMERIC(20, 10),
+    repayment_amount            NUMERIC(20, 4),
+    settled_in_round            UUID REFERENCES funding_rounds(id),
+    settlement_grant_id         UUID REFERENCES grants(id),
+    created_at                  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at                  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at                  TIMESTAMPTZ,
+    CONSTRAINT chk_note_principal CHECK (principal > 0),
+    CONSTRAINT chk_note_interest_rate CHECK (interest_rate >= 0),
+    CONSTRAINT chk_note_maturity CHECK (maturity_date > issue_date),
+    CONSTRAINT chk_note_cap CHECK (valuation_cap IS NULL OR valuation_cap > 0),
+    CONSTRAINT chk_note_discount CHECK (discount_rate IS NULL OR (discount_rate > 0 AND discount_rate < 1)),
+    CONSTRAINT chk_note_qualified_financing CHECK (qualified_financing_amount IS NULL OR qualified_financing_amount > 0),
+    CONSTRAINT chk_note_maturity_cap CHECK (maturity_valuation_cap IS NULL OR maturity_valuation_cap > 0),
+    CONSTRAINT chk_note_settlement CHECK (
+        settlement_date IS NULL
+        OR (settlement_method IS NOT NULL AND settlement_interest IS NOT NULL AND settlement_shares IS NOT NULL
+            AND settlement_price IS NOT NULL AND repayment_amount IS NOT NULL)
+    )
+);
+
+CREATE INDEX idx_convertible_notes_company ON convertible_notes(company_id) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_convertible_notes_updated_at BEFORE UPDATE ON convertible_notes FOR EACH ROW EXECUTE FUNCTION update_updated_at();
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS mfn_notices;
+
+ALTER TABLE safe_notes
+    DROP CONSTRAINT IF EXISTS chk_inherited_terms,
+    DROP CONSTRAINT IF EXISTS chk_mfn_uncapped,
+    DROP COLUMN IF EXISTS inherited_terms_from,
+    DROP COLUMN IF EXISTS is_mfn;
@@ -0,0 +1,20 @@
+-- An MFN SAFE is uncapped and inherits the cap and discount of the most
+-- favorable SAFE issued after it and before the round it converts in.
+ALTER TABLE safe_notes
+    ADD COLUMN is_mfn                BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN inherited_terms_from  UUID REFERENCES safe_notes(id),
+    ADD CONSTRAINT chk_mfn_uncapped CHECK (NOT is_mfn OR valuation_cap IS NULL),
+    ADD CONSTRAINT chk_inherited_terms CHECK (inherited_terms_from IS NULL OR (is_mfn AND is_converted));
+
+-- Notices to MFN holders that a later SAFE was issued on better terms.
+CREATE TABLE mfn_notices (
+    id                   UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    safe_note_id         UUID NOT NULL REFERENCES safe_notes(id),
+    source_safe_note_id  UUID NOT NULL REFERENCES safe_notes(id),
+    stakeholder_id       UUID NOT NULL REFERENCES stakeholders(id),
+    valuation_cap        NUMERIC(20, 4),
+    discount_rate        NUMERIC(5, 4),
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_mfn_notices_stakeholder ON mfn_notices(stakeholder_id);
@@ -0,0 +1,15 @@
+ALTER TABLE safe_notes
+    DROP COLUMN IF EXISTS cap_pool_increase,
+    DROP COLUMN IF EXISTS cap_unissued_pool,
+    DROP COLUMN IF EXISTS cap_promised_options,
+    DROP COLUMN IF EXISTS cap_issued_options,
+    DROP COLUMN IF EXISTS cap_issued_shares,
+    DROP COLUMN IF EXISTS capitalization_definition;
+
+ALTER TABLE funding_rounds
+    DROP CONSTRAINT IF EXISTS chk_promised_options,
+    DROP CONSTRAINT IF EXISTS chk_option_pool_increase,
+    DROP COLUMN IF EXISTS promised_options,
+    DROP COLUMN IF EXISTS option_pool_increase;
+
+DROP TYPE IF EXISTS capitalization_definition;
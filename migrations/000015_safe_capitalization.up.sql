@@ -0,0 +1,19 @@
+-- Which "Company Capitalization" a SAFE's contract converts against.
+CREATE TYPE capitalization_definition AS ENUM ('outstanding', 'fully_diluted', 'fully_diluted_with_pool_increase');
+
+-- A round's option pool increase, and the options promised but not yet
+-- granted when it closes.
+ALTER TABLE funding_rounds
+    ADD COLUMN option_pool_increase  NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN promised_options      NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_option_pool_increase CHECK (option_pool_increase >= 0),
+    ADD CONSTRAINT chk_promised_options CHECK (promised_options >= 0);
+
+-- A converted SAFE records the capitalization it converted against.
+ALTER TABLE safe_notes
+    ADD COLUMN capitalization_definition  capitalization_definition NOT NULL DEFAULT 'outstanding',
+    ADD COLUMN cap_issued_shares          NUMERIC(20, 4),
+    ADD COLUMN cap_issued_options         NUMERIC(20, 4),
+    ADD COLUMN cap_promised_options       NUMERIC(20, 4),
+    ADD COLUMN cap_unissued_pool          NUMERIC(20, 4),
+    ADD COLUMN cap_pool_increase          NUMERIC(20, 4);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_share_classes_parent;
+
+ALTER TABLE share_classes
+    DROP COLUMN IF EXISTS parent_share_class_id;
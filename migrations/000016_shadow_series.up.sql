@@ -0,0 +1,6 @@
+-- A shadow series, such as Series A-1, is a sub-series of a round's preferred
+-- class issued to SAFEs converting below the round price.
+ALTER TABLE share_classes
+    ADD COLUMN parent_share_class_id UUID REFERENCES share_classes(id);
+
+CREATE INDEX idx_share_classes_parent ON share_classes(parent_share_class_id) WHERE parent_share_class_id IS NOT NULL;